@@ -311,34 +311,31 @@ func (b0 GetCustomerByIDRequest_builder) Build() *GetCustomerByIDRequest {
 	return m0
 }
 
-type Customer struct {
+// UpdateMyProfileRequest only updates the fields which are set, an empty phone number removes it
+type UpdateMyProfileRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
-	xxx_hidden_Email       *string                `protobuf:"bytes,3,opt,name=email"`
-	xxx_hidden_PhoneNumber *string                `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber"`
-	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt"`
-	xxx_hidden_UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_PhoneNumber *string                `protobuf:"bytes,2,opt,name=phone_number,json=phoneNumber"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *Customer) Reset() {
-	*x = Customer{}
+func (x *UpdateMyProfileRequest) Reset() {
+	*x = UpdateMyProfileRequest{}
 	mi := &file_restaurant_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Customer) String() string {
+func (x *UpdateMyProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Customer) ProtoMessage() {}
+func (*UpdateMyProfileRequest) ProtoMessage() {}
 
-func (x *Customer) ProtoReflect() protoreflect.Message {
+func (x *UpdateMyProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -350,17 +347,7 @@ func (x *Customer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *Customer) GetId() string {
-	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *Customer) GetName() string {
+func (x *UpdateMyProfileRequest) GetName() string {
 	if x != nil {
 		if x.xxx_hidden_Name != nil {
 			return *x.xxx_hidden_Name
@@ -370,17 +357,7 @@ func (x *Customer) GetName() string {
 	return ""
 }
 
-func (x *Customer) GetEmail() string {
-	if x != nil {
-		if x.xxx_hidden_Email != nil {
-			return *x.xxx_hidden_Email
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *Customer) GetPhoneNumber() string {
+func (x *UpdateMyProfileRequest) GetPhoneNumber() string {
 	if x != nil {
 		if x.xxx_hidden_PhoneNumber != nil {
 			return *x.xxx_hidden_PhoneNumber
@@ -390,155 +367,63 @@ func (x *Customer) GetPhoneNumber() string {
 	return ""
 }
 
-func (x *Customer) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.xxx_hidden_CreatedAt
-	}
-	return nil
-}
-
-func (x *Customer) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.xxx_hidden_UpdatedAt
-	}
-	return nil
-}
-
-func (x *Customer) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
-}
-
-func (x *Customer) SetName(v string) {
+func (x *UpdateMyProfileRequest) SetName(v string) {
 	x.xxx_hidden_Name = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
-}
-
-func (x *Customer) SetEmail(v string) {
-	x.xxx_hidden_Email = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *Customer) SetPhoneNumber(v string) {
+func (x *UpdateMyProfileRequest) SetPhoneNumber(v string) {
 	x.xxx_hidden_PhoneNumber = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
-}
-
-func (x *Customer) SetCreatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_CreatedAt = v
-}
-
-func (x *Customer) SetUpdatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_UpdatedAt = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (x *Customer) HasId() bool {
+func (x *UpdateMyProfileRequest) HasName() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *Customer) HasName() bool {
+func (x *UpdateMyProfileRequest) HasPhoneNumber() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *Customer) HasEmail() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
-}
-
-func (x *Customer) HasPhoneNumber() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
-}
-
-func (x *Customer) HasCreatedAt() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_CreatedAt != nil
-}
-
-func (x *Customer) HasUpdatedAt() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_UpdatedAt != nil
-}
-
-func (x *Customer) ClearId() {
+func (x *UpdateMyProfileRequest) ClearName() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
-}
-
-func (x *Customer) ClearName() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_Name = nil
 }
 
-func (x *Customer) ClearEmail() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Email = nil
-}
-
-func (x *Customer) ClearPhoneNumber() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+func (x *UpdateMyProfileRequest) ClearPhoneNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_PhoneNumber = nil
 }
 
-func (x *Customer) ClearCreatedAt() {
-	x.xxx_hidden_CreatedAt = nil
-}
-
-func (x *Customer) ClearUpdatedAt() {
-	x.xxx_hidden_UpdatedAt = nil
-}
-
-type Customer_builder struct {
+type UpdateMyProfileRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id          *string
 	Name        *string
-	Email       *string
 	PhoneNumber *string
-	CreatedAt   *timestamppb.Timestamp
-	UpdatedAt   *timestamppb.Timestamp
 }
 
-func (b0 Customer_builder) Build() *Customer {
-	m0 := &Customer{}
+func (b0 UpdateMyProfileRequest_builder) Build() *UpdateMyProfileRequest {
+	m0 := &UpdateMyProfileRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
-		x.xxx_hidden_Id = b.Id
-	}
 	if b.Name != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
 		x.xxx_hidden_Name = b.Name
 	}
-	if b.Email != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
-		x.xxx_hidden_Email = b.Email
-	}
 	if b.PhoneNumber != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
 		x.xxx_hidden_PhoneNumber = b.PhoneNumber
 	}
-	x.xxx_hidden_CreatedAt = b.CreatedAt
-	x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	return m0
 }
 
-type GenerateTokenRequest struct {
+type ChangeLoginIDRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_LoginId     *string                `protobuf:"bytes,1,opt,name=login_id,json=loginId"`
 	xxx_hidden_Password    *string                `protobuf:"bytes,2,opt,name=password"`
@@ -548,20 +433,20 @@ type GenerateTokenRequest struct {
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GenerateTokenRequest) Reset() {
-	*x = GenerateTokenRequest{}
+func (x *ChangeLoginIDRequest) Reset() {
+	*x = ChangeLoginIDRequest{}
 	mi := &file_restaurant_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateTokenRequest) String() string {
+func (x *ChangeLoginIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTokenRequest) ProtoMessage() {}
+func (*ChangeLoginIDRequest) ProtoMessage() {}
 
-func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeLoginIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,7 +458,7 @@ func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GenerateTokenRequest) GetLoginId() string {
+func (x *ChangeLoginIDRequest) GetLoginId() string {
 	if x != nil {
 		if x.xxx_hidden_LoginId != nil {
 			return *x.xxx_hidden_LoginId
@@ -583,7 +468,7 @@ func (x *GenerateTokenRequest) GetLoginId() string {
 	return ""
 }
 
-func (x *GenerateTokenRequest) GetPassword() string {
+func (x *ChangeLoginIDRequest) GetPassword() string {
 	if x != nil {
 		if x.xxx_hidden_Password != nil {
 			return *x.xxx_hidden_Password
@@ -593,49 +478,50 @@ func (x *GenerateTokenRequest) GetPassword() string {
 	return ""
 }
 
-func (x *GenerateTokenRequest) SetLoginId(v string) {
+func (x *ChangeLoginIDRequest) SetLoginId(v string) {
 	x.xxx_hidden_LoginId = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *GenerateTokenRequest) SetPassword(v string) {
+func (x *ChangeLoginIDRequest) SetPassword(v string) {
 	x.xxx_hidden_Password = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (x *GenerateTokenRequest) HasLoginId() bool {
+func (x *ChangeLoginIDRequest) HasLoginId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GenerateTokenRequest) HasPassword() bool {
+func (x *ChangeLoginIDRequest) HasPassword() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *GenerateTokenRequest) ClearLoginId() {
+func (x *ChangeLoginIDRequest) ClearLoginId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_LoginId = nil
 }
 
-func (x *GenerateTokenRequest) ClearPassword() {
+func (x *ChangeLoginIDRequest) ClearPassword() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_Password = nil
 }
 
-type GenerateTokenRequest_builder struct {
+type ChangeLoginIDRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	LoginId  *string
+	LoginId *string
+	// Empty for customers without a password, who logged in with an identity provider recently
 	Password *string
 }
 
-func (b0 GenerateTokenRequest_builder) Build() *GenerateTokenRequest {
-	m0 := &GenerateTokenRequest{}
+func (b0 ChangeLoginIDRequest_builder) Build() *ChangeLoginIDRequest {
+	m0 := &ChangeLoginIDRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.LoginId != nil {
@@ -649,31 +535,30 @@ func (b0 GenerateTokenRequest_builder) Build() *GenerateTokenRequest {
 	return m0
 }
 
-type GenerateTokenResponse struct {
-	state                   protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_AccessToken  *string                `protobuf:"bytes,1,opt,name=access_token,json=accessToken"`
-	xxx_hidden_RefreshToken *string                `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken"`
-	xxx_hidden_ExpiresIn    int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn"`
-	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
-	XXX_presence            [1]uint32
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type ChangeEmailRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Email       *string                `protobuf:"bytes,1,opt,name=email"`
+	xxx_hidden_Password    *string                `protobuf:"bytes,2,opt,name=password"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GenerateTokenResponse) Reset() {
-	*x = GenerateTokenResponse{}
+func (x *ChangeEmailRequest) Reset() {
+	*x = ChangeEmailRequest{}
 	mi := &file_restaurant_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateTokenResponse) String() string {
+func (x *ChangeEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTokenResponse) ProtoMessage() {}
+func (*ChangeEmailRequest) ProtoMessage() {}
 
-func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangeEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -685,132 +570,107 @@ func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GenerateTokenResponse) GetAccessToken() string {
+func (x *ChangeEmailRequest) GetEmail() string {
 	if x != nil {
-		if x.xxx_hidden_AccessToken != nil {
-			return *x.xxx_hidden_AccessToken
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *GenerateTokenResponse) GetRefreshToken() string {
+func (x *ChangeEmailRequest) GetPassword() string {
 	if x != nil {
-		if x.xxx_hidden_RefreshToken != nil {
-			return *x.xxx_hidden_RefreshToken
+		if x.xxx_hidden_Password != nil {
+			return *x.xxx_hidden_Password
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *GenerateTokenResponse) GetExpiresIn() int64 {
-	if x != nil {
-		return x.xxx_hidden_ExpiresIn
-	}
-	return 0
-}
-
-func (x *GenerateTokenResponse) SetAccessToken(v string) {
-	x.xxx_hidden_AccessToken = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
-}
-
-func (x *GenerateTokenResponse) SetRefreshToken(v string) {
-	x.xxx_hidden_RefreshToken = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+func (x *ChangeEmailRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *GenerateTokenResponse) SetExpiresIn(v int64) {
-	x.xxx_hidden_ExpiresIn = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+func (x *ChangeEmailRequest) SetPassword(v string) {
+	x.xxx_hidden_Password = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (x *GenerateTokenResponse) HasAccessToken() bool {
+func (x *ChangeEmailRequest) HasEmail() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GenerateTokenResponse) HasRefreshToken() bool {
+func (x *ChangeEmailRequest) HasPassword() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *GenerateTokenResponse) HasExpiresIn() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
-}
-
-func (x *GenerateTokenResponse) ClearAccessToken() {
+func (x *ChangeEmailRequest) ClearEmail() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_AccessToken = nil
+	x.xxx_hidden_Email = nil
 }
 
-func (x *GenerateTokenResponse) ClearRefreshToken() {
+func (x *ChangeEmailRequest) ClearPassword() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_RefreshToken = nil
-}
-
-func (x *GenerateTokenResponse) ClearExpiresIn() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_ExpiresIn = 0
+	x.xxx_hidden_Password = nil
 }
 
-type GenerateTokenResponse_builder struct {
+type ChangeEmailRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	AccessToken  *string
-	RefreshToken *string
-	ExpiresIn    *int64
+	Email *string
+	// Empty for customers without a password, who logged in with an identity provider recently
+	Password *string
 }
 
-func (b0 GenerateTokenResponse_builder) Build() *GenerateTokenResponse {
-	m0 := &GenerateTokenResponse{}
+func (b0 ChangeEmailRequest_builder) Build() *ChangeEmailRequest {
+	m0 := &ChangeEmailRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.AccessToken != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
-		x.xxx_hidden_AccessToken = b.AccessToken
-	}
-	if b.RefreshToken != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
-		x.xxx_hidden_RefreshToken = b.RefreshToken
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Email = b.Email
 	}
-	if b.ExpiresIn != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
-		x.xxx_hidden_ExpiresIn = *b.ExpiresIn
+	if b.Password != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Password = b.Password
 	}
 	return m0
 }
 
-type CreateMenuItemRequest struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_MenuItem *MenuItem              `protobuf:"bytes,1,opt,name=menu_item,json=menuItem"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ChangePasswordRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CurrentPassword *string                `protobuf:"bytes,1,opt,name=current_password,json=currentPassword"`
+	xxx_hidden_NewPassword     *string                `protobuf:"bytes,2,opt,name=new_password,json=newPassword"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
-func (x *CreateMenuItemRequest) Reset() {
-	*x = CreateMenuItemRequest{}
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
 	mi := &file_restaurant_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMenuItemRequest) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMenuItemRequest) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *CreateMenuItemRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -822,65 +682,106 @@ func (x *CreateMenuItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateMenuItemRequest) GetMenuItem() *MenuItem {
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
 	if x != nil {
-		return x.xxx_hidden_MenuItem
+		if x.xxx_hidden_CurrentPassword != nil {
+			return *x.xxx_hidden_CurrentPassword
+		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateMenuItemRequest) SetMenuItem(v *MenuItem) {
-	x.xxx_hidden_MenuItem = v
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		if x.xxx_hidden_NewPassword != nil {
+			return *x.xxx_hidden_NewPassword
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *CreateMenuItemRequest) HasMenuItem() bool {
+func (x *ChangePasswordRequest) SetCurrentPassword(v string) {
+	x.xxx_hidden_CurrentPassword = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ChangePasswordRequest) SetNewPassword(v string) {
+	x.xxx_hidden_NewPassword = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ChangePasswordRequest) HasCurrentPassword() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_MenuItem != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *CreateMenuItemRequest) ClearMenuItem() {
-	x.xxx_hidden_MenuItem = nil
+func (x *ChangePasswordRequest) HasNewPassword() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-type CreateMenuItemRequest_builder struct {
+func (x *ChangePasswordRequest) ClearCurrentPassword() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CurrentPassword = nil
+}
+
+func (x *ChangePasswordRequest) ClearNewPassword() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NewPassword = nil
+}
+
+type ChangePasswordRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	MenuItem *MenuItem
+	// Empty for customers without a password, who logged in with an identity provider recently
+	CurrentPassword *string
+	NewPassword     *string
 }
 
-func (b0 CreateMenuItemRequest_builder) Build() *CreateMenuItemRequest {
-	m0 := &CreateMenuItemRequest{}
+func (b0 ChangePasswordRequest_builder) Build() *ChangePasswordRequest {
+	m0 := &ChangePasswordRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_MenuItem = b.MenuItem
+	if b.CurrentPassword != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_CurrentPassword = b.CurrentPassword
+	}
+	if b.NewPassword != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NewPassword = b.NewPassword
+	}
 	return m0
 }
 
-type GetMenuItemRequest struct {
+type DeleteMyAccountRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Password    *string                `protobuf:"bytes,1,opt,name=password"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetMenuItemRequest) Reset() {
-	*x = GetMenuItemRequest{}
+func (x *DeleteMyAccountRequest) Reset() {
+	*x = DeleteMyAccountRequest{}
 	mi := &file_restaurant_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMenuItemRequest) String() string {
+func (x *DeleteMyAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMenuItemRequest) ProtoMessage() {}
+func (*DeleteMyAccountRequest) ProtoMessage() {}
 
-func (x *GetMenuItemRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteMyAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -892,71 +793,74 @@ func (x *GetMenuItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetMenuItemRequest) GetId() string {
+func (x *DeleteMyAccountRequest) GetPassword() string {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
+		if x.xxx_hidden_Password != nil {
+			return *x.xxx_hidden_Password
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *GetMenuItemRequest) SetId(v string) {
-	x.xxx_hidden_Id = &v
+func (x *DeleteMyAccountRequest) SetPassword(v string) {
+	x.xxx_hidden_Password = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *GetMenuItemRequest) HasId() bool {
+func (x *DeleteMyAccountRequest) HasPassword() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GetMenuItemRequest) ClearId() {
+func (x *DeleteMyAccountRequest) ClearPassword() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_Password = nil
 }
 
-type GetMenuItemRequest_builder struct {
+type DeleteMyAccountRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	// Empty for customers without a password, who logged in with an identity provider recently
+	Password *string
 }
 
-func (b0 GetMenuItemRequest_builder) Build() *GetMenuItemRequest {
-	m0 := &GetMenuItemRequest{}
+func (b0 DeleteMyAccountRequest_builder) Build() *DeleteMyAccountRequest {
+	m0 := &DeleteMyAccountRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
+	if b.Password != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
+		x.xxx_hidden_Password = b.Password
 	}
 	return m0
 }
 
-type ListMenuItemsResponse struct {
-	state            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Items *[]*MenuItem           `protobuf:"bytes,1,rep,name=items"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ExportMyDataResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Document    *string                `protobuf:"bytes,1,opt,name=document"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *ListMenuItemsResponse) Reset() {
-	*x = ListMenuItemsResponse{}
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
 	mi := &file_restaurant_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMenuItemsResponse) String() string {
+func (x *ExportMyDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMenuItemsResponse) ProtoMessage() {}
+func (*ExportMyDataResponse) ProtoMessage() {}
 
-func (x *ListMenuItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,54 +872,76 @@ func (x *ListMenuItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListMenuItemsResponse) GetItems() []*MenuItem {
+func (x *ExportMyDataResponse) GetDocument() string {
 	if x != nil {
-		if x.xxx_hidden_Items != nil {
-			return *x.xxx_hidden_Items
+		if x.xxx_hidden_Document != nil {
+			return *x.xxx_hidden_Document
 		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMenuItemsResponse) SetItems(v []*MenuItem) {
-	x.xxx_hidden_Items = &v
+func (x *ExportMyDataResponse) SetDocument(v string) {
+	x.xxx_hidden_Document = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-type ListMenuItemsResponse_builder struct {
+func (x *ExportMyDataResponse) HasDocument() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ExportMyDataResponse) ClearDocument() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Document = nil
+}
+
+type ExportMyDataResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Items []*MenuItem
+	// document is the JSON encoded profile, favorites, visited tabs and ordered items of the customer
+	Document *string
 }
 
-func (b0 ListMenuItemsResponse_builder) Build() *ListMenuItemsResponse {
-	m0 := &ListMenuItemsResponse{}
+func (b0 ExportMyDataResponse_builder) Build() *ExportMyDataResponse {
+	m0 := &ExportMyDataResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Items = &b.Items
+	if b.Document != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Document = b.Document
+	}
 	return m0
 }
 
-type UpdateMenuItemRequest struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_MenuItem *MenuItem              `protobuf:"bytes,1,opt,name=menu_item,json=menuItem"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type SaveFavoriteRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MenuItemId  *string                `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Modifiers   []byte                 `protobuf:"bytes,3,opt,name=modifiers"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *UpdateMenuItemRequest) Reset() {
-	*x = UpdateMenuItemRequest{}
+func (x *SaveFavoriteRequest) Reset() {
+	*x = SaveFavoriteRequest{}
 	mi := &file_restaurant_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMenuItemRequest) String() string {
+func (x *SaveFavoriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMenuItemRequest) ProtoMessage() {}
+func (*SaveFavoriteRequest) ProtoMessage() {}
 
-func (x *UpdateMenuItemRequest) ProtoReflect() protoreflect.Message {
+func (x *SaveFavoriteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1027,43 +953,115 @@ func (x *UpdateMenuItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateMenuItemRequest) GetMenuItem() *MenuItem {
+func (x *SaveFavoriteRequest) GetMenuItemId() string {
 	if x != nil {
-		return x.xxx_hidden_MenuItem
+		if x.xxx_hidden_MenuItemId != nil {
+			return *x.xxx_hidden_MenuItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SaveFavoriteRequest) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SaveFavoriteRequest) GetModifiers() []byte {
+	if x != nil {
+		return x.xxx_hidden_Modifiers
 	}
 	return nil
 }
 
-func (x *UpdateMenuItemRequest) SetMenuItem(v *MenuItem) {
-	x.xxx_hidden_MenuItem = v
+func (x *SaveFavoriteRequest) SetMenuItemId(v string) {
+	x.xxx_hidden_MenuItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-func (x *UpdateMenuItemRequest) HasMenuItem() bool {
+func (x *SaveFavoriteRequest) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *SaveFavoriteRequest) SetModifiers(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Modifiers = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *SaveFavoriteRequest) HasMenuItemId() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_MenuItem != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UpdateMenuItemRequest) ClearMenuItem() {
-	x.xxx_hidden_MenuItem = nil
+func (x *SaveFavoriteRequest) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-type UpdateMenuItemRequest_builder struct {
+func (x *SaveFavoriteRequest) HasModifiers() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SaveFavoriteRequest) ClearMenuItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MenuItemId = nil
+}
+
+func (x *SaveFavoriteRequest) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *SaveFavoriteRequest) ClearModifiers() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Modifiers = nil
+}
+
+type SaveFavoriteRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	MenuItem *MenuItem
+	MenuItemId *string
+	Name       *string
+	Modifiers  []byte
 }
 
-func (b0 UpdateMenuItemRequest_builder) Build() *UpdateMenuItemRequest {
-	m0 := &UpdateMenuItemRequest{}
+func (b0 SaveFavoriteRequest_builder) Build() *SaveFavoriteRequest {
+	m0 := &SaveFavoriteRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_MenuItem = b.MenuItem
+	if b.MenuItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_MenuItemId = b.MenuItemId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Modifiers != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Modifiers = b.Modifiers
+	}
 	return m0
 }
 
-type DeleteMenuItemRequest struct {
+type DeleteFavoriteRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
@@ -1072,20 +1070,20 @@ type DeleteMenuItemRequest struct {
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *DeleteMenuItemRequest) Reset() {
-	*x = DeleteMenuItemRequest{}
+func (x *DeleteFavoriteRequest) Reset() {
+	*x = DeleteFavoriteRequest{}
 	mi := &file_restaurant_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMenuItemRequest) String() string {
+func (x *DeleteFavoriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMenuItemRequest) ProtoMessage() {}
+func (*DeleteFavoriteRequest) ProtoMessage() {}
 
-func (x *DeleteMenuItemRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteFavoriteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1097,7 +1095,7 @@ func (x *DeleteMenuItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteMenuItemRequest) GetId() string {
+func (x *DeleteFavoriteRequest) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -1107,31 +1105,31 @@ func (x *DeleteMenuItemRequest) GetId() string {
 	return ""
 }
 
-func (x *DeleteMenuItemRequest) SetId(v string) {
+func (x *DeleteFavoriteRequest) SetId(v string) {
 	x.xxx_hidden_Id = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *DeleteMenuItemRequest) HasId() bool {
+func (x *DeleteFavoriteRequest) HasId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *DeleteMenuItemRequest) ClearId() {
+func (x *DeleteFavoriteRequest) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
 }
 
-type DeleteMenuItemRequest_builder struct {
+type DeleteFavoriteRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Id *string
 }
 
-func (b0 DeleteMenuItemRequest_builder) Build() *DeleteMenuItemRequest {
-	m0 := &DeleteMenuItemRequest{}
+func (b0 DeleteFavoriteRequest_builder) Build() *DeleteFavoriteRequest {
+	m0 := &DeleteFavoriteRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
@@ -1141,34 +1139,27 @@ func (b0 DeleteMenuItemRequest_builder) Build() *DeleteMenuItemRequest {
 	return m0
 }
 
-type CreateOrderItemRequest struct {
-	state                       protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_OrderId          *string                `protobuf:"bytes,1,opt,name=order_id,json=orderId"`
-	xxx_hidden_MenuItemId       *string                `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId"`
-	xxx_hidden_Quantity         int32                  `protobuf:"varint,3,opt,name=quantity"`
-	xxx_hidden_Modifiers        []byte                 `protobuf:"bytes,4,opt,name=modifiers"`
-	xxx_hidden_GuestOwnerIds    []string               `protobuf:"bytes,5,rep,name=guest_owner_ids,json=guestOwnerIds"`
-	xxx_hidden_CustomerOwnerIds []string               `protobuf:"bytes,6,rep,name=customer_owner_ids,json=customerOwnerIds"`
-	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
-	XXX_presence                [1]uint32
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type ListFavoritesResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Favorites *[]*Favorite           `protobuf:"bytes,1,rep,name=favorites"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *CreateOrderItemRequest) Reset() {
-	*x = CreateOrderItemRequest{}
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
 	mi := &file_restaurant_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderItemRequest) String() string {
+func (x *ListFavoritesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderItemRequest) ProtoMessage() {}
+func (*ListFavoritesResponse) ProtoMessage() {}
 
-func (x *CreateOrderItemRequest) ProtoReflect() protoreflect.Message {
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1180,17 +1171,85 @@ func (x *CreateOrderItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateOrderItemRequest) GetOrderId() string {
+func (x *ListFavoritesResponse) GetFavorites() []*Favorite {
 	if x != nil {
-		if x.xxx_hidden_OrderId != nil {
-			return *x.xxx_hidden_OrderId
+		if x.xxx_hidden_Favorites != nil {
+			return *x.xxx_hidden_Favorites
 		}
-		return ""
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOrderItemRequest) GetMenuItemId() string {
+func (x *ListFavoritesResponse) SetFavorites(v []*Favorite) {
+	x.xxx_hidden_Favorites = &v
+}
+
+type ListFavoritesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Favorites []*Favorite
+}
+
+func (b0 ListFavoritesResponse_builder) Build() *ListFavoritesResponse {
+	m0 := &ListFavoritesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Favorites = &b.Favorites
+	return m0
+}
+
+type Favorite struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_MenuItemId   *string                `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId"`
+	xxx_hidden_MenuItemName *string                `protobuf:"bytes,3,opt,name=menu_item_name,json=menuItemName"`
+	xxx_hidden_Name         *string                `protobuf:"bytes,4,opt,name=name"`
+	xxx_hidden_Modifiers    []byte                 `protobuf:"bytes,5,opt,name=modifiers"`
+	xxx_hidden_Outdated     bool                   `protobuf:"varint,6,opt,name=outdated"`
+	xxx_hidden_CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Favorite) Reset() {
+	*x = Favorite{}
+	mi := &file_restaurant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Favorite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Favorite) ProtoMessage() {}
+
+func (x *Favorite) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Favorite) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Favorite) GetMenuItemId() string {
 	if x != nil {
 		if x.xxx_hidden_MenuItemId != nil {
 			return *x.xxx_hidden_MenuItemId
@@ -1200,173 +1259,268 @@ func (x *CreateOrderItemRequest) GetMenuItemId() string {
 	return ""
 }
 
-func (x *CreateOrderItemRequest) GetQuantity() int32 {
+func (x *Favorite) GetMenuItemName() string {
 	if x != nil {
-		return x.xxx_hidden_Quantity
+		if x.xxx_hidden_MenuItemName != nil {
+			return *x.xxx_hidden_MenuItemName
+		}
+		return ""
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateOrderItemRequest) GetModifiers() []byte {
+func (x *Favorite) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Favorite) GetModifiers() []byte {
 	if x != nil {
 		return x.xxx_hidden_Modifiers
 	}
 	return nil
 }
 
-func (x *CreateOrderItemRequest) GetGuestOwnerIds() []string {
+func (x *Favorite) GetOutdated() bool {
 	if x != nil {
-		return x.xxx_hidden_GuestOwnerIds
+		return x.xxx_hidden_Outdated
+	}
+	return false
+}
+
+func (x *Favorite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
 	}
 	return nil
 }
 
-func (x *CreateOrderItemRequest) GetCustomerOwnerIds() []string {
+func (x *Favorite) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.xxx_hidden_CustomerOwnerIds
+		return x.xxx_hidden_UpdatedAt
 	}
 	return nil
 }
 
-func (x *CreateOrderItemRequest) SetOrderId(v string) {
-	x.xxx_hidden_OrderId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+func (x *Favorite) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
 }
 
-func (x *CreateOrderItemRequest) SetMenuItemId(v string) {
+func (x *Favorite) SetMenuItemId(v string) {
 	x.xxx_hidden_MenuItemId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
 }
 
-func (x *CreateOrderItemRequest) SetQuantity(v int32) {
-	x.xxx_hidden_Quantity = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+func (x *Favorite) SetMenuItemName(v string) {
+	x.xxx_hidden_MenuItemName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
 }
 
-func (x *CreateOrderItemRequest) SetModifiers(v []byte) {
+func (x *Favorite) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *Favorite) SetModifiers(v []byte) {
 	if v == nil {
 		v = []byte{}
 	}
 	x.xxx_hidden_Modifiers = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
 }
 
-func (x *CreateOrderItemRequest) SetGuestOwnerIds(v []string) {
-	x.xxx_hidden_GuestOwnerIds = v
+func (x *Favorite) SetOutdated(v bool) {
+	x.xxx_hidden_Outdated = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
 }
 
-func (x *CreateOrderItemRequest) SetCustomerOwnerIds(v []string) {
-	x.xxx_hidden_CustomerOwnerIds = v
+func (x *Favorite) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
 }
 
-func (x *CreateOrderItemRequest) HasOrderId() bool {
+func (x *Favorite) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *Favorite) HasId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *CreateOrderItemRequest) HasMenuItemId() bool {
+func (x *Favorite) HasMenuItemId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *CreateOrderItemRequest) HasQuantity() bool {
+func (x *Favorite) HasMenuItemName() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *CreateOrderItemRequest) HasModifiers() bool {
+func (x *Favorite) HasName() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *CreateOrderItemRequest) ClearOrderId() {
+func (x *Favorite) HasModifiers() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Favorite) HasOutdated() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Favorite) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Favorite) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *Favorite) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_OrderId = nil
+	x.xxx_hidden_Id = nil
 }
 
-func (x *CreateOrderItemRequest) ClearMenuItemId() {
+func (x *Favorite) ClearMenuItemId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_MenuItemId = nil
 }
 
-func (x *CreateOrderItemRequest) ClearQuantity() {
+func (x *Favorite) ClearMenuItemName() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Quantity = 0
+	x.xxx_hidden_MenuItemName = nil
 }
 
-func (x *CreateOrderItemRequest) ClearModifiers() {
+func (x *Favorite) ClearName() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Favorite) ClearModifiers() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
 	x.xxx_hidden_Modifiers = nil
 }
 
-type CreateOrderItemRequest_builder struct {
+func (x *Favorite) ClearOutdated() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Outdated = false
+}
+
+func (x *Favorite) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Favorite) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type Favorite_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	OrderId          *string
-	MenuItemId       *string
-	Quantity         *int32
-	Modifiers        []byte
-	GuestOwnerIds    []string
-	CustomerOwnerIds []string
+	Id           *string
+	MenuItemId   *string
+	MenuItemName *string
+	Name         *string
+	Modifiers    []byte
+	// Outdated favorites can not be ordered, as their menu item was deleted or its
+	// modifiers config no longer accepts the modifiers
+	Outdated  *bool
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt *timestamppb.Timestamp
 }
 
-func (b0 CreateOrderItemRequest_builder) Build() *CreateOrderItemRequest {
-	m0 := &CreateOrderItemRequest{}
+func (b0 Favorite_builder) Build() *Favorite {
+	m0 := &Favorite{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.OrderId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
-		x.xxx_hidden_OrderId = b.OrderId
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		x.xxx_hidden_Id = b.Id
 	}
 	if b.MenuItemId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
 		x.xxx_hidden_MenuItemId = b.MenuItemId
 	}
-	if b.Quantity != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
-		x.xxx_hidden_Quantity = *b.Quantity
+	if b.MenuItemName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_MenuItemName = b.MenuItemName
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_Name = b.Name
 	}
 	if b.Modifiers != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
 		x.xxx_hidden_Modifiers = b.Modifiers
 	}
-	x.xxx_hidden_GuestOwnerIds = b.GuestOwnerIds
-	x.xxx_hidden_CustomerOwnerIds = b.CustomerOwnerIds
+	if b.Outdated != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
+		x.xxx_hidden_Outdated = *b.Outdated
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	return m0
 }
 
-type OrderItemID struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type Customer struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id            *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Name          *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Email         *string                `protobuf:"bytes,3,opt,name=email"`
+	xxx_hidden_PhoneNumber   *string                `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber"`
+	xxx_hidden_CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_LoginId       *string                `protobuf:"bytes,7,opt,name=login_id,json=loginId"`
+	xxx_hidden_EmailVerified bool                   `protobuf:"varint,8,opt,name=email_verified,json=emailVerified"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *OrderItemID) Reset() {
-	*x = OrderItemID{}
-	mi := &file_restaurant_proto_msgTypes[11]
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_restaurant_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItemID) String() string {
+func (x *Customer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItemID) ProtoMessage() {}
+func (*Customer) ProtoMessage() {}
 
-func (x *OrderItemID) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[11]
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,7 +1531,7 @@ func (x *OrderItemID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *OrderItemID) GetId() string {
+func (x *Customer) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -1387,142 +1541,269 @@ func (x *OrderItemID) GetId() string {
 	return ""
 }
 
-func (x *OrderItemID) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *Customer) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *OrderItemID) HasId() bool {
-	if x == nil {
-		return false
+func (x *Customer) GetEmail() string {
+	if x != nil {
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
+		}
+		return ""
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return ""
 }
 
-func (x *OrderItemID) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *Customer) GetPhoneNumber() string {
+	if x != nil {
+		if x.xxx_hidden_PhoneNumber != nil {
+			return *x.xxx_hidden_PhoneNumber
+		}
+		return ""
+	}
+	return ""
 }
 
-type OrderItemID_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	Id *string
+func (x *Customer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
 }
 
-func (b0 OrderItemID_builder) Build() *OrderItemID {
-	m0 := &OrderItemID{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
+func (x *Customer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
 	}
-	return m0
+	return nil
 }
 
-type DeleteOrderItemRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *Customer) GetLoginId() string {
+	if x != nil {
+		if x.xxx_hidden_LoginId != nil {
+			return *x.xxx_hidden_LoginId
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *DeleteOrderItemRequest) Reset() {
-	*x = DeleteOrderItemRequest{}
-	mi := &file_restaurant_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Customer) GetEmailVerified() bool {
+	if x != nil {
+		return x.xxx_hidden_EmailVerified
+	}
+	return false
 }
 
-func (x *DeleteOrderItemRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Customer) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
 }
 
-func (*DeleteOrderItemRequest) ProtoMessage() {}
+func (x *Customer) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+}
 
-func (x *DeleteOrderItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Customer) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
+}
+
+func (x *Customer) SetPhoneNumber(v string) {
+	x.xxx_hidden_PhoneNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+}
+
+func (x *Customer) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Customer) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *Customer) SetLoginId(v string) {
+	x.xxx_hidden_LoginId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *Customer) SetEmailVerified(v bool) {
+	x.xxx_hidden_EmailVerified = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+func (x *Customer) HasId() bool {
+	if x == nil {
+		return false
 	}
-	return mi.MessageOf(x)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *DeleteOrderItemRequest) GetId() string {
-	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
+func (x *Customer) HasName() bool {
+	if x == nil {
+		return false
 	}
-	return ""
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *DeleteOrderItemRequest) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *Customer) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *DeleteOrderItemRequest) HasId() bool {
+func (x *Customer) HasPhoneNumber() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *DeleteOrderItemRequest) ClearId() {
+func (x *Customer) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Customer) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *Customer) HasLoginId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Customer) HasEmailVerified() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Customer) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
 }
 
-type DeleteOrderItemRequest_builder struct {
+func (x *Customer) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Customer) ClearEmail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Email = nil
+}
+
+func (x *Customer) ClearPhoneNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_PhoneNumber = nil
+}
+
+func (x *Customer) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Customer) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *Customer) ClearLoginId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_LoginId = nil
+}
+
+func (x *Customer) ClearEmailVerified() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_EmailVerified = false
+}
+
+type Customer_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	Id            *string
+	Name          *string
+	Email         *string
+	PhoneNumber   *string
+	CreatedAt     *timestamppb.Timestamp
+	UpdatedAt     *timestamppb.Timestamp
+	LoginId       *string
+	EmailVerified *bool
 }
 
-func (b0 DeleteOrderItemRequest_builder) Build() *DeleteOrderItemRequest {
-	m0 := &DeleteOrderItemRequest{}
+func (b0 Customer_builder) Build() *Customer {
+	m0 := &Customer{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
 		x.xxx_hidden_Id = b.Id
 	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
+		x.xxx_hidden_Email = b.Email
+	}
+	if b.PhoneNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
+		x.xxx_hidden_PhoneNumber = b.PhoneNumber
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	if b.LoginId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_LoginId = b.LoginId
+	}
+	if b.EmailVerified != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_EmailVerified = *b.EmailVerified
+	}
 	return m0
 }
 
-type UpdateOrderItemModifiersRequest struct {
+type GenerateTokenRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
-	xxx_hidden_Modifiers   []byte                 `protobuf:"bytes,2,opt,name=modifiers"`
+	xxx_hidden_LoginId     *string                `protobuf:"bytes,1,opt,name=login_id,json=loginId"`
+	xxx_hidden_Password    *string                `protobuf:"bytes,2,opt,name=password"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *UpdateOrderItemModifiersRequest) Reset() {
-	*x = UpdateOrderItemModifiersRequest{}
+func (x *GenerateTokenRequest) Reset() {
+	*x = GenerateTokenRequest{}
 	mi := &file_restaurant_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderItemModifiersRequest) String() string {
+func (x *GenerateTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderItemModifiersRequest) ProtoMessage() {}
+func (*GenerateTokenRequest) ProtoMessage() {}
 
-func (x *UpdateOrderItemModifiersRequest) ProtoReflect() protoreflect.Message {
+func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1534,106 +1815,107 @@ func (x *UpdateOrderItemModifiersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateOrderItemModifiersRequest) GetOrderItemId() string {
+func (x *GenerateTokenRequest) GetLoginId() string {
 	if x != nil {
-		if x.xxx_hidden_OrderItemId != nil {
-			return *x.xxx_hidden_OrderItemId
+		if x.xxx_hidden_LoginId != nil {
+			return *x.xxx_hidden_LoginId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *UpdateOrderItemModifiersRequest) GetModifiers() []byte {
+func (x *GenerateTokenRequest) GetPassword() string {
 	if x != nil {
-		return x.xxx_hidden_Modifiers
+		if x.xxx_hidden_Password != nil {
+			return *x.xxx_hidden_Password
+		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateOrderItemModifiersRequest) SetOrderItemId(v string) {
-	x.xxx_hidden_OrderItemId = &v
+func (x *GenerateTokenRequest) SetLoginId(v string) {
+	x.xxx_hidden_LoginId = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *UpdateOrderItemModifiersRequest) SetModifiers(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
-	x.xxx_hidden_Modifiers = v
+func (x *GenerateTokenRequest) SetPassword(v string) {
+	x.xxx_hidden_Password = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (x *UpdateOrderItemModifiersRequest) HasOrderItemId() bool {
+func (x *GenerateTokenRequest) HasLoginId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UpdateOrderItemModifiersRequest) HasModifiers() bool {
+func (x *GenerateTokenRequest) HasPassword() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *UpdateOrderItemModifiersRequest) ClearOrderItemId() {
+func (x *GenerateTokenRequest) ClearLoginId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_OrderItemId = nil
+	x.xxx_hidden_LoginId = nil
 }
 
-func (x *UpdateOrderItemModifiersRequest) ClearModifiers() {
+func (x *GenerateTokenRequest) ClearPassword() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Modifiers = nil
+	x.xxx_hidden_Password = nil
 }
 
-type UpdateOrderItemModifiersRequest_builder struct {
+type GenerateTokenRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	OrderItemId *string
-	Modifiers   []byte
+	LoginId  *string
+	Password *string
 }
 
-func (b0 UpdateOrderItemModifiersRequest_builder) Build() *UpdateOrderItemModifiersRequest {
-	m0 := &UpdateOrderItemModifiersRequest{}
+func (b0 GenerateTokenRequest_builder) Build() *GenerateTokenRequest {
+	m0 := &GenerateTokenRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.OrderItemId != nil {
+	if b.LoginId != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_OrderItemId = b.OrderItemId
+		x.xxx_hidden_LoginId = b.LoginId
 	}
-	if b.Modifiers != nil {
+	if b.Password != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_Modifiers = b.Modifiers
+		x.xxx_hidden_Password = b.Password
 	}
 	return m0
 }
 
-type UpdateOrderItemQuantityRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
-	xxx_hidden_Quantity    int32                  `protobuf:"varint,2,opt,name=quantity"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *UpdateOrderItemQuantityRequest) Reset() {
-	*x = UpdateOrderItemQuantityRequest{}
-	mi := &file_restaurant_proto_msgTypes[14]
+type GenerateTokenResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AccessToken  *string                `protobuf:"bytes,1,opt,name=access_token,json=accessToken"`
+	xxx_hidden_RefreshToken *string                `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken"`
+	xxx_hidden_ExpiresIn    int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GenerateTokenResponse) Reset() {
+	*x = GenerateTokenResponse{}
+	mi := &file_restaurant_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderItemQuantityRequest) String() string {
+func (x *GenerateTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderItemQuantityRequest) ProtoMessage() {}
+func (*GenerateTokenResponse) ProtoMessage() {}
 
-func (x *UpdateOrderItemQuantityRequest) ProtoReflect() protoreflect.Message {
+func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1645,103 +1927,134 @@ func (x *UpdateOrderItemQuantityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateOrderItemQuantityRequest) GetOrderItemId() string {
+func (x *GenerateTokenResponse) GetAccessToken() string {
 	if x != nil {
-		if x.xxx_hidden_OrderItemId != nil {
-			return *x.xxx_hidden_OrderItemId
+		if x.xxx_hidden_AccessToken != nil {
+			return *x.xxx_hidden_AccessToken
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *UpdateOrderItemQuantityRequest) GetQuantity() int32 {
+func (x *GenerateTokenResponse) GetRefreshToken() string {
 	if x != nil {
-		return x.xxx_hidden_Quantity
+		if x.xxx_hidden_RefreshToken != nil {
+			return *x.xxx_hidden_RefreshToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GenerateTokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.xxx_hidden_ExpiresIn
 	}
 	return 0
 }
 
-func (x *UpdateOrderItemQuantityRequest) SetOrderItemId(v string) {
-	x.xxx_hidden_OrderItemId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+func (x *GenerateTokenResponse) SetAccessToken(v string) {
+	x.xxx_hidden_AccessToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-func (x *UpdateOrderItemQuantityRequest) SetQuantity(v int32) {
-	x.xxx_hidden_Quantity = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *GenerateTokenResponse) SetRefreshToken(v string) {
+	x.xxx_hidden_RefreshToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *UpdateOrderItemQuantityRequest) HasOrderItemId() bool {
+func (x *GenerateTokenResponse) SetExpiresIn(v int64) {
+	x.xxx_hidden_ExpiresIn = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *GenerateTokenResponse) HasAccessToken() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UpdateOrderItemQuantityRequest) HasQuantity() bool {
+func (x *GenerateTokenResponse) HasRefreshToken() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *UpdateOrderItemQuantityRequest) ClearOrderItemId() {
+func (x *GenerateTokenResponse) HasExpiresIn() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GenerateTokenResponse) ClearAccessToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_OrderItemId = nil
+	x.xxx_hidden_AccessToken = nil
 }
 
-func (x *UpdateOrderItemQuantityRequest) ClearQuantity() {
+func (x *GenerateTokenResponse) ClearRefreshToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Quantity = 0
+	x.xxx_hidden_RefreshToken = nil
 }
 
-type UpdateOrderItemQuantityRequest_builder struct {
+func (x *GenerateTokenResponse) ClearExpiresIn() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_ExpiresIn = 0
+}
+
+type GenerateTokenResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	OrderItemId *string
-	Quantity    *int32
+	AccessToken  *string
+	RefreshToken *string
+	ExpiresIn    *int64
 }
 
-func (b0 UpdateOrderItemQuantityRequest_builder) Build() *UpdateOrderItemQuantityRequest {
-	m0 := &UpdateOrderItemQuantityRequest{}
+func (b0 GenerateTokenResponse_builder) Build() *GenerateTokenResponse {
+	m0 := &GenerateTokenResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.OrderItemId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_OrderItemId = b.OrderItemId
+	if b.AccessToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_AccessToken = b.AccessToken
 	}
-	if b.Quantity != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_Quantity = *b.Quantity
+	if b.RefreshToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_RefreshToken = b.RefreshToken
+	}
+	if b.ExpiresIn != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_ExpiresIn = *b.ExpiresIn
 	}
 	return m0
 }
 
-type AddOrderItemGuestOwnerRequest struct {
+type VerifyEmailRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
-	xxx_hidden_GuestId     *string                `protobuf:"bytes,2,opt,name=guest_id,json=guestId"`
+	xxx_hidden_Token       *string                `protobuf:"bytes,1,opt,name=token"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *AddOrderItemGuestOwnerRequest) Reset() {
-	*x = AddOrderItemGuestOwnerRequest{}
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
 	mi := &file_restaurant_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOrderItemGuestOwnerRequest) String() string {
+func (x *VerifyEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrderItemGuestOwnerRequest) ProtoMessage() {}
+func (*VerifyEmailRequest) ProtoMessage() {}
 
-func (x *AddOrderItemGuestOwnerRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1753,218 +2066,5361 @@ func (x *AddOrderItemGuestOwnerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *AddOrderItemGuestOwnerRequest) GetOrderItemId() string {
+func (x *VerifyEmailRequest) GetToken() string {
 	if x != nil {
-		if x.xxx_hidden_OrderItemId != nil {
-			return *x.xxx_hidden_OrderItemId
+		if x.xxx_hidden_Token != nil {
+			return *x.xxx_hidden_Token
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *AddOrderItemGuestOwnerRequest) GetGuestId() string {
+func (x *VerifyEmailRequest) SetToken(v string) {
+	x.xxx_hidden_Token = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *VerifyEmailRequest) HasToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VerifyEmailRequest) ClearToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Token = nil
+}
+
+type VerifyEmailRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token *string
+}
+
+func (b0 VerifyEmailRequest_builder) Build() *VerifyEmailRequest {
+	m0 := &VerifyEmailRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Token != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Token = b.Token
+	}
+	return m0
+}
+
+type RequestPasswordResetRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Email       *string                `protobuf:"bytes,1,opt,name=email"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_restaurant_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[16]
 	if x != nil {
-		if x.xxx_hidden_GuestId != nil {
-			return *x.xxx_hidden_GuestId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		if x.xxx_hidden_Email != nil {
+			return *x.xxx_hidden_Email
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *AddOrderItemGuestOwnerRequest) SetOrderItemId(v string) {
-	x.xxx_hidden_OrderItemId = &v
+func (x *RequestPasswordResetRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *RequestPasswordResetRequest) HasEmail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RequestPasswordResetRequest) ClearEmail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Email = nil
+}
+
+type RequestPasswordResetRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email *string
+}
+
+func (b0 RequestPasswordResetRequest_builder) Build() *RequestPasswordResetRequest {
+	m0 := &RequestPasswordResetRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Email != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Email = b.Email
+	}
+	return m0
+}
+
+type ResetPasswordRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Token       *string                `protobuf:"bytes,1,opt,name=token"`
+	xxx_hidden_NewPassword *string                `protobuf:"bytes,2,opt,name=new_password,json=newPassword"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_restaurant_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		if x.xxx_hidden_Token != nil {
+			return *x.xxx_hidden_Token
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		if x.xxx_hidden_NewPassword != nil {
+			return *x.xxx_hidden_NewPassword
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) SetToken(v string) {
+	x.xxx_hidden_Token = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *AddOrderItemGuestOwnerRequest) SetGuestId(v string) {
-	x.xxx_hidden_GuestId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *ResetPasswordRequest) SetNewPassword(v string) {
+	x.xxx_hidden_NewPassword = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ResetPasswordRequest) HasToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ResetPasswordRequest) HasNewPassword() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ResetPasswordRequest) ClearToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Token = nil
+}
+
+func (x *ResetPasswordRequest) ClearNewPassword() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NewPassword = nil
+}
+
+type ResetPasswordRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token       *string
+	NewPassword *string
+}
+
+func (b0 ResetPasswordRequest_builder) Build() *ResetPasswordRequest {
+	m0 := &ResetPasswordRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Token != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Token = b.Token
+	}
+	if b.NewPassword != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NewPassword = b.NewPassword
+	}
+	return m0
+}
+
+type StartOIDCLoginRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Provider    *string                `protobuf:"bytes,1,opt,name=provider"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *StartOIDCLoginRequest) Reset() {
+	*x = StartOIDCLoginRequest{}
+	mi := &file_restaurant_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOIDCLoginRequest) ProtoMessage() {}
+
+func (x *StartOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StartOIDCLoginRequest) GetProvider() string {
+	if x != nil {
+		if x.xxx_hidden_Provider != nil {
+			return *x.xxx_hidden_Provider
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *StartOIDCLoginRequest) SetProvider(v string) {
+	x.xxx_hidden_Provider = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *StartOIDCLoginRequest) HasProvider() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *StartOIDCLoginRequest) ClearProvider() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Provider = nil
+}
+
+type StartOIDCLoginRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Provider *string
+}
+
+func (b0 StartOIDCLoginRequest_builder) Build() *StartOIDCLoginRequest {
+	m0 := &StartOIDCLoginRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Provider != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Provider = b.Provider
+	}
+	return m0
+}
+
+type StartOIDCLoginResponse struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AuthorizationUrl *string                `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *StartOIDCLoginResponse) Reset() {
+	*x = StartOIDCLoginResponse{}
+	mi := &file_restaurant_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOIDCLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOIDCLoginResponse) ProtoMessage() {}
+
+func (x *StartOIDCLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *StartOIDCLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		if x.xxx_hidden_AuthorizationUrl != nil {
+			return *x.xxx_hidden_AuthorizationUrl
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *StartOIDCLoginResponse) SetAuthorizationUrl(v string) {
+	x.xxx_hidden_AuthorizationUrl = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *StartOIDCLoginResponse) HasAuthorizationUrl() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *StartOIDCLoginResponse) ClearAuthorizationUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_AuthorizationUrl = nil
+}
+
+type StartOIDCLoginResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	AuthorizationUrl *string
+}
+
+func (b0 StartOIDCLoginResponse_builder) Build() *StartOIDCLoginResponse {
+	m0 := &StartOIDCLoginResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.AuthorizationUrl != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_AuthorizationUrl = b.AuthorizationUrl
+	}
+	return m0
+}
+
+type CompleteOIDCLoginRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_State       *string                `protobuf:"bytes,1,opt,name=state"`
+	xxx_hidden_Code        *string                `protobuf:"bytes,2,opt,name=code"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CompleteOIDCLoginRequest) Reset() {
+	*x = CompleteOIDCLoginRequest{}
+	mi := &file_restaurant_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOIDCLoginRequest) ProtoMessage() {}
+
+func (x *CompleteOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CompleteOIDCLoginRequest) GetState() string {
+	if x != nil {
+		if x.xxx_hidden_State != nil {
+			return *x.xxx_hidden_State
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetCode() string {
+	if x != nil {
+		if x.xxx_hidden_Code != nil {
+			return *x.xxx_hidden_Code
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) SetState(v string) {
+	x.xxx_hidden_State = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *CompleteOIDCLoginRequest) SetCode(v string) {
+	x.xxx_hidden_Code = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *CompleteOIDCLoginRequest) HasState() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CompleteOIDCLoginRequest) HasCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CompleteOIDCLoginRequest) ClearState() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_State = nil
+}
+
+func (x *CompleteOIDCLoginRequest) ClearCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Code = nil
+}
+
+type CompleteOIDCLoginRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	State *string
+	Code  *string
+}
+
+func (b0 CompleteOIDCLoginRequest_builder) Build() *CompleteOIDCLoginRequest {
+	m0 := &CompleteOIDCLoginRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.State != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_State = b.State
+	}
+	if b.Code != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Code = b.Code
+	}
+	return m0
+}
+
+type CreateMenuItemRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MenuItem *MenuItem              `protobuf:"bytes,1,opt,name=menu_item,json=menuItem"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateMenuItemRequest) Reset() {
+	*x = CreateMenuItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuItemRequest) ProtoMessage() {}
+
+func (x *CreateMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateMenuItemRequest) GetMenuItem() *MenuItem {
+	if x != nil {
+		return x.xxx_hidden_MenuItem
+	}
+	return nil
+}
+
+func (x *CreateMenuItemRequest) SetMenuItem(v *MenuItem) {
+	x.xxx_hidden_MenuItem = v
+}
+
+func (x *CreateMenuItemRequest) HasMenuItem() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MenuItem != nil
+}
+
+func (x *CreateMenuItemRequest) ClearMenuItem() {
+	x.xxx_hidden_MenuItem = nil
+}
+
+type CreateMenuItemRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MenuItem *MenuItem
+}
+
+func (b0 CreateMenuItemRequest_builder) Build() *CreateMenuItemRequest {
+	m0 := &CreateMenuItemRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MenuItem = b.MenuItem
+	return m0
+}
+
+type GetMenuItemRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetMenuItemRequest) Reset() {
+	*x = GetMenuItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuItemRequest) ProtoMessage() {}
+
+func (x *GetMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetMenuItemRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetMenuItemRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetMenuItemRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetMenuItemRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type GetMenuItemRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 GetMenuItemRequest_builder) Build() *GetMenuItemRequest {
+	m0 := &GetMenuItemRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type ListMenuItemsResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Items *[]*MenuItem           `protobuf:"bytes,1,rep,name=items"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListMenuItemsResponse) Reset() {
+	*x = ListMenuItemsResponse{}
+	mi := &file_restaurant_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMenuItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMenuItemsResponse) ProtoMessage() {}
+
+func (x *ListMenuItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListMenuItemsResponse) GetItems() []*MenuItem {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *ListMenuItemsResponse) SetItems(v []*MenuItem) {
+	x.xxx_hidden_Items = &v
+}
+
+type ListMenuItemsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Items []*MenuItem
+}
+
+func (b0 ListMenuItemsResponse_builder) Build() *ListMenuItemsResponse {
+	m0 := &ListMenuItemsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Items = &b.Items
+	return m0
+}
+
+type UpdateMenuItemRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MenuItem *MenuItem              `protobuf:"bytes,1,opt,name=menu_item,json=menuItem"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateMenuItemRequest) Reset() {
+	*x = UpdateMenuItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuItemRequest) ProtoMessage() {}
+
+func (x *UpdateMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateMenuItemRequest) GetMenuItem() *MenuItem {
+	if x != nil {
+		return x.xxx_hidden_MenuItem
+	}
+	return nil
+}
+
+func (x *UpdateMenuItemRequest) SetMenuItem(v *MenuItem) {
+	x.xxx_hidden_MenuItem = v
+}
+
+func (x *UpdateMenuItemRequest) HasMenuItem() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MenuItem != nil
+}
+
+func (x *UpdateMenuItemRequest) ClearMenuItem() {
+	x.xxx_hidden_MenuItem = nil
+}
+
+type UpdateMenuItemRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MenuItem *MenuItem
+}
+
+func (b0 UpdateMenuItemRequest_builder) Build() *UpdateMenuItemRequest {
+	m0 := &UpdateMenuItemRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_MenuItem = b.MenuItem
+	return m0
+}
+
+type DeleteMenuItemRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteMenuItemRequest) Reset() {
+	*x = DeleteMenuItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuItemRequest) ProtoMessage() {}
+
+func (x *DeleteMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteMenuItemRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteMenuItemRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteMenuItemRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteMenuItemRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type DeleteMenuItemRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 DeleteMenuItemRequest_builder) Build() *DeleteMenuItemRequest {
+	m0 := &DeleteMenuItemRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type CreateOrderItemRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderId          *string                `protobuf:"bytes,1,opt,name=order_id,json=orderId"`
+	xxx_hidden_MenuItemId       *string                `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId"`
+	xxx_hidden_Quantity         int32                  `protobuf:"varint,3,opt,name=quantity"`
+	xxx_hidden_Modifiers        []byte                 `protobuf:"bytes,4,opt,name=modifiers"`
+	xxx_hidden_GuestOwnerIds    []string               `protobuf:"bytes,5,rep,name=guest_owner_ids,json=guestOwnerIds"`
+	xxx_hidden_CustomerOwnerIds []string               `protobuf:"bytes,6,rep,name=customer_owner_ids,json=customerOwnerIds"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *CreateOrderItemRequest) Reset() {
+	*x = CreateOrderItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderItemRequest) ProtoMessage() {}
+
+func (x *CreateOrderItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateOrderItemRequest) GetOrderId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderId != nil {
+			return *x.xxx_hidden_OrderId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateOrderItemRequest) GetMenuItemId() string {
+	if x != nil {
+		if x.xxx_hidden_MenuItemId != nil {
+			return *x.xxx_hidden_MenuItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateOrderItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *CreateOrderItemRequest) GetModifiers() []byte {
+	if x != nil {
+		return x.xxx_hidden_Modifiers
+	}
+	return nil
+}
+
+func (x *CreateOrderItemRequest) GetGuestOwnerIds() []string {
+	if x != nil {
+		return x.xxx_hidden_GuestOwnerIds
+	}
+	return nil
+}
+
+func (x *CreateOrderItemRequest) GetCustomerOwnerIds() []string {
+	if x != nil {
+		return x.xxx_hidden_CustomerOwnerIds
+	}
+	return nil
+}
+
+func (x *CreateOrderItemRequest) SetOrderId(v string) {
+	x.xxx_hidden_OrderId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *CreateOrderItemRequest) SetMenuItemId(v string) {
+	x.xxx_hidden_MenuItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *CreateOrderItemRequest) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *CreateOrderItemRequest) SetModifiers(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Modifiers = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *CreateOrderItemRequest) SetGuestOwnerIds(v []string) {
+	x.xxx_hidden_GuestOwnerIds = v
+}
+
+func (x *CreateOrderItemRequest) SetCustomerOwnerIds(v []string) {
+	x.xxx_hidden_CustomerOwnerIds = v
+}
+
+func (x *CreateOrderItemRequest) HasOrderId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateOrderItemRequest) HasMenuItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CreateOrderItemRequest) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CreateOrderItemRequest) HasModifiers() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CreateOrderItemRequest) ClearOrderId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderId = nil
+}
+
+func (x *CreateOrderItemRequest) ClearMenuItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_MenuItemId = nil
+}
+
+func (x *CreateOrderItemRequest) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *CreateOrderItemRequest) ClearModifiers() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Modifiers = nil
+}
+
+type CreateOrderItemRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderId          *string
+	MenuItemId       *string
+	Quantity         *int32
+	Modifiers        []byte
+	GuestOwnerIds    []string
+	CustomerOwnerIds []string
+}
+
+func (b0 CreateOrderItemRequest_builder) Build() *CreateOrderItemRequest {
+	m0 := &CreateOrderItemRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_OrderId = b.OrderId
+	}
+	if b.MenuItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_MenuItemId = b.MenuItemId
+	}
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	if b.Modifiers != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_Modifiers = b.Modifiers
+	}
+	x.xxx_hidden_GuestOwnerIds = b.GuestOwnerIds
+	x.xxx_hidden_CustomerOwnerIds = b.CustomerOwnerIds
+	return m0
+}
+
+type OrderItemID struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OrderItemID) Reset() {
+	*x = OrderItemID{}
+	mi := &file_restaurant_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItemID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItemID) ProtoMessage() {}
+
+func (x *OrderItemID) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OrderItemID) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OrderItemID) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *OrderItemID) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *OrderItemID) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type OrderItemID_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 OrderItemID_builder) Build() *OrderItemID {
+	m0 := &OrderItemID{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type AddFavoriteToOrderRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FavoriteId  *string                `protobuf:"bytes,1,opt,name=favorite_id,json=favoriteId"`
+	xxx_hidden_OrderId     *string                `protobuf:"bytes,2,opt,name=order_id,json=orderId"`
+	xxx_hidden_Quantity    int32                  `protobuf:"varint,3,opt,name=quantity"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AddFavoriteToOrderRequest) Reset() {
+	*x = AddFavoriteToOrderRequest{}
+	mi := &file_restaurant_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteToOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteToOrderRequest) ProtoMessage() {}
+
+func (x *AddFavoriteToOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AddFavoriteToOrderRequest) GetFavoriteId() string {
+	if x != nil {
+		if x.xxx_hidden_FavoriteId != nil {
+			return *x.xxx_hidden_FavoriteId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AddFavoriteToOrderRequest) GetOrderId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderId != nil {
+			return *x.xxx_hidden_OrderId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AddFavoriteToOrderRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *AddFavoriteToOrderRequest) SetFavoriteId(v string) {
+	x.xxx_hidden_FavoriteId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *AddFavoriteToOrderRequest) SetOrderId(v string) {
+	x.xxx_hidden_OrderId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *AddFavoriteToOrderRequest) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *AddFavoriteToOrderRequest) HasFavoriteId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AddFavoriteToOrderRequest) HasOrderId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AddFavoriteToOrderRequest) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *AddFavoriteToOrderRequest) ClearFavoriteId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FavoriteId = nil
+}
+
+func (x *AddFavoriteToOrderRequest) ClearOrderId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_OrderId = nil
+}
+
+func (x *AddFavoriteToOrderRequest) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Quantity = 0
+}
+
+type AddFavoriteToOrderRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	FavoriteId *string
+	// Order not sent yet receiving the item
+	OrderId  *string
+	Quantity *int32
+}
+
+func (b0 AddFavoriteToOrderRequest_builder) Build() *AddFavoriteToOrderRequest {
+	m0 := &AddFavoriteToOrderRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FavoriteId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_FavoriteId = b.FavoriteId
+	}
+	if b.OrderId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_OrderId = b.OrderId
+	}
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	return m0
+}
+
+type ReorderRequest struct {
+	state                  protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_OrderId     *string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId"`
+	xxx_hidden_Source      isReorderRequest_Source `protobuf_oneof:"source"`
+	xxx_hidden_OnlyOwned   bool                    `protobuf:"varint,4,opt,name=only_owned,json=onlyOwned"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReorderRequest) Reset() {
+	*x = ReorderRequest{}
+	mi := &file_restaurant_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRequest) ProtoMessage() {}
+
+func (x *ReorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReorderRequest) GetOrderId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderId != nil {
+			return *x.xxx_hidden_OrderId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ReorderRequest) GetSourceOrderId() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Source.(*reorderRequest_SourceOrderId); ok {
+			return x.SourceOrderId
+		}
+	}
+	return ""
+}
+
+func (x *ReorderRequest) GetSourceTabId() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Source.(*reorderRequest_SourceTabId); ok {
+			return x.SourceTabId
+		}
+	}
+	return ""
+}
+
+func (x *ReorderRequest) GetOnlyOwned() bool {
+	if x != nil {
+		return x.xxx_hidden_OnlyOwned
+	}
+	return false
+}
+
+func (x *ReorderRequest) SetOrderId(v string) {
+	x.xxx_hidden_OrderId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *ReorderRequest) SetSourceOrderId(v string) {
+	x.xxx_hidden_Source = &reorderRequest_SourceOrderId{v}
+}
+
+func (x *ReorderRequest) SetSourceTabId(v string) {
+	x.xxx_hidden_Source = &reorderRequest_SourceTabId{v}
+}
+
+func (x *ReorderRequest) SetOnlyOwned(v bool) {
+	x.xxx_hidden_OnlyOwned = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *ReorderRequest) HasOrderId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ReorderRequest) HasSource() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Source != nil
+}
+
+func (x *ReorderRequest) HasSourceOrderId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Source.(*reorderRequest_SourceOrderId)
+	return ok
+}
+
+func (x *ReorderRequest) HasSourceTabId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Source.(*reorderRequest_SourceTabId)
+	return ok
+}
+
+func (x *ReorderRequest) HasOnlyOwned() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ReorderRequest) ClearOrderId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderId = nil
+}
+
+func (x *ReorderRequest) ClearSource() {
+	x.xxx_hidden_Source = nil
+}
+
+func (x *ReorderRequest) ClearSourceOrderId() {
+	if _, ok := x.xxx_hidden_Source.(*reorderRequest_SourceOrderId); ok {
+		x.xxx_hidden_Source = nil
+	}
+}
+
+func (x *ReorderRequest) ClearSourceTabId() {
+	if _, ok := x.xxx_hidden_Source.(*reorderRequest_SourceTabId); ok {
+		x.xxx_hidden_Source = nil
+	}
+}
+
+func (x *ReorderRequest) ClearOnlyOwned() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_OnlyOwned = false
+}
+
+const ReorderRequest_Source_not_set_case case_ReorderRequest_Source = 0
+const ReorderRequest_SourceOrderId_case case_ReorderRequest_Source = 2
+const ReorderRequest_SourceTabId_case case_ReorderRequest_Source = 3
+
+func (x *ReorderRequest) WhichSource() case_ReorderRequest_Source {
+	if x == nil {
+		return ReorderRequest_Source_not_set_case
+	}
+	switch x.xxx_hidden_Source.(type) {
+	case *reorderRequest_SourceOrderId:
+		return ReorderRequest_SourceOrderId_case
+	case *reorderRequest_SourceTabId:
+		return ReorderRequest_SourceTabId_case
+	default:
+		return ReorderRequest_Source_not_set_case
+	}
+}
+
+type ReorderRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Order not sent yet receiving the items
+	OrderId *string
+	// Fields of oneof xxx_hidden_Source:
+	SourceOrderId *string
+	SourceTabId   *string
+	// -- end of xxx_hidden_Source
+	// Only clones the items owned by the customer
+	OnlyOwned *bool
+}
+
+func (b0 ReorderRequest_builder) Build() *ReorderRequest {
+	m0 := &ReorderRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_OrderId = b.OrderId
+	}
+	if b.SourceOrderId != nil {
+		x.xxx_hidden_Source = &reorderRequest_SourceOrderId{*b.SourceOrderId}
+	}
+	if b.SourceTabId != nil {
+		x.xxx_hidden_Source = &reorderRequest_SourceTabId{*b.SourceTabId}
+	}
+	if b.OnlyOwned != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_OnlyOwned = *b.OnlyOwned
+	}
+	return m0
+}
+
+type case_ReorderRequest_Source protoreflect.FieldNumber
+
+func (x case_ReorderRequest_Source) String() string {
+	md := file_restaurant_proto_msgTypes[29].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isReorderRequest_Source interface {
+	isReorderRequest_Source()
+}
+
+type reorderRequest_SourceOrderId struct {
+	SourceOrderId string `protobuf:"bytes,2,opt,name=source_order_id,json=sourceOrderId,oneof"`
+}
+
+type reorderRequest_SourceTabId struct {
+	SourceTabId string `protobuf:"bytes,3,opt,name=source_tab_id,json=sourceTabId,oneof"`
+}
+
+func (*reorderRequest_SourceOrderId) isReorderRequest_Source() {}
+
+func (*reorderRequest_SourceTabId) isReorderRequest_Source() {}
+
+type ReorderResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderItemIds *[]*OrderItemID        `protobuf:"bytes,1,rep,name=order_item_ids,json=orderItemIds"`
+	xxx_hidden_SkippedItems *[]*SkippedOrderItem   `protobuf:"bytes,2,rep,name=skipped_items,json=skippedItems"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ReorderResponse) Reset() {
+	*x = ReorderResponse{}
+	mi := &file_restaurant_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderResponse) ProtoMessage() {}
+
+func (x *ReorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReorderResponse) GetOrderItemIds() []*OrderItemID {
+	if x != nil {
+		if x.xxx_hidden_OrderItemIds != nil {
+			return *x.xxx_hidden_OrderItemIds
+		}
+	}
+	return nil
+}
+
+func (x *ReorderResponse) GetSkippedItems() []*SkippedOrderItem {
+	if x != nil {
+		if x.xxx_hidden_SkippedItems != nil {
+			return *x.xxx_hidden_SkippedItems
+		}
+	}
+	return nil
+}
+
+func (x *ReorderResponse) SetOrderItemIds(v []*OrderItemID) {
+	x.xxx_hidden_OrderItemIds = &v
+}
+
+func (x *ReorderResponse) SetSkippedItems(v []*SkippedOrderItem) {
+	x.xxx_hidden_SkippedItems = &v
+}
+
+type ReorderResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderItemIds []*OrderItemID
+	SkippedItems []*SkippedOrderItem
+}
+
+func (b0 ReorderResponse_builder) Build() *ReorderResponse {
+	m0 := &ReorderResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_OrderItemIds = &b.OrderItemIds
+	x.xxx_hidden_SkippedItems = &b.SkippedItems
+	return m0
+}
+
+type SkippedOrderItem struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MenuItemId  *string                `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Reason      *string                `protobuf:"bytes,3,opt,name=reason"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SkippedOrderItem) Reset() {
+	*x = SkippedOrderItem{}
+	mi := &file_restaurant_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedOrderItem) ProtoMessage() {}
+
+func (x *SkippedOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SkippedOrderItem) GetMenuItemId() string {
+	if x != nil {
+		if x.xxx_hidden_MenuItemId != nil {
+			return *x.xxx_hidden_MenuItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SkippedOrderItem) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SkippedOrderItem) GetReason() string {
+	if x != nil {
+		if x.xxx_hidden_Reason != nil {
+			return *x.xxx_hidden_Reason
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SkippedOrderItem) SetMenuItemId(v string) {
+	x.xxx_hidden_MenuItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *SkippedOrderItem) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *SkippedOrderItem) SetReason(v string) {
+	x.xxx_hidden_Reason = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *SkippedOrderItem) HasMenuItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SkippedOrderItem) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SkippedOrderItem) HasReason() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SkippedOrderItem) ClearMenuItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MenuItemId = nil
+}
+
+func (x *SkippedOrderItem) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *SkippedOrderItem) ClearReason() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Reason = nil
+}
+
+type SkippedOrderItem_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MenuItemId *string
+	Name       *string
+	// "unavailable", "deleted" or "incompatible_modifiers"
+	Reason *string
+}
+
+func (b0 SkippedOrderItem_builder) Build() *SkippedOrderItem {
+	m0 := &SkippedOrderItem{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MenuItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_MenuItemId = b.MenuItemId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Reason != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Reason = b.Reason
+	}
+	return m0
+}
+
+type DeleteOrderItemRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteOrderItemRequest) Reset() {
+	*x = DeleteOrderItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderItemRequest) ProtoMessage() {}
+
+func (x *DeleteOrderItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteOrderItemRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteOrderItemRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteOrderItemRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteOrderItemRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type DeleteOrderItemRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 DeleteOrderItemRequest_builder) Build() *DeleteOrderItemRequest {
+	m0 := &DeleteOrderItemRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type UpdateOrderItemModifiersRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
+	xxx_hidden_Modifiers   []byte                 `protobuf:"bytes,2,opt,name=modifiers"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateOrderItemModifiersRequest) Reset() {
+	*x = UpdateOrderItemModifiersRequest{}
+	mi := &file_restaurant_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderItemModifiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderItemModifiersRequest) ProtoMessage() {}
+
+func (x *UpdateOrderItemModifiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateOrderItemModifiersRequest) GetOrderItemId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderItemId != nil {
+			return *x.xxx_hidden_OrderItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateOrderItemModifiersRequest) GetModifiers() []byte {
+	if x != nil {
+		return x.xxx_hidden_Modifiers
+	}
+	return nil
+}
+
+func (x *UpdateOrderItemModifiersRequest) SetOrderItemId(v string) {
+	x.xxx_hidden_OrderItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *UpdateOrderItemModifiersRequest) SetModifiers(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Modifiers = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UpdateOrderItemModifiersRequest) HasOrderItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UpdateOrderItemModifiersRequest) HasModifiers() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateOrderItemModifiersRequest) ClearOrderItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderItemId = nil
+}
+
+func (x *UpdateOrderItemModifiersRequest) ClearModifiers() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Modifiers = nil
+}
+
+type UpdateOrderItemModifiersRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderItemId *string
+	Modifiers   []byte
+}
+
+func (b0 UpdateOrderItemModifiersRequest_builder) Build() *UpdateOrderItemModifiersRequest {
+	m0 := &UpdateOrderItemModifiersRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_OrderItemId = b.OrderItemId
+	}
+	if b.Modifiers != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Modifiers = b.Modifiers
+	}
+	return m0
+}
+
+type UpdateOrderItemQuantityRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
+	xxx_hidden_Quantity    int32                  `protobuf:"varint,2,opt,name=quantity"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateOrderItemQuantityRequest) Reset() {
+	*x = UpdateOrderItemQuantityRequest{}
+	mi := &file_restaurant_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderItemQuantityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderItemQuantityRequest) ProtoMessage() {}
+
+func (x *UpdateOrderItemQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateOrderItemQuantityRequest) GetOrderItemId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderItemId != nil {
+			return *x.xxx_hidden_OrderItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateOrderItemQuantityRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *UpdateOrderItemQuantityRequest) SetOrderItemId(v string) {
+	x.xxx_hidden_OrderItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *UpdateOrderItemQuantityRequest) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UpdateOrderItemQuantityRequest) HasOrderItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UpdateOrderItemQuantityRequest) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateOrderItemQuantityRequest) ClearOrderItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderItemId = nil
+}
+
+func (x *UpdateOrderItemQuantityRequest) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Quantity = 0
+}
+
+type UpdateOrderItemQuantityRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderItemId *string
+	Quantity    *int32
+}
+
+func (b0 UpdateOrderItemQuantityRequest_builder) Build() *UpdateOrderItemQuantityRequest {
+	m0 := &UpdateOrderItemQuantityRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_OrderItemId = b.OrderItemId
+	}
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	return m0
+}
+
+type AddOrderItemGuestOwnerRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
+	xxx_hidden_GuestId     *string                `protobuf:"bytes,2,opt,name=guest_id,json=guestId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AddOrderItemGuestOwnerRequest) Reset() {
+	*x = AddOrderItemGuestOwnerRequest{}
+	mi := &file_restaurant_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrderItemGuestOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrderItemGuestOwnerRequest) ProtoMessage() {}
+
+func (x *AddOrderItemGuestOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AddOrderItemGuestOwnerRequest) GetOrderItemId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderItemId != nil {
+			return *x.xxx_hidden_OrderItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AddOrderItemGuestOwnerRequest) GetGuestId() string {
+	if x != nil {
+		if x.xxx_hidden_GuestId != nil {
+			return *x.xxx_hidden_GuestId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AddOrderItemGuestOwnerRequest) SetOrderItemId(v string) {
+	x.xxx_hidden_OrderItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *AddOrderItemGuestOwnerRequest) SetGuestId(v string) {
+	x.xxx_hidden_GuestId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *AddOrderItemGuestOwnerRequest) HasOrderItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AddOrderItemGuestOwnerRequest) HasGuestId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AddOrderItemGuestOwnerRequest) ClearOrderItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderItemId = nil
+}
+
+func (x *AddOrderItemGuestOwnerRequest) ClearGuestId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_GuestId = nil
+}
+
+type AddOrderItemGuestOwnerRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderItemId *string
+	GuestId     *string
+}
+
+func (b0 AddOrderItemGuestOwnerRequest_builder) Build() *AddOrderItemGuestOwnerRequest {
+	m0 := &AddOrderItemGuestOwnerRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_OrderItemId = b.OrderItemId
+	}
+	if b.GuestId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_GuestId = b.GuestId
+	}
+	return m0
+}
+
+type RemoveOrderItemGuestOwnerRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
+	xxx_hidden_GuestId     *string                `protobuf:"bytes,2,opt,name=guest_id,json=guestId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) Reset() {
+	*x = RemoveOrderItemGuestOwnerRequest{}
+	mi := &file_restaurant_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrderItemGuestOwnerRequest) ProtoMessage() {}
+
+func (x *RemoveOrderItemGuestOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) GetOrderItemId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderItemId != nil {
+			return *x.xxx_hidden_OrderItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) GetGuestId() string {
+	if x != nil {
+		if x.xxx_hidden_GuestId != nil {
+			return *x.xxx_hidden_GuestId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) SetOrderItemId(v string) {
+	x.xxx_hidden_OrderItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) SetGuestId(v string) {
+	x.xxx_hidden_GuestId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) HasOrderItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) HasGuestId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) ClearOrderItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderItemId = nil
+}
+
+func (x *RemoveOrderItemGuestOwnerRequest) ClearGuestId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_GuestId = nil
+}
+
+type RemoveOrderItemGuestOwnerRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderItemId *string
+	GuestId     *string
+}
+
+func (b0 RemoveOrderItemGuestOwnerRequest_builder) Build() *RemoveOrderItemGuestOwnerRequest {
+	m0 := &RemoveOrderItemGuestOwnerRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_OrderItemId = b.OrderItemId
+	}
+	if b.GuestId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_GuestId = b.GuestId
+	}
+	return m0
+}
+
+type AddOrderItemCustomerOwnerRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
+	xxx_hidden_CustomerId  *string                `protobuf:"bytes,2,opt,name=customer_id,json=customerId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) Reset() {
+	*x = AddOrderItemCustomerOwnerRequest{}
+	mi := &file_restaurant_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrderItemCustomerOwnerRequest) ProtoMessage() {}
+
+func (x *AddOrderItemCustomerOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) GetOrderItemId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderItemId != nil {
+			return *x.xxx_hidden_OrderItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) GetCustomerId() string {
+	if x != nil {
+		if x.xxx_hidden_CustomerId != nil {
+			return *x.xxx_hidden_CustomerId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) SetOrderItemId(v string) {
+	x.xxx_hidden_OrderItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) SetCustomerId(v string) {
+	x.xxx_hidden_CustomerId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) HasOrderItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) HasCustomerId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) ClearOrderItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderItemId = nil
+}
+
+func (x *AddOrderItemCustomerOwnerRequest) ClearCustomerId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CustomerId = nil
+}
+
+type AddOrderItemCustomerOwnerRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderItemId *string
+	CustomerId  *string
+}
+
+func (b0 AddOrderItemCustomerOwnerRequest_builder) Build() *AddOrderItemCustomerOwnerRequest {
+	m0 := &AddOrderItemCustomerOwnerRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_OrderItemId = b.OrderItemId
+	}
+	if b.CustomerId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_CustomerId = b.CustomerId
+	}
+	return m0
+}
+
+type RemoveOrderItemCustomerOwnerRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
+	xxx_hidden_CustomerId  *string                `protobuf:"bytes,2,opt,name=customer_id,json=customerId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) Reset() {
+	*x = RemoveOrderItemCustomerOwnerRequest{}
+	mi := &file_restaurant_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrderItemCustomerOwnerRequest) ProtoMessage() {}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) GetOrderItemId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderItemId != nil {
+			return *x.xxx_hidden_OrderItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) GetCustomerId() string {
+	if x != nil {
+		if x.xxx_hidden_CustomerId != nil {
+			return *x.xxx_hidden_CustomerId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) SetOrderItemId(v string) {
+	x.xxx_hidden_OrderItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) SetCustomerId(v string) {
+	x.xxx_hidden_CustomerId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) HasOrderItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) HasCustomerId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) ClearOrderItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderItemId = nil
+}
+
+func (x *RemoveOrderItemCustomerOwnerRequest) ClearCustomerId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CustomerId = nil
+}
+
+type RemoveOrderItemCustomerOwnerRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderItemId *string
+	CustomerId  *string
+}
+
+func (b0 RemoveOrderItemCustomerOwnerRequest_builder) Build() *RemoveOrderItemCustomerOwnerRequest {
+	m0 := &RemoveOrderItemCustomerOwnerRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_OrderItemId = b.OrderItemId
+	}
+	if b.CustomerId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_CustomerId = b.CustomerId
+	}
+	return m0
+}
+
+type SendOrderRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_OrderId     *string                `protobuf:"bytes,1,opt,name=order_id,json=orderId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SendOrderRequest) Reset() {
+	*x = SendOrderRequest{}
+	mi := &file_restaurant_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOrderRequest) ProtoMessage() {}
+
+func (x *SendOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SendOrderRequest) GetOrderId() string {
+	if x != nil {
+		if x.xxx_hidden_OrderId != nil {
+			return *x.xxx_hidden_OrderId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SendOrderRequest) SetOrderId(v string) {
+	x.xxx_hidden_OrderId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *SendOrderRequest) HasOrderId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SendOrderRequest) ClearOrderId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_OrderId = nil
+}
+
+type SendOrderRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OrderId *string
+}
+
+func (b0 SendOrderRequest_builder) Build() *SendOrderRequest {
+	m0 := &SendOrderRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.OrderId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_OrderId = b.OrderId
+	}
+	return m0
+}
+
+type TabID struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TabID) Reset() {
+	*x = TabID{}
+	mi := &file_restaurant_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TabID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TabID) ProtoMessage() {}
+
+func (x *TabID) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TabID) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TabID) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *TabID) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TabID) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type TabID_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 TabID_builder) Build() *TabID {
+	m0 := &TabID{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type VisitTabRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TabId       *string                `protobuf:"bytes,1,opt,name=tab_id,json=tabId"`
+	xxx_hidden_CustomerId  *string                `protobuf:"bytes,2,opt,name=customer_id,json=customerId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *VisitTabRequest) Reset() {
+	*x = VisitTabRequest{}
+	mi := &file_restaurant_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitTabRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitTabRequest) ProtoMessage() {}
+
+func (x *VisitTabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VisitTabRequest) GetTabId() string {
+	if x != nil {
+		if x.xxx_hidden_TabId != nil {
+			return *x.xxx_hidden_TabId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VisitTabRequest) GetCustomerId() string {
+	if x != nil {
+		if x.xxx_hidden_CustomerId != nil {
+			return *x.xxx_hidden_CustomerId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VisitTabRequest) SetTabId(v string) {
+	x.xxx_hidden_TabId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *VisitTabRequest) SetCustomerId(v string) {
+	x.xxx_hidden_CustomerId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *VisitTabRequest) HasTabId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VisitTabRequest) HasCustomerId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VisitTabRequest) ClearTabId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TabId = nil
+}
+
+func (x *VisitTabRequest) ClearCustomerId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CustomerId = nil
+}
+
+type VisitTabRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TabId      *string
+	CustomerId *string
+}
+
+func (b0 VisitTabRequest_builder) Build() *VisitTabRequest {
+	m0 := &VisitTabRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TabId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_TabId = b.TabId
+	}
+	if b.CustomerId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_CustomerId = b.CustomerId
+	}
+	return m0
+}
+
+type CreateGuestRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TabId       *string                `protobuf:"bytes,1,opt,name=tab_id,json=tabId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateGuestRequest) Reset() {
+	*x = CreateGuestRequest{}
+	mi := &file_restaurant_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestRequest) ProtoMessage() {}
+
+func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateGuestRequest) GetTabId() string {
+	if x != nil {
+		if x.xxx_hidden_TabId != nil {
+			return *x.xxx_hidden_TabId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateGuestRequest) SetTabId(v string) {
+	x.xxx_hidden_TabId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *CreateGuestRequest) HasTabId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateGuestRequest) ClearTabId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TabId = nil
+}
+
+type CreateGuestRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TabId *string
+}
+
+func (b0 CreateGuestRequest_builder) Build() *CreateGuestRequest {
+	m0 := &CreateGuestRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TabId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_TabId = b.TabId
+	}
+	return m0
+}
+
+type CreateGuestResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_ClaimToken  *string                `protobuf:"bytes,2,opt,name=claim_token,json=claimToken"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateGuestResponse) Reset() {
+	*x = CreateGuestResponse{}
+	mi := &file_restaurant_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestResponse) ProtoMessage() {}
+
+func (x *CreateGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateGuestResponse) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateGuestResponse) GetClaimToken() string {
+	if x != nil {
+		if x.xxx_hidden_ClaimToken != nil {
+			return *x.xxx_hidden_ClaimToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CreateGuestResponse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *CreateGuestResponse) SetClaimToken(v string) {
+	x.xxx_hidden_ClaimToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *CreateGuestResponse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CreateGuestResponse) HasClaimToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CreateGuestResponse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *CreateGuestResponse) ClearClaimToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ClaimToken = nil
+}
+
+type CreateGuestResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id         *string
+	ClaimToken *string
+}
+
+func (b0 CreateGuestResponse_builder) Build() *CreateGuestResponse {
+	m0 := &CreateGuestResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.ClaimToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ClaimToken = b.ClaimToken
+	}
+	return m0
+}
+
+type GuestID struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GuestID) Reset() {
+	*x = GuestID{}
+	mi := &file_restaurant_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestID) ProtoMessage() {}
+
+func (x *GuestID) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GuestID) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GuestID) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GuestID) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GuestID) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type GuestID_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 GuestID_builder) Build() *GuestID {
+	m0 := &GuestID{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type UpdateGuestNameRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GuestId     *string                `protobuf:"bytes,1,opt,name=guest_id,json=guestId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateGuestNameRequest) Reset() {
+	*x = UpdateGuestNameRequest{}
+	mi := &file_restaurant_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuestNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuestNameRequest) ProtoMessage() {}
+
+func (x *UpdateGuestNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateGuestNameRequest) GetGuestId() string {
+	if x != nil {
+		if x.xxx_hidden_GuestId != nil {
+			return *x.xxx_hidden_GuestId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateGuestNameRequest) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UpdateGuestNameRequest) SetGuestId(v string) {
+	x.xxx_hidden_GuestId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *UpdateGuestNameRequest) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UpdateGuestNameRequest) HasGuestId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UpdateGuestNameRequest) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateGuestNameRequest) ClearGuestId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GuestId = nil
+}
+
+func (x *UpdateGuestNameRequest) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+type UpdateGuestNameRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GuestId *string
+	Name    *string
+}
+
+func (b0 UpdateGuestNameRequest_builder) Build() *UpdateGuestNameRequest {
+	m0 := &UpdateGuestNameRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GuestId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_GuestId = b.GuestId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Name = b.Name
+	}
+	return m0
+}
+
+type ClaimGuestRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_GuestId     *string                `protobuf:"bytes,1,opt,name=guest_id,json=guestId"`
+	xxx_hidden_ClaimToken  *string                `protobuf:"bytes,2,opt,name=claim_token,json=claimToken"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ClaimGuestRequest) Reset() {
+	*x = ClaimGuestRequest{}
+	mi := &file_restaurant_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimGuestRequest) ProtoMessage() {}
+
+func (x *ClaimGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ClaimGuestRequest) GetGuestId() string {
+	if x != nil {
+		if x.xxx_hidden_GuestId != nil {
+			return *x.xxx_hidden_GuestId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ClaimGuestRequest) GetClaimToken() string {
+	if x != nil {
+		if x.xxx_hidden_ClaimToken != nil {
+			return *x.xxx_hidden_ClaimToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ClaimGuestRequest) SetGuestId(v string) {
+	x.xxx_hidden_GuestId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ClaimGuestRequest) SetClaimToken(v string) {
+	x.xxx_hidden_ClaimToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ClaimGuestRequest) HasGuestId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ClaimGuestRequest) HasClaimToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ClaimGuestRequest) ClearGuestId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_GuestId = nil
+}
+
+func (x *ClaimGuestRequest) ClearClaimToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ClaimToken = nil
+}
+
+type ClaimGuestRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	GuestId    *string
+	ClaimToken *string
+}
+
+func (b0 ClaimGuestRequest_builder) Build() *ClaimGuestRequest {
+	m0 := &ClaimGuestRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.GuestId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_GuestId = b.GuestId
+	}
+	if b.ClaimToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ClaimToken = b.ClaimToken
+	}
+	return m0
+}
+
+type GetOpenTabRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TabId       *string                `protobuf:"bytes,1,opt,name=tab_id,json=tabId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetOpenTabRequest) Reset() {
+	*x = GetOpenTabRequest{}
+	mi := &file_restaurant_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpenTabRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenTabRequest) ProtoMessage() {}
+
+func (x *GetOpenTabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetOpenTabRequest) GetTabId() string {
+	if x != nil {
+		if x.xxx_hidden_TabId != nil {
+			return *x.xxx_hidden_TabId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetOpenTabRequest) SetTabId(v string) {
+	x.xxx_hidden_TabId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetOpenTabRequest) HasTabId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetOpenTabRequest) ClearTabId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TabId = nil
+}
+
+type GetOpenTabRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TabId *string
+}
+
+func (b0 GetOpenTabRequest_builder) Build() *GetOpenTabRequest {
+	m0 := &GetOpenTabRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TabId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_TabId = b.TabId
+	}
+	return m0
+}
+
+type CloseTabRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TabId       *string                `protobuf:"bytes,1,opt,name=tab_id,json=tabId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CloseTabRequest) Reset() {
+	*x = CloseTabRequest{}
+	mi := &file_restaurant_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseTabRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseTabRequest) ProtoMessage() {}
+
+func (x *CloseTabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CloseTabRequest) GetTabId() string {
+	if x != nil {
+		if x.xxx_hidden_TabId != nil {
+			return *x.xxx_hidden_TabId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CloseTabRequest) SetTabId(v string) {
+	x.xxx_hidden_TabId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *CloseTabRequest) HasTabId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CloseTabRequest) ClearTabId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TabId = nil
+}
+
+type CloseTabRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TabId *string
+}
+
+func (b0 CloseTabRequest_builder) Build() *CloseTabRequest {
+	m0 := &CloseTabRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TabId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_TabId = b.TabId
+	}
+	return m0
+}
+
+type CloseTabResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ClosedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=closed_at,json=closedAt"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CloseTabResponse) Reset() {
+	*x = CloseTabResponse{}
+	mi := &file_restaurant_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseTabResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseTabResponse) ProtoMessage() {}
+
+func (x *CloseTabResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CloseTabResponse) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ClosedAt
+	}
+	return nil
+}
+
+func (x *CloseTabResponse) SetClosedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ClosedAt = v
+}
+
+func (x *CloseTabResponse) HasClosedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ClosedAt != nil
+}
+
+func (x *CloseTabResponse) ClearClosedAt() {
+	x.xxx_hidden_ClosedAt = nil
+}
+
+type CloseTabResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ClosedAt *timestamppb.Timestamp
+}
+
+func (b0 CloseTabResponse_builder) Build() *CloseTabResponse {
+	m0 := &CloseTabResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_ClosedAt = b.ClosedAt
+	return m0
+}
+
+type GetVisitedTabsRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CustomerId  *string                `protobuf:"bytes,1,opt,name=customer_id,json=customerId"`
+	xxx_hidden_PageSize    int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize"`
+	xxx_hidden_PageToken   *string                `protobuf:"bytes,3,opt,name=page_token,json=pageToken"`
+	xxx_hidden_Since       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since"`
+	xxx_hidden_Until       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until"`
+	xxx_hidden_Closed      bool                   `protobuf:"varint,6,opt,name=closed"`
+	xxx_hidden_Summary     bool                   `protobuf:"varint,7,opt,name=summary"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetVisitedTabsRequest) Reset() {
+	*x = GetVisitedTabsRequest{}
+	mi := &file_restaurant_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitedTabsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitedTabsRequest) ProtoMessage() {}
+
+func (x *GetVisitedTabsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetVisitedTabsRequest) GetCustomerId() string {
+	if x != nil {
+		if x.xxx_hidden_CustomerId != nil {
+			return *x.xxx_hidden_CustomerId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetVisitedTabsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *GetVisitedTabsRequest) GetPageToken() string {
+	if x != nil {
+		if x.xxx_hidden_PageToken != nil {
+			return *x.xxx_hidden_PageToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetVisitedTabsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Since
+	}
+	return nil
+}
+
+func (x *GetVisitedTabsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Until
+	}
+	return nil
+}
+
+func (x *GetVisitedTabsRequest) GetClosed() bool {
+	if x != nil {
+		return x.xxx_hidden_Closed
+	}
+	return false
+}
+
+func (x *GetVisitedTabsRequest) GetSummary() bool {
+	if x != nil {
+		return x.xxx_hidden_Summary
+	}
+	return false
+}
+
+func (x *GetVisitedTabsRequest) SetCustomerId(v string) {
+	x.xxx_hidden_CustomerId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *GetVisitedTabsRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *GetVisitedTabsRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *GetVisitedTabsRequest) SetSince(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Since = v
+}
+
+func (x *GetVisitedTabsRequest) SetUntil(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Until = v
+}
+
+func (x *GetVisitedTabsRequest) SetClosed(v bool) {
+	x.xxx_hidden_Closed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *GetVisitedTabsRequest) SetSummary(v bool) {
+	x.xxx_hidden_Summary = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *GetVisitedTabsRequest) HasCustomerId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetVisitedTabsRequest) HasPageSize() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetVisitedTabsRequest) HasPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetVisitedTabsRequest) HasSince() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Since != nil
+}
+
+func (x *GetVisitedTabsRequest) HasUntil() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Until != nil
+}
+
+func (x *GetVisitedTabsRequest) HasClosed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *GetVisitedTabsRequest) HasSummary() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *GetVisitedTabsRequest) ClearCustomerId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CustomerId = nil
+}
+
+func (x *GetVisitedTabsRequest) ClearPageSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PageSize = 0
+}
+
+func (x *GetVisitedTabsRequest) ClearPageToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_PageToken = nil
+}
+
+func (x *GetVisitedTabsRequest) ClearSince() {
+	x.xxx_hidden_Since = nil
+}
+
+func (x *GetVisitedTabsRequest) ClearUntil() {
+	x.xxx_hidden_Until = nil
+}
+
+func (x *GetVisitedTabsRequest) ClearClosed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Closed = false
+}
+
+func (x *GetVisitedTabsRequest) ClearSummary() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Summary = false
+}
+
+type GetVisitedTabsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CustomerId *string
+	// At most 100 tabs are returned per page, 20 by default
+	PageSize *int32
+	// next_page_token of the previous page
+	PageToken *string
+	// Bounds of the creation time of the tabs, since inclusive and until exclusive
+	Since *timestamppb.Timestamp
+	Until *timestamppb.Timestamp
+	// Only lists open or closed tabs when set
+	Closed *bool
+	// Leaves out the orders of the tabs
+	Summary *bool
+}
+
+func (b0 GetVisitedTabsRequest_builder) Build() *GetVisitedTabsRequest {
+	m0 := &GetVisitedTabsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CustomerId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_CustomerId = b.CustomerId
+	}
+	if b.PageSize != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_PageSize = *b.PageSize
+	}
+	if b.PageToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_PageToken = b.PageToken
+	}
+	x.xxx_hidden_Since = b.Since
+	x.xxx_hidden_Until = b.Until
+	if b.Closed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_Closed = *b.Closed
+	}
+	if b.Summary != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_Summary = *b.Summary
+	}
+	return m0
+}
+
+type GetVisitedTabsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Tabs          *[]*Tab                `protobuf:"bytes,1,rep,name=tabs"`
+	xxx_hidden_NextPageToken *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetVisitedTabsResponse) Reset() {
+	*x = GetVisitedTabsResponse{}
+	mi := &file_restaurant_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitedTabsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitedTabsResponse) ProtoMessage() {}
+
+func (x *GetVisitedTabsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetVisitedTabsResponse) GetTabs() []*Tab {
+	if x != nil {
+		if x.xxx_hidden_Tabs != nil {
+			return *x.xxx_hidden_Tabs
+		}
+	}
+	return nil
+}
+
+func (x *GetVisitedTabsResponse) GetNextPageToken() string {
+	if x != nil {
+		if x.xxx_hidden_NextPageToken != nil {
+			return *x.xxx_hidden_NextPageToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetVisitedTabsResponse) SetTabs(v []*Tab) {
+	x.xxx_hidden_Tabs = &v
+}
+
+func (x *GetVisitedTabsResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetVisitedTabsResponse) HasNextPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetVisitedTabsResponse) ClearNextPageToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NextPageToken = nil
+}
+
+type GetVisitedTabsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Tabs []*Tab
+	// Empty on the last page
+	NextPageToken *string
+}
+
+func (b0 GetVisitedTabsResponse_builder) Build() *GetVisitedTabsResponse {
+	m0 := &GetVisitedTabsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Tabs = &b.Tabs
+	if b.NextPageToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NextPageToken = b.NextPageToken
+	}
+	return m0
+}
+
+type GetSpendSummaryRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_CustomerId  *string                `protobuf:"bytes,1,opt,name=customer_id,json=customerId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetSpendSummaryRequest) Reset() {
+	*x = GetSpendSummaryRequest{}
+	mi := &file_restaurant_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendSummaryRequest) ProtoMessage() {}
+
+func (x *GetSpendSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetSpendSummaryRequest) GetCustomerId() string {
+	if x != nil {
+		if x.xxx_hidden_CustomerId != nil {
+			return *x.xxx_hidden_CustomerId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetSpendSummaryRequest) SetCustomerId(v string) {
+	x.xxx_hidden_CustomerId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetSpendSummaryRequest) HasCustomerId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetSpendSummaryRequest) ClearCustomerId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_CustomerId = nil
+}
+
+type GetSpendSummaryRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	CustomerId *string
+}
+
+func (b0 GetSpendSummaryRequest_builder) Build() *GetSpendSummaryRequest {
+	m0 := &GetSpendSummaryRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.CustomerId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_CustomerId = b.CustomerId
+	}
+	return m0
+}
+
+type SpendSummary struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TotalSpend    int64                  `protobuf:"varint,1,opt,name=total_spend,json=totalSpend"`
+	xxx_hidden_VisitCount    int64                  `protobuf:"varint,2,opt,name=visit_count,json=visitCount"`
+	xxx_hidden_FavoriteItems *[]*FavoriteItem       `protobuf:"bytes,3,rep,name=favorite_items,json=favoriteItems"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SpendSummary) Reset() {
+	*x = SpendSummary{}
+	mi := &file_restaurant_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendSummary) ProtoMessage() {}
+
+func (x *SpendSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SpendSummary) GetTotalSpend() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalSpend
+	}
+	return 0
+}
+
+func (x *SpendSummary) GetVisitCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_VisitCount
+	}
+	return 0
+}
+
+func (x *SpendSummary) GetFavoriteItems() []*FavoriteItem {
+	if x != nil {
+		if x.xxx_hidden_FavoriteItems != nil {
+			return *x.xxx_hidden_FavoriteItems
+		}
+	}
+	return nil
+}
+
+func (x *SpendSummary) SetTotalSpend(v int64) {
+	x.xxx_hidden_TotalSpend = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *SpendSummary) SetVisitCount(v int64) {
+	x.xxx_hidden_VisitCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *SpendSummary) SetFavoriteItems(v []*FavoriteItem) {
+	x.xxx_hidden_FavoriteItems = &v
+}
+
+func (x *SpendSummary) HasTotalSpend() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SpendSummary) HasVisitCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SpendSummary) ClearTotalSpend() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_TotalSpend = 0
+}
+
+func (x *SpendSummary) ClearVisitCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_VisitCount = 0
+}
+
+type SpendSummary_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TotalSpend    *int64
+	VisitCount    *int64
+	FavoriteItems []*FavoriteItem
+}
+
+func (b0 SpendSummary_builder) Build() *SpendSummary {
+	m0 := &SpendSummary{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.TotalSpend != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_TotalSpend = *b.TotalSpend
+	}
+	if b.VisitCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_VisitCount = *b.VisitCount
+	}
+	x.xxx_hidden_FavoriteItems = &b.FavoriteItems
+	return m0
+}
+
+type FavoriteItem struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MenuItemId  *string                `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Quantity    int64                  `protobuf:"varint,3,opt,name=quantity"`
+	xxx_hidden_TabCount    int64                  `protobuf:"varint,4,opt,name=tab_count,json=tabCount"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FavoriteItem) Reset() {
+	*x = FavoriteItem{}
+	mi := &file_restaurant_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteItem) ProtoMessage() {}
+
+func (x *FavoriteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FavoriteItem) GetMenuItemId() string {
+	if x != nil {
+		if x.xxx_hidden_MenuItemId != nil {
+			return *x.xxx_hidden_MenuItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FavoriteItem) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FavoriteItem) GetQuantity() int64 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *FavoriteItem) GetTabCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_TabCount
+	}
+	return 0
+}
+
+func (x *FavoriteItem) SetMenuItemId(v string) {
+	x.xxx_hidden_MenuItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *FavoriteItem) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *FavoriteItem) SetQuantity(v int64) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *FavoriteItem) SetTabCount(v int64) {
+	x.xxx_hidden_TabCount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *FavoriteItem) HasMenuItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *FavoriteItem) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FavoriteItem) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *FavoriteItem) HasTabCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *FavoriteItem) ClearMenuItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MenuItemId = nil
+}
+
+func (x *FavoriteItem) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *FavoriteItem) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *FavoriteItem) ClearTabCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_TabCount = 0
+}
+
+type FavoriteItem_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MenuItemId *string
+	Name       *string
+	Quantity   *int64
+	TabCount   *int64
+}
+
+func (b0 FavoriteItem_builder) Build() *FavoriteItem {
+	m0 := &FavoriteItem{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MenuItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_MenuItemId = b.MenuItemId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	if b.TabCount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_TabCount = *b.TabCount
+	}
+	return m0
+}
+
+type Tab struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id               *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_TotalPrice       int32                  `protobuf:"varint,2,opt,name=total_price,json=totalPrice"`
+	xxx_hidden_Orders           *[]*Order              `protobuf:"bytes,3,rep,name=orders"`
+	xxx_hidden_CustomGuestNames map[string]string      `protobuf:"bytes,4,rep,name=custom_guest_names,json=customGuestNames" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt"`
+	xxx_hidden_ClosedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=closed_at,json=closedAt"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *Tab) Reset() {
+	*x = Tab{}
+	mi := &file_restaurant_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tab) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tab) ProtoMessage() {}
+
+func (x *Tab) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Tab) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Tab) GetTotalPrice() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalPrice
+	}
+	return 0
+}
+
+func (x *Tab) GetOrders() []*Order {
+	if x != nil {
+		if x.xxx_hidden_Orders != nil {
+			return *x.xxx_hidden_Orders
+		}
+	}
+	return nil
+}
+
+func (x *Tab) GetCustomGuestNames() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_CustomGuestNames
+	}
+	return nil
+}
+
+func (x *Tab) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Tab) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ClosedAt
+	}
+	return nil
+}
+
+func (x *Tab) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *Tab) SetTotalPrice(v int32) {
+	x.xxx_hidden_TotalPrice = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *Tab) SetOrders(v []*Order) {
+	x.xxx_hidden_Orders = &v
+}
+
+func (x *Tab) SetCustomGuestNames(v map[string]string) {
+	x.xxx_hidden_CustomGuestNames = v
+}
+
+func (x *Tab) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Tab) SetClosedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ClosedAt = v
+}
+
+func (x *Tab) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Tab) HasTotalPrice() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Tab) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Tab) HasClosedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ClosedAt != nil
+}
+
+func (x *Tab) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Tab) ClearTotalPrice() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TotalPrice = 0
+}
+
+func (x *Tab) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Tab) ClearClosedAt() {
+	x.xxx_hidden_ClosedAt = nil
+}
+
+type Tab_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id               *string
+	TotalPrice       *int32
+	Orders           []*Order
+	CustomGuestNames map[string]string
+	CreatedAt        *timestamppb.Timestamp
+	ClosedAt         *timestamppb.Timestamp
+}
+
+func (b0 Tab_builder) Build() *Tab {
+	m0 := &Tab{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.TotalPrice != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_TotalPrice = *b.TotalPrice
+	}
+	x.xxx_hidden_Orders = &b.Orders
+	x.xxx_hidden_CustomGuestNames = b.CustomGuestNames
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_ClosedAt = b.ClosedAt
+	return m0
+}
+
+type Order struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Items       *[]*OrderItem          `protobuf:"bytes,2,rep,name=items"`
+	xxx_hidden_SentAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=sent_at,json=sentAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_restaurant_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *Order) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_SentAt
+	}
+	return nil
+}
+
+func (x *Order) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *Order) SetItems(v []*OrderItem) {
+	x.xxx_hidden_Items = &v
+}
+
+func (x *Order) SetSentAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_SentAt = v
+}
+
+func (x *Order) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Order) HasSentAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_SentAt != nil
+}
+
+func (x *Order) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Order) ClearSentAt() {
+	x.xxx_hidden_SentAt = nil
+}
+
+type Order_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id     *string
+	Items  []*OrderItem
+	SentAt *timestamppb.Timestamp
+}
+
+func (b0 Order_builder) Build() *Order {
+	m0 := &Order{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_Items = &b.Items
+	x.xxx_hidden_SentAt = b.SentAt
+	return m0
+}
+
+type OrderItem struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id               *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Quantity         int32                  `protobuf:"varint,2,opt,name=quantity"`
+	xxx_hidden_Modifiers        []byte                 `protobuf:"bytes,3,opt,name=modifiers"`
+	xxx_hidden_GuestOwnerIds    []string               `protobuf:"bytes,4,rep,name=guest_owner_ids,json=guestOwnerIds"`
+	xxx_hidden_CustomerOwnerIds []string               `protobuf:"bytes,5,rep,name=customer_owner_ids,json=customerOwnerIds"`
+	xxx_hidden_MenuItemId       *string                `protobuf:"bytes,6,opt,name=menu_item_id,json=menuItemId"`
+	xxx_hidden_Name             *string                `protobuf:"bytes,7,opt,name=name"`
+	xxx_hidden_Description      *string                `protobuf:"bytes,8,opt,name=description"`
+	xxx_hidden_PhotoPathinfo    *string                `protobuf:"bytes,9,opt,name=photo_pathinfo,json=photoPathinfo"`
+	xxx_hidden_Price            int32                  `protobuf:"varint,10,opt,name=price"`
+	xxx_hidden_PortionSize      int32                  `protobuf:"varint,11,opt,name=portion_size,json=portionSize"`
+	xxx_hidden_ModifiersConfig  []byte                 `protobuf:"bytes,12,opt,name=modifiers_config,json=modifiersConfig"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_restaurant_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OrderItem) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.xxx_hidden_Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetModifiers() []byte {
+	if x != nil {
+		return x.xxx_hidden_Modifiers
+	}
+	return nil
+}
+
+func (x *OrderItem) GetGuestOwnerIds() []string {
+	if x != nil {
+		return x.xxx_hidden_GuestOwnerIds
+	}
+	return nil
+}
+
+func (x *OrderItem) GetCustomerOwnerIds() []string {
+	if x != nil {
+		return x.xxx_hidden_CustomerOwnerIds
+	}
+	return nil
+}
+
+func (x *OrderItem) GetMenuItemId() string {
+	if x != nil {
+		if x.xxx_hidden_MenuItemId != nil {
+			return *x.xxx_hidden_MenuItemId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OrderItem) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPhotoPathinfo() string {
+	if x != nil {
+		if x.xxx_hidden_PhotoPathinfo != nil {
+			return *x.xxx_hidden_PhotoPathinfo
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPrice() int32 {
+	if x != nil {
+		return x.xxx_hidden_Price
+	}
+	return 0
+}
+
+func (x *OrderItem) GetPortionSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PortionSize
+	}
+	return 0
+}
+
+func (x *OrderItem) GetModifiersConfig() []byte {
+	if x != nil {
+		return x.xxx_hidden_ModifiersConfig
+	}
+	return nil
+}
+
+func (x *OrderItem) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 12)
+}
+
+func (x *OrderItem) SetQuantity(v int32) {
+	x.xxx_hidden_Quantity = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 12)
+}
+
+func (x *OrderItem) SetModifiers(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Modifiers = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 12)
+}
+
+func (x *OrderItem) SetGuestOwnerIds(v []string) {
+	x.xxx_hidden_GuestOwnerIds = v
+}
+
+func (x *OrderItem) SetCustomerOwnerIds(v []string) {
+	x.xxx_hidden_CustomerOwnerIds = v
+}
+
+func (x *OrderItem) SetMenuItemId(v string) {
+	x.xxx_hidden_MenuItemId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 12)
+}
+
+func (x *OrderItem) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 12)
+}
+
+func (x *OrderItem) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 12)
+}
+
+func (x *OrderItem) SetPhotoPathinfo(v string) {
+	x.xxx_hidden_PhotoPathinfo = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 12)
+}
+
+func (x *OrderItem) SetPrice(v int32) {
+	x.xxx_hidden_Price = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 12)
+}
+
+func (x *OrderItem) SetPortionSize(v int32) {
+	x.xxx_hidden_PortionSize = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 12)
+}
+
+func (x *OrderItem) SetModifiersConfig(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_ModifiersConfig = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 12)
+}
+
+func (x *OrderItem) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *OrderItem) HasQuantity() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *OrderItem) HasModifiers() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *OrderItem) HasMenuItemId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *OrderItem) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *OrderItem) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *OrderItem) HasPhotoPathinfo() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *OrderItem) HasPrice() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *OrderItem) HasPortionSize() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *OrderItem) HasModifiersConfig() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *OrderItem) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *OrderItem) ClearQuantity() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Quantity = 0
+}
+
+func (x *OrderItem) ClearModifiers() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Modifiers = nil
+}
+
+func (x *OrderItem) ClearMenuItemId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_MenuItemId = nil
+}
+
+func (x *OrderItem) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *OrderItem) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Description = nil
+}
+
+func (x *OrderItem) ClearPhotoPathinfo() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_PhotoPathinfo = nil
+}
+
+func (x *OrderItem) ClearPrice() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Price = 0
+}
+
+func (x *OrderItem) ClearPortionSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_PortionSize = 0
+}
+
+func (x *OrderItem) ClearModifiersConfig() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_ModifiersConfig = nil
+}
+
+type OrderItem_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id               *string
+	Quantity         *int32
+	Modifiers        []byte
+	GuestOwnerIds    []string
+	CustomerOwnerIds []string
+	MenuItemId       *string
+	Name             *string
+	Description      *string
+	PhotoPathinfo    *string
+	Price            *int32
+	PortionSize      *int32
+	ModifiersConfig  []byte
+}
+
+func (b0 OrderItem_builder) Build() *OrderItem {
+	m0 := &OrderItem{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 12)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Quantity != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 12)
+		x.xxx_hidden_Quantity = *b.Quantity
+	}
+	if b.Modifiers != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 12)
+		x.xxx_hidden_Modifiers = b.Modifiers
+	}
+	x.xxx_hidden_GuestOwnerIds = b.GuestOwnerIds
+	x.xxx_hidden_CustomerOwnerIds = b.CustomerOwnerIds
+	if b.MenuItemId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 12)
+		x.xxx_hidden_MenuItemId = b.MenuItemId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 12)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 12)
+		x.xxx_hidden_Description = b.Description
+	}
+	if b.PhotoPathinfo != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 12)
+		x.xxx_hidden_PhotoPathinfo = b.PhotoPathinfo
+	}
+	if b.Price != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 12)
+		x.xxx_hidden_Price = *b.Price
+	}
+	if b.PortionSize != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 12)
+		x.xxx_hidden_PortionSize = *b.PortionSize
+	}
+	if b.ModifiersConfig != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 12)
+		x.xxx_hidden_ModifiersConfig = b.ModifiersConfig
+	}
+	return m0
+}
+
+type MenuItem struct {
+	state                           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                   *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Name                 *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Description          *string                `protobuf:"bytes,3,opt,name=description"`
+	xxx_hidden_PhotoPathinfo        *string                `protobuf:"bytes,4,opt,name=photo_pathinfo,json=photoPathinfo"`
+	xxx_hidden_Price                int32                  `protobuf:"varint,5,opt,name=price"`
+	xxx_hidden_PortionSize          int32                  `protobuf:"varint,6,opt,name=portion_size,json=portionSize"`
+	xxx_hidden_Available            bool                   `protobuf:"varint,7,opt,name=available"`
+	xxx_hidden_ModifiersConfig      []byte                 `protobuf:"bytes,8,opt,name=modifiers_config,json=modifiersConfig"`
+	xxx_hidden_MenuTags             *[]*MenuTag            `protobuf:"bytes,9,rep,name=menu_tags,json=menuTags"`
+	xxx_hidden_CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt"`
+	xxx_hidden_DeletedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt"`
+	xxx_hidden_Photo                *MenuItemPhoto         `protobuf:"bytes,12,opt,name=photo"`
+	xxx_hidden_MenuIds              []string               `protobuf:"bytes,13,rep,name=menu_ids,json=menuIds"`
+	xxx_hidden_AvailabilityOverride *AvailabilityOverride  `protobuf:"bytes,14,opt,name=availability_override,json=availabilityOverride"`
+	xxx_hidden_AvailableNow         bool                   `protobuf:"varint,15,opt,name=available_now,json=availableNow"`
+	XXX_raceDetectHookData          protoimpl.RaceDetectHookData
+	XXX_presence                    [1]uint32
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *MenuItem) Reset() {
+	*x = MenuItem{}
+	mi := &file_restaurant_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItem) ProtoMessage() {}
+
+func (x *MenuItem) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MenuItem) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MenuItem) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MenuItem) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MenuItem) GetPhotoPathinfo() string {
+	if x != nil {
+		if x.xxx_hidden_PhotoPathinfo != nil {
+			return *x.xxx_hidden_PhotoPathinfo
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MenuItem) GetPrice() int32 {
+	if x != nil {
+		return x.xxx_hidden_Price
+	}
+	return 0
+}
+
+func (x *MenuItem) GetPortionSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PortionSize
+	}
+	return 0
+}
+
+func (x *MenuItem) GetAvailable() bool {
+	if x != nil {
+		return x.xxx_hidden_Available
+	}
+	return false
+}
+
+func (x *MenuItem) GetModifiersConfig() []byte {
+	if x != nil {
+		return x.xxx_hidden_ModifiersConfig
+	}
+	return nil
+}
+
+func (x *MenuItem) GetMenuTags() []*MenuTag {
+	if x != nil {
+		if x.xxx_hidden_MenuTags != nil {
+			return *x.xxx_hidden_MenuTags
+		}
+	}
+	return nil
+}
+
+func (x *MenuItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *MenuItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_DeletedAt
+	}
+	return nil
+}
+
+func (x *MenuItem) GetPhoto() *MenuItemPhoto {
+	if x != nil {
+		return x.xxx_hidden_Photo
+	}
+	return nil
+}
+
+func (x *MenuItem) GetMenuIds() []string {
+	if x != nil {
+		return x.xxx_hidden_MenuIds
+	}
+	return nil
+}
+
+func (x *MenuItem) GetAvailabilityOverride() *AvailabilityOverride {
+	if x != nil {
+		return x.xxx_hidden_AvailabilityOverride
+	}
+	return nil
+}
+
+func (x *MenuItem) GetAvailableNow() bool {
+	if x != nil {
+		return x.xxx_hidden_AvailableNow
+	}
+	return false
+}
+
+func (x *MenuItem) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 15)
+}
+
+func (x *MenuItem) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 15)
+}
+
+func (x *MenuItem) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 15)
+}
+
+func (x *MenuItem) SetPhotoPathinfo(v string) {
+	x.xxx_hidden_PhotoPathinfo = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 15)
+}
+
+func (x *MenuItem) SetPrice(v int32) {
+	x.xxx_hidden_Price = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 15)
+}
+
+func (x *MenuItem) SetPortionSize(v int32) {
+	x.xxx_hidden_PortionSize = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 15)
+}
+
+func (x *MenuItem) SetAvailable(v bool) {
+	x.xxx_hidden_Available = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 15)
+}
+
+func (x *MenuItem) SetModifiersConfig(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_ModifiersConfig = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 15)
+}
+
+func (x *MenuItem) SetMenuTags(v []*MenuTag) {
+	x.xxx_hidden_MenuTags = &v
+}
+
+func (x *MenuItem) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *MenuItem) SetDeletedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_DeletedAt = v
+}
+
+func (x *MenuItem) SetPhoto(v *MenuItemPhoto) {
+	x.xxx_hidden_Photo = v
+}
+
+func (x *MenuItem) SetMenuIds(v []string) {
+	x.xxx_hidden_MenuIds = v
+}
+
+func (x *MenuItem) SetAvailabilityOverride(v *AvailabilityOverride) {
+	x.xxx_hidden_AvailabilityOverride = v
+}
+
+func (x *MenuItem) SetAvailableNow(v bool) {
+	x.xxx_hidden_AvailableNow = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 15)
+}
+
+func (x *MenuItem) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MenuItem) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MenuItem) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *MenuItem) HasPhotoPathinfo() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *MenuItem) HasPrice() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *MenuItem) HasPortionSize() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
 }
 
-func (x *AddOrderItemGuestOwnerRequest) HasOrderItemId() bool {
+func (x *MenuItem) HasAvailable() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
 }
 
-func (x *AddOrderItemGuestOwnerRequest) HasGuestId() bool {
+func (x *MenuItem) HasModifiersConfig() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
 }
 
-func (x *AddOrderItemGuestOwnerRequest) ClearOrderItemId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_OrderItemId = nil
+func (x *MenuItem) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
 }
 
-func (x *AddOrderItemGuestOwnerRequest) ClearGuestId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_GuestId = nil
+func (x *MenuItem) HasDeletedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DeletedAt != nil
 }
 
-type AddOrderItemGuestOwnerRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	OrderItemId *string
-	GuestId     *string
+func (x *MenuItem) HasPhoto() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Photo != nil
 }
 
-func (b0 AddOrderItemGuestOwnerRequest_builder) Build() *AddOrderItemGuestOwnerRequest {
-	m0 := &AddOrderItemGuestOwnerRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.OrderItemId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_OrderItemId = b.OrderItemId
+func (x *MenuItem) HasAvailabilityOverride() bool {
+	if x == nil {
+		return false
 	}
-	if b.GuestId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_GuestId = b.GuestId
+	return x.xxx_hidden_AvailabilityOverride != nil
+}
+
+func (x *MenuItem) HasAvailableNow() bool {
+	if x == nil {
+		return false
 	}
-	return m0
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
 }
 
-type RemoveOrderItemGuestOwnerRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
-	xxx_hidden_GuestId     *string                `protobuf:"bytes,2,opt,name=guest_id,json=guestId"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *MenuItem) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) Reset() {
-	*x = RemoveOrderItemGuestOwnerRequest{}
-	mi := &file_restaurant_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MenuItem) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MenuItem) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Description = nil
 }
 
-func (*RemoveOrderItemGuestOwnerRequest) ProtoMessage() {}
+func (x *MenuItem) ClearPhotoPathinfo() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_PhotoPathinfo = nil
+}
 
-func (x *RemoveOrderItemGuestOwnerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[16]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *MenuItem) ClearPrice() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Price = 0
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) GetOrderItemId() string {
-	if x != nil {
-		if x.xxx_hidden_OrderItemId != nil {
-			return *x.xxx_hidden_OrderItemId
-		}
-		return ""
-	}
-	return ""
+func (x *MenuItem) ClearPortionSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_PortionSize = 0
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) GetGuestId() string {
-	if x != nil {
-		if x.xxx_hidden_GuestId != nil {
-			return *x.xxx_hidden_GuestId
-		}
-		return ""
-	}
-	return ""
+func (x *MenuItem) ClearAvailable() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Available = false
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) SetOrderItemId(v string) {
-	x.xxx_hidden_OrderItemId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+func (x *MenuItem) ClearModifiersConfig() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_ModifiersConfig = nil
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) SetGuestId(v string) {
-	x.xxx_hidden_GuestId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *MenuItem) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) HasOrderItemId() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+func (x *MenuItem) ClearDeletedAt() {
+	x.xxx_hidden_DeletedAt = nil
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) HasGuestId() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+func (x *MenuItem) ClearPhoto() {
+	x.xxx_hidden_Photo = nil
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) ClearOrderItemId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_OrderItemId = nil
+func (x *MenuItem) ClearAvailabilityOverride() {
+	x.xxx_hidden_AvailabilityOverride = nil
 }
 
-func (x *RemoveOrderItemGuestOwnerRequest) ClearGuestId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_GuestId = nil
+func (x *MenuItem) ClearAvailableNow() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_AvailableNow = false
 }
 
-type RemoveOrderItemGuestOwnerRequest_builder struct {
+type MenuItem_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	OrderItemId *string
-	GuestId     *string
+	Id              *string
+	Name            *string
+	Description     *string
+	PhotoPathinfo   *string
+	Price           *int32
+	PortionSize     *int32
+	Available       *bool
+	ModifiersConfig []byte
+	MenuTags        []*MenuTag
+	CreatedAt       *timestamppb.Timestamp
+	DeletedAt       *timestamppb.Timestamp
+	// Uploaded with UploadMenuItemPhoto, unset without any
+	Photo *MenuItemPhoto
+	// The menus the item belongs to
+	MenuIds []string
+	// Set with SetMenuItemAvailabilityOverride, unset without any
+	AvailabilityOverride *AvailabilityOverride
+	// Whether the item can be ordered now, given available, the override and the schedules of its menus
+	AvailableNow *bool
 }
 
-func (b0 RemoveOrderItemGuestOwnerRequest_builder) Build() *RemoveOrderItemGuestOwnerRequest {
-	m0 := &RemoveOrderItemGuestOwnerRequest{}
+func (b0 MenuItem_builder) Build() *MenuItem {
+	m0 := &MenuItem{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.OrderItemId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_OrderItemId = b.OrderItemId
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 15)
+		x.xxx_hidden_Id = b.Id
 	}
-	if b.GuestId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_GuestId = b.GuestId
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 15)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 15)
+		x.xxx_hidden_Description = b.Description
+	}
+	if b.PhotoPathinfo != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 15)
+		x.xxx_hidden_PhotoPathinfo = b.PhotoPathinfo
+	}
+	if b.Price != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 15)
+		x.xxx_hidden_Price = *b.Price
+	}
+	if b.PortionSize != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 15)
+		x.xxx_hidden_PortionSize = *b.PortionSize
+	}
+	if b.Available != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 15)
+		x.xxx_hidden_Available = *b.Available
+	}
+	if b.ModifiersConfig != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 15)
+		x.xxx_hidden_ModifiersConfig = b.ModifiersConfig
+	}
+	x.xxx_hidden_MenuTags = &b.MenuTags
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_DeletedAt = b.DeletedAt
+	x.xxx_hidden_Photo = b.Photo
+	x.xxx_hidden_MenuIds = b.MenuIds
+	x.xxx_hidden_AvailabilityOverride = b.AvailabilityOverride
+	if b.AvailableNow != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 15)
+		x.xxx_hidden_AvailableNow = *b.AvailableNow
 	}
 	return m0
 }
 
-type AddOrderItemCustomerOwnerRequest struct {
+type AvailabilityOverride struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
-	xxx_hidden_CustomerId  *string                `protobuf:"bytes,2,opt,name=customer_id,json=customerId"`
+	xxx_hidden_Available   bool                   `protobuf:"varint,1,opt,name=available"`
+	xxx_hidden_Until       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) Reset() {
-	*x = AddOrderItemCustomerOwnerRequest{}
-	mi := &file_restaurant_proto_msgTypes[17]
+func (x *AvailabilityOverride) Reset() {
+	*x = AvailabilityOverride{}
+	mi := &file_restaurant_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) String() string {
+func (x *AvailabilityOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrderItemCustomerOwnerRequest) ProtoMessage() {}
+func (*AvailabilityOverride) ProtoMessage() {}
 
-func (x *AddOrderItemCustomerOwnerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[17]
+func (x *AvailabilityOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1975,107 +7431,97 @@ func (x *AddOrderItemCustomerOwnerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) GetOrderItemId() string {
+func (x *AvailabilityOverride) GetAvailable() bool {
 	if x != nil {
-		if x.xxx_hidden_OrderItemId != nil {
-			return *x.xxx_hidden_OrderItemId
-		}
-		return ""
+		return x.xxx_hidden_Available
 	}
-	return ""
+	return false
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) GetCustomerId() string {
+func (x *AvailabilityOverride) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		if x.xxx_hidden_CustomerId != nil {
-			return *x.xxx_hidden_CustomerId
-		}
-		return ""
+		return x.xxx_hidden_Until
 	}
-	return ""
+	return nil
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) SetOrderItemId(v string) {
-	x.xxx_hidden_OrderItemId = &v
+func (x *AvailabilityOverride) SetAvailable(v bool) {
+	x.xxx_hidden_Available = v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) SetCustomerId(v string) {
-	x.xxx_hidden_CustomerId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *AvailabilityOverride) SetUntil(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Until = v
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) HasOrderItemId() bool {
+func (x *AvailabilityOverride) HasAvailable() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) HasCustomerId() bool {
+func (x *AvailabilityOverride) HasUntil() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return x.xxx_hidden_Until != nil
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) ClearOrderItemId() {
+func (x *AvailabilityOverride) ClearAvailable() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_OrderItemId = nil
+	x.xxx_hidden_Available = false
 }
 
-func (x *AddOrderItemCustomerOwnerRequest) ClearCustomerId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_CustomerId = nil
+func (x *AvailabilityOverride) ClearUntil() {
+	x.xxx_hidden_Until = nil
 }
 
-type AddOrderItemCustomerOwnerRequest_builder struct {
+type AvailabilityOverride_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	OrderItemId *string
-	CustomerId  *string
+	Available *bool
+	// Unset for an override without end
+	Until *timestamppb.Timestamp
 }
 
-func (b0 AddOrderItemCustomerOwnerRequest_builder) Build() *AddOrderItemCustomerOwnerRequest {
-	m0 := &AddOrderItemCustomerOwnerRequest{}
+func (b0 AvailabilityOverride_builder) Build() *AvailabilityOverride {
+	m0 := &AvailabilityOverride{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.OrderItemId != nil {
+	if b.Available != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_OrderItemId = b.OrderItemId
-	}
-	if b.CustomerId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_CustomerId = b.CustomerId
+		x.xxx_hidden_Available = *b.Available
 	}
+	x.xxx_hidden_Until = b.Until
 	return m0
 }
 
-type RemoveOrderItemCustomerOwnerRequest struct {
+type SetMenuItemAvailabilityOverrideRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_OrderItemId *string                `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId"`
-	xxx_hidden_CustomerId  *string                `protobuf:"bytes,2,opt,name=customer_id,json=customerId"`
+	xxx_hidden_MenuItemId  *string                `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId"`
+	xxx_hidden_Override    *AvailabilityOverride  `protobuf:"bytes,2,opt,name=override"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) Reset() {
-	*x = RemoveOrderItemCustomerOwnerRequest{}
-	mi := &file_restaurant_proto_msgTypes[18]
+func (x *SetMenuItemAvailabilityOverrideRequest) Reset() {
+	*x = SetMenuItemAvailabilityOverrideRequest{}
+	mi := &file_restaurant_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) String() string {
+func (x *SetMenuItemAvailabilityOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveOrderItemCustomerOwnerRequest) ProtoMessage() {}
+func (*SetMenuItemAvailabilityOverrideRequest) ProtoMessage() {}
 
-func (x *RemoveOrderItemCustomerOwnerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[18]
+func (x *SetMenuItemAvailabilityOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2086,106 +7532,109 @@ func (x *RemoveOrderItemCustomerOwnerRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) GetOrderItemId() string {
+func (x *SetMenuItemAvailabilityOverrideRequest) GetMenuItemId() string {
 	if x != nil {
-		if x.xxx_hidden_OrderItemId != nil {
-			return *x.xxx_hidden_OrderItemId
+		if x.xxx_hidden_MenuItemId != nil {
+			return *x.xxx_hidden_MenuItemId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) GetCustomerId() string {
+func (x *SetMenuItemAvailabilityOverrideRequest) GetOverride() *AvailabilityOverride {
 	if x != nil {
-		if x.xxx_hidden_CustomerId != nil {
-			return *x.xxx_hidden_CustomerId
-		}
-		return ""
+		return x.xxx_hidden_Override
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) SetOrderItemId(v string) {
-	x.xxx_hidden_OrderItemId = &v
+func (x *SetMenuItemAvailabilityOverrideRequest) SetMenuItemId(v string) {
+	x.xxx_hidden_MenuItemId = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) SetCustomerId(v string) {
-	x.xxx_hidden_CustomerId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *SetMenuItemAvailabilityOverrideRequest) SetOverride(v *AvailabilityOverride) {
+	x.xxx_hidden_Override = v
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) HasOrderItemId() bool {
+func (x *SetMenuItemAvailabilityOverrideRequest) HasMenuItemId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) HasCustomerId() bool {
+func (x *SetMenuItemAvailabilityOverrideRequest) HasOverride() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return x.xxx_hidden_Override != nil
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) ClearOrderItemId() {
+func (x *SetMenuItemAvailabilityOverrideRequest) ClearMenuItemId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_OrderItemId = nil
+	x.xxx_hidden_MenuItemId = nil
 }
 
-func (x *RemoveOrderItemCustomerOwnerRequest) ClearCustomerId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_CustomerId = nil
+func (x *SetMenuItemAvailabilityOverrideRequest) ClearOverride() {
+	x.xxx_hidden_Override = nil
 }
 
-type RemoveOrderItemCustomerOwnerRequest_builder struct {
+type SetMenuItemAvailabilityOverrideRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	OrderItemId *string
-	CustomerId  *string
+	MenuItemId *string
+	// Unset to remove the override
+	Override *AvailabilityOverride
 }
 
-func (b0 RemoveOrderItemCustomerOwnerRequest_builder) Build() *RemoveOrderItemCustomerOwnerRequest {
-	m0 := &RemoveOrderItemCustomerOwnerRequest{}
+func (b0 SetMenuItemAvailabilityOverrideRequest_builder) Build() *SetMenuItemAvailabilityOverrideRequest {
+	m0 := &SetMenuItemAvailabilityOverrideRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.OrderItemId != nil {
+	if b.MenuItemId != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_OrderItemId = b.OrderItemId
-	}
-	if b.CustomerId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_CustomerId = b.CustomerId
+		x.xxx_hidden_MenuItemId = b.MenuItemId
 	}
+	x.xxx_hidden_Override = b.Override
 	return m0
 }
 
-type SendOrderRequest struct {
+// A group of menu items served during a period, such as breakfast, active during any of its
+// schedules, or always without any
+type Menu struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_OrderId     *string                `protobuf:"bytes,1,opt,name=order_id,json=orderId"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Description *string                `protobuf:"bytes,3,opt,name=description"`
+	xxx_hidden_Timezone    *string                `protobuf:"bytes,4,opt,name=timezone"`
+	xxx_hidden_Schedules   *[]*MenuSchedule       `protobuf:"bytes,5,rep,name=schedules"`
+	xxx_hidden_MenuItemIds []string               `protobuf:"bytes,6,rep,name=menu_item_ids,json=menuItemIds"`
+	xxx_hidden_Active      bool                   `protobuf:"varint,7,opt,name=active"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *SendOrderRequest) Reset() {
-	*x = SendOrderRequest{}
-	mi := &file_restaurant_proto_msgTypes[19]
+func (x *Menu) Reset() {
+	*x = Menu{}
+	mi := &file_restaurant_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendOrderRequest) String() string {
+func (x *Menu) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendOrderRequest) ProtoMessage() {}
+func (*Menu) ProtoMessage() {}
 
-func (x *SendOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[19]
+func (x *Menu) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2196,153 +7645,282 @@ func (x *SendOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *SendOrderRequest) GetOrderId() string {
+func (x *Menu) GetId() string {
 	if x != nil {
-		if x.xxx_hidden_OrderId != nil {
-			return *x.xxx_hidden_OrderId
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *SendOrderRequest) SetOrderId(v string) {
-	x.xxx_hidden_OrderId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *Menu) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *SendOrderRequest) HasOrderId() bool {
-	if x == nil {
-		return false
+func (x *Menu) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return ""
 }
 
-func (x *SendOrderRequest) ClearOrderId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_OrderId = nil
+func (x *Menu) GetTimezone() string {
+	if x != nil {
+		if x.xxx_hidden_Timezone != nil {
+			return *x.xxx_hidden_Timezone
+		}
+		return ""
+	}
+	return ""
 }
 
-type SendOrderRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *Menu) GetSchedules() []*MenuSchedule {
+	if x != nil {
+		if x.xxx_hidden_Schedules != nil {
+			return *x.xxx_hidden_Schedules
+		}
+	}
+	return nil
+}
 
-	OrderId *string
+func (x *Menu) GetMenuItemIds() []string {
+	if x != nil {
+		return x.xxx_hidden_MenuItemIds
+	}
+	return nil
 }
 
-func (b0 SendOrderRequest_builder) Build() *SendOrderRequest {
-	m0 := &SendOrderRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.OrderId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_OrderId = b.OrderId
+func (x *Menu) GetActive() bool {
+	if x != nil {
+		return x.xxx_hidden_Active
 	}
-	return m0
+	return false
 }
 
-type TabID struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *Menu) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
 }
 
-func (x *TabID) Reset() {
-	*x = TabID{}
-	mi := &file_restaurant_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Menu) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
 }
 
-func (x *TabID) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Menu) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
 }
 
-func (*TabID) ProtoMessage() {}
+func (x *Menu) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
 
-func (x *TabID) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[20]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Menu) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *Menu) SetTimezone(v string) {
+	x.xxx_hidden_Timezone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *Menu) SetSchedules(v []*MenuSchedule) {
+	x.xxx_hidden_Schedules = &v
+}
+
+func (x *Menu) SetMenuItemIds(v []string) {
+	x.xxx_hidden_MenuItemIds = v
+}
+
+func (x *Menu) SetActive(v bool) {
+	x.xxx_hidden_Active = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *Menu) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Menu) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *Menu) HasId() bool {
+	if x == nil {
+		return false
 	}
-	return mi.MessageOf(x)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *TabID) GetId() string {
-	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
+func (x *Menu) HasName() bool {
+	if x == nil {
+		return false
 	}
-	return ""
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *TabID) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *Menu) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *TabID) HasId() bool {
+func (x *Menu) HasTimezone() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *TabID) ClearId() {
+func (x *Menu) HasActive() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Menu) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Menu) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *Menu) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
 }
 
-type TabID_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *Menu) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
 
-	Id *string
+func (x *Menu) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Description = nil
 }
 
-func (b0 TabID_builder) Build() *TabID {
-	m0 := &TabID{}
+func (x *Menu) ClearTimezone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Timezone = nil
+}
+
+func (x *Menu) ClearActive() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Active = false
+}
+
+func (x *Menu) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Menu) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+type Menu_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id          *string
+	Name        *string
+	Description *string
+	// IANA timezone of the schedules, UTC by default
+	Timezone    *string
+	Schedules   []*MenuSchedule
+	MenuItemIds []string
+	// Whether the menu is active now
+	Active    *bool
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt *timestamppb.Timestamp
+}
+
+func (b0 Menu_builder) Build() *Menu {
+	m0 := &Menu{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
 		x.xxx_hidden_Id = b.Id
 	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_Description = b.Description
+	}
+	if b.Timezone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_Timezone = b.Timezone
+	}
+	x.xxx_hidden_Schedules = &b.Schedules
+	x.xxx_hidden_MenuItemIds = b.MenuItemIds
+	if b.Active != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_Active = *b.Active
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	return m0
 }
 
-type VisitTabRequest struct {
+// A weekly time window, ending the next day when end_time is not after start_time
+type MenuSchedule struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TabId       *string                `protobuf:"bytes,1,opt,name=tab_id,json=tabId"`
-	xxx_hidden_CustomerId  *string                `protobuf:"bytes,2,opt,name=customer_id,json=customerId"`
+	xxx_hidden_Weekdays    []int32                `protobuf:"varint,1,rep,packed,name=weekdays"`
+	xxx_hidden_StartTime   *string                `protobuf:"bytes,2,opt,name=start_time,json=startTime"`
+	xxx_hidden_EndTime     *string                `protobuf:"bytes,3,opt,name=end_time,json=endTime"`
+	xxx_hidden_StartDate   *string                `protobuf:"bytes,4,opt,name=start_date,json=startDate"`
+	xxx_hidden_EndDate     *string                `protobuf:"bytes,5,opt,name=end_date,json=endDate"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *VisitTabRequest) Reset() {
-	*x = VisitTabRequest{}
-	mi := &file_restaurant_proto_msgTypes[21]
+func (x *MenuSchedule) Reset() {
+	*x = MenuSchedule{}
+	mi := &file_restaurant_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VisitTabRequest) String() string {
+func (x *MenuSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VisitTabRequest) ProtoMessage() {}
+func (*MenuSchedule) ProtoMessage() {}
 
-func (x *VisitTabRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[21]
+func (x *MenuSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2353,106 +7931,184 @@ func (x *VisitTabRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *VisitTabRequest) GetTabId() string {
+func (x *MenuSchedule) GetWeekdays() []int32 {
 	if x != nil {
-		if x.xxx_hidden_TabId != nil {
-			return *x.xxx_hidden_TabId
+		return x.xxx_hidden_Weekdays
+	}
+	return nil
+}
+
+func (x *MenuSchedule) GetStartTime() string {
+	if x != nil {
+		if x.xxx_hidden_StartTime != nil {
+			return *x.xxx_hidden_StartTime
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *VisitTabRequest) GetCustomerId() string {
+func (x *MenuSchedule) GetEndTime() string {
 	if x != nil {
-		if x.xxx_hidden_CustomerId != nil {
-			return *x.xxx_hidden_CustomerId
+		if x.xxx_hidden_EndTime != nil {
+			return *x.xxx_hidden_EndTime
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *VisitTabRequest) SetTabId(v string) {
-	x.xxx_hidden_TabId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+func (x *MenuSchedule) GetStartDate() string {
+	if x != nil {
+		if x.xxx_hidden_StartDate != nil {
+			return *x.xxx_hidden_StartDate
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *VisitTabRequest) SetCustomerId(v string) {
-	x.xxx_hidden_CustomerId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *MenuSchedule) GetEndDate() string {
+	if x != nil {
+		if x.xxx_hidden_EndDate != nil {
+			return *x.xxx_hidden_EndDate
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *VisitTabRequest) HasTabId() bool {
+func (x *MenuSchedule) SetWeekdays(v []int32) {
+	x.xxx_hidden_Weekdays = v
+}
+
+func (x *MenuSchedule) SetStartTime(v string) {
+	x.xxx_hidden_StartTime = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *MenuSchedule) SetEndTime(v string) {
+	x.xxx_hidden_EndTime = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *MenuSchedule) SetStartDate(v string) {
+	x.xxx_hidden_StartDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *MenuSchedule) SetEndDate(v string) {
+	x.xxx_hidden_EndDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *MenuSchedule) HasStartTime() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *VisitTabRequest) HasCustomerId() bool {
+func (x *MenuSchedule) HasEndTime() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *VisitTabRequest) ClearTabId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_TabId = nil
+func (x *MenuSchedule) HasStartDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *VisitTabRequest) ClearCustomerId() {
+func (x *MenuSchedule) HasEndDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *MenuSchedule) ClearStartTime() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_CustomerId = nil
+	x.xxx_hidden_StartTime = nil
 }
 
-type VisitTabRequest_builder struct {
+func (x *MenuSchedule) ClearEndTime() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_EndTime = nil
+}
+
+func (x *MenuSchedule) ClearStartDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_StartDate = nil
+}
+
+func (x *MenuSchedule) ClearEndDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_EndDate = nil
+}
+
+type MenuSchedule_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	TabId      *string
-	CustomerId *string
+	// 0 for Sunday to 6 for Saturday, every day when empty
+	Weekdays []int32
+	// HH:MM
+	StartTime *string
+	EndTime   *string
+	// Optional YYYY-MM-DD bounds of the days the window starts on
+	StartDate *string
+	EndDate   *string
 }
 
-func (b0 VisitTabRequest_builder) Build() *VisitTabRequest {
-	m0 := &VisitTabRequest{}
+func (b0 MenuSchedule_builder) Build() *MenuSchedule {
+	m0 := &MenuSchedule{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.TabId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_TabId = b.TabId
+	x.xxx_hidden_Weekdays = b.Weekdays
+	if b.StartTime != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_StartTime = b.StartTime
 	}
-	if b.CustomerId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_CustomerId = b.CustomerId
+	if b.EndTime != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_EndTime = b.EndTime
+	}
+	if b.StartDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_StartDate = b.StartDate
+	}
+	if b.EndDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_EndDate = b.EndDate
 	}
 	return m0
 }
 
-type CreateGuestRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TabId       *string                `protobuf:"bytes,1,opt,name=tab_id,json=tabId"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type CreateMenuRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Menu *Menu                  `protobuf:"bytes,1,opt,name=menu"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateGuestRequest) Reset() {
-	*x = CreateGuestRequest{}
-	mi := &file_restaurant_proto_msgTypes[22]
+func (x *CreateMenuRequest) Reset() {
+	*x = CreateMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGuestRequest) String() string {
+func (x *CreateMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGuestRequest) ProtoMessage() {}
+func (*CreateMenuRequest) ProtoMessage() {}
 
-func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[22]
+func (x *CreateMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2463,51 +8119,43 @@ func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateGuestRequest) GetTabId() string {
+func (x *CreateMenuRequest) GetMenu() *Menu {
 	if x != nil {
-		if x.xxx_hidden_TabId != nil {
-			return *x.xxx_hidden_TabId
-		}
-		return ""
+		return x.xxx_hidden_Menu
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateGuestRequest) SetTabId(v string) {
-	x.xxx_hidden_TabId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *CreateMenuRequest) SetMenu(v *Menu) {
+	x.xxx_hidden_Menu = v
 }
 
-func (x *CreateGuestRequest) HasTabId() bool {
+func (x *CreateMenuRequest) HasMenu() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return x.xxx_hidden_Menu != nil
 }
 
-func (x *CreateGuestRequest) ClearTabId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_TabId = nil
+func (x *CreateMenuRequest) ClearMenu() {
+	x.xxx_hidden_Menu = nil
 }
 
-type CreateGuestRequest_builder struct {
+type CreateMenuRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	TabId *string
+	Menu *Menu
 }
 
-func (b0 CreateGuestRequest_builder) Build() *CreateGuestRequest {
-	m0 := &CreateGuestRequest{}
+func (b0 CreateMenuRequest_builder) Build() *CreateMenuRequest {
+	m0 := &CreateMenuRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.TabId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_TabId = b.TabId
-	}
+	x.xxx_hidden_Menu = b.Menu
 	return m0
 }
 
-type GuestID struct {
+type GetMenuRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
@@ -2516,21 +8164,21 @@ type GuestID struct {
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GuestID) Reset() {
-	*x = GuestID{}
-	mi := &file_restaurant_proto_msgTypes[23]
+func (x *GetMenuRequest) Reset() {
+	*x = GetMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GuestID) String() string {
+func (x *GetMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuestID) ProtoMessage() {}
+func (*GetMenuRequest) ProtoMessage() {}
 
-func (x *GuestID) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[23]
+func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2541,7 +8189,7 @@ func (x *GuestID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GuestID) GetId() string {
+func (x *GetMenuRequest) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -2551,31 +8199,31 @@ func (x *GuestID) GetId() string {
 	return ""
 }
 
-func (x *GuestID) SetId(v string) {
+func (x *GetMenuRequest) SetId(v string) {
 	x.xxx_hidden_Id = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *GuestID) HasId() bool {
+func (x *GetMenuRequest) HasId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GuestID) ClearId() {
+func (x *GetMenuRequest) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
 }
 
-type GuestID_builder struct {
+type GetMenuRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Id *string
 }
 
-func (b0 GuestID_builder) Build() *GuestID {
-	m0 := &GuestID{}
+func (b0 GetMenuRequest_builder) Build() *GetMenuRequest {
+	m0 := &GetMenuRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
@@ -2585,31 +8233,28 @@ func (b0 GuestID_builder) Build() *GuestID {
 	return m0
 }
 
-type UpdateGuestNameRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_GuestId     *string                `protobuf:"bytes,1,opt,name=guest_id,json=guestId"`
-	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type ListMenusResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Menus *[]*Menu               `protobuf:"bytes,1,rep,name=menus"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateGuestNameRequest) Reset() {
-	*x = UpdateGuestNameRequest{}
-	mi := &file_restaurant_proto_msgTypes[24]
+func (x *ListMenusResponse) Reset() {
+	*x = ListMenusResponse{}
+	mi := &file_restaurant_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGuestNameRequest) String() string {
+func (x *ListMenusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGuestNameRequest) ProtoMessage() {}
+func (*ListMenusResponse) ProtoMessage() {}
 
-func (x *UpdateGuestNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[24]
+func (x *ListMenusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2620,106 +8265,55 @@ func (x *UpdateGuestNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateGuestNameRequest) GetGuestId() string {
-	if x != nil {
-		if x.xxx_hidden_GuestId != nil {
-			return *x.xxx_hidden_GuestId
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *UpdateGuestNameRequest) GetName() string {
+func (x *ListMenusResponse) GetMenus() []*Menu {
 	if x != nil {
-		if x.xxx_hidden_Name != nil {
-			return *x.xxx_hidden_Name
+		if x.xxx_hidden_Menus != nil {
+			return *x.xxx_hidden_Menus
 		}
-		return ""
-	}
-	return ""
-}
-
-func (x *UpdateGuestNameRequest) SetGuestId(v string) {
-	x.xxx_hidden_GuestId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
-}
-
-func (x *UpdateGuestNameRequest) SetName(v string) {
-	x.xxx_hidden_Name = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
-}
-
-func (x *UpdateGuestNameRequest) HasGuestId() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
-}
-
-func (x *UpdateGuestNameRequest) HasName() bool {
-	if x == nil {
-		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
-}
-
-func (x *UpdateGuestNameRequest) ClearGuestId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_GuestId = nil
+	return nil
 }
 
-func (x *UpdateGuestNameRequest) ClearName() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Name = nil
+func (x *ListMenusResponse) SetMenus(v []*Menu) {
+	x.xxx_hidden_Menus = &v
 }
 
-type UpdateGuestNameRequest_builder struct {
+type ListMenusResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	GuestId *string
-	Name    *string
+	Menus []*Menu
 }
 
-func (b0 UpdateGuestNameRequest_builder) Build() *UpdateGuestNameRequest {
-	m0 := &UpdateGuestNameRequest{}
+func (b0 ListMenusResponse_builder) Build() *ListMenusResponse {
+	m0 := &ListMenusResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.GuestId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_GuestId = b.GuestId
-	}
-	if b.Name != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_Name = b.Name
-	}
+	x.xxx_hidden_Menus = &b.Menus
 	return m0
 }
 
-type GetOpenTabRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TabId       *string                `protobuf:"bytes,1,opt,name=tab_id,json=tabId"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type UpdateMenuRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Menu *Menu                  `protobuf:"bytes,1,opt,name=menu"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetOpenTabRequest) Reset() {
-	*x = GetOpenTabRequest{}
-	mi := &file_restaurant_proto_msgTypes[25]
+func (x *UpdateMenuRequest) Reset() {
+	*x = UpdateMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenTabRequest) String() string {
+func (x *UpdateMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenTabRequest) ProtoMessage() {}
+func (*UpdateMenuRequest) ProtoMessage() {}
 
-func (x *GetOpenTabRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[25]
+func (x *UpdateMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2730,74 +8324,66 @@ func (x *GetOpenTabRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetOpenTabRequest) GetTabId() string {
+func (x *UpdateMenuRequest) GetMenu() *Menu {
 	if x != nil {
-		if x.xxx_hidden_TabId != nil {
-			return *x.xxx_hidden_TabId
-		}
-		return ""
+		return x.xxx_hidden_Menu
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOpenTabRequest) SetTabId(v string) {
-	x.xxx_hidden_TabId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *UpdateMenuRequest) SetMenu(v *Menu) {
+	x.xxx_hidden_Menu = v
 }
 
-func (x *GetOpenTabRequest) HasTabId() bool {
+func (x *UpdateMenuRequest) HasMenu() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return x.xxx_hidden_Menu != nil
 }
 
-func (x *GetOpenTabRequest) ClearTabId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_TabId = nil
+func (x *UpdateMenuRequest) ClearMenu() {
+	x.xxx_hidden_Menu = nil
 }
 
-type GetOpenTabRequest_builder struct {
+type UpdateMenuRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	TabId *string
+	Menu *Menu
 }
 
-func (b0 GetOpenTabRequest_builder) Build() *GetOpenTabRequest {
-	m0 := &GetOpenTabRequest{}
+func (b0 UpdateMenuRequest_builder) Build() *UpdateMenuRequest {
+	m0 := &UpdateMenuRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.TabId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_TabId = b.TabId
-	}
+	x.xxx_hidden_Menu = b.Menu
 	return m0
 }
 
-type CloseTabRequest struct {
+type DeleteMenuRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TabId       *string                `protobuf:"bytes,1,opt,name=tab_id,json=tabId"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *CloseTabRequest) Reset() {
-	*x = CloseTabRequest{}
-	mi := &file_restaurant_proto_msgTypes[26]
+func (x *DeleteMenuRequest) Reset() {
+	*x = DeleteMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseTabRequest) String() string {
+func (x *DeleteMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseTabRequest) ProtoMessage() {}
+func (*DeleteMenuRequest) ProtoMessage() {}
 
-func (x *CloseTabRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[26]
+func (x *DeleteMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,72 +8394,72 @@ func (x *CloseTabRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CloseTabRequest) GetTabId() string {
+func (x *DeleteMenuRequest) GetId() string {
 	if x != nil {
-		if x.xxx_hidden_TabId != nil {
-			return *x.xxx_hidden_TabId
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *CloseTabRequest) SetTabId(v string) {
-	x.xxx_hidden_TabId = &v
+func (x *DeleteMenuRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *CloseTabRequest) HasTabId() bool {
+func (x *DeleteMenuRequest) HasId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *CloseTabRequest) ClearTabId() {
+func (x *DeleteMenuRequest) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_TabId = nil
+	x.xxx_hidden_Id = nil
 }
 
-type CloseTabRequest_builder struct {
+type DeleteMenuRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	TabId *string
+	Id *string
 }
 
-func (b0 CloseTabRequest_builder) Build() *CloseTabRequest {
-	m0 := &CloseTabRequest{}
+func (b0 DeleteMenuRequest_builder) Build() *DeleteMenuRequest {
+	m0 := &DeleteMenuRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.TabId != nil {
+	if b.Id != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_TabId = b.TabId
+		x.xxx_hidden_Id = b.Id
 	}
 	return m0
 }
 
-type CloseTabResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_ClosedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=closed_at,json=closedAt"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type UploadMenuItemPhotoRequest struct {
+	state           protoimpl.MessageState            `protogen:"opaque.v1"`
+	xxx_hidden_Data isUploadMenuItemPhotoRequest_Data `protobuf_oneof:"data"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CloseTabResponse) Reset() {
-	*x = CloseTabResponse{}
-	mi := &file_restaurant_proto_msgTypes[27]
+func (x *UploadMenuItemPhotoRequest) Reset() {
+	*x = UploadMenuItemPhotoRequest{}
+	mi := &file_restaurant_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseTabResponse) String() string {
+func (x *UploadMenuItemPhotoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseTabResponse) ProtoMessage() {}
+func (*UploadMenuItemPhotoRequest) ProtoMessage() {}
 
-func (x *CloseTabResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[27]
+func (x *UploadMenuItemPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2884,66 +8470,168 @@ func (x *CloseTabResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CloseTabResponse) GetClosedAt() *timestamppb.Timestamp {
+func (x *UploadMenuItemPhotoRequest) GetMenuItemId() string {
 	if x != nil {
-		return x.xxx_hidden_ClosedAt
+		if x, ok := x.xxx_hidden_Data.(*uploadMenuItemPhotoRequest_MenuItemId); ok {
+			return x.MenuItemId
+		}
+	}
+	return ""
+}
+
+func (x *UploadMenuItemPhotoRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Data.(*uploadMenuItemPhotoRequest_Chunk); ok {
+			return x.Chunk
+		}
 	}
 	return nil
 }
 
-func (x *CloseTabResponse) SetClosedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_ClosedAt = v
+func (x *UploadMenuItemPhotoRequest) SetMenuItemId(v string) {
+	x.xxx_hidden_Data = &uploadMenuItemPhotoRequest_MenuItemId{v}
 }
 
-func (x *CloseTabResponse) HasClosedAt() bool {
+func (x *UploadMenuItemPhotoRequest) SetChunk(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = &uploadMenuItemPhotoRequest_Chunk{v}
+}
+
+func (x *UploadMenuItemPhotoRequest) HasData() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_ClosedAt != nil
+	return x.xxx_hidden_Data != nil
 }
 
-func (x *CloseTabResponse) ClearClosedAt() {
-	x.xxx_hidden_ClosedAt = nil
+func (x *UploadMenuItemPhotoRequest) HasMenuItemId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Data.(*uploadMenuItemPhotoRequest_MenuItemId)
+	return ok
 }
 
-type CloseTabResponse_builder struct {
+func (x *UploadMenuItemPhotoRequest) HasChunk() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Data.(*uploadMenuItemPhotoRequest_Chunk)
+	return ok
+}
+
+func (x *UploadMenuItemPhotoRequest) ClearData() {
+	x.xxx_hidden_Data = nil
+}
+
+func (x *UploadMenuItemPhotoRequest) ClearMenuItemId() {
+	if _, ok := x.xxx_hidden_Data.(*uploadMenuItemPhotoRequest_MenuItemId); ok {
+		x.xxx_hidden_Data = nil
+	}
+}
+
+func (x *UploadMenuItemPhotoRequest) ClearChunk() {
+	if _, ok := x.xxx_hidden_Data.(*uploadMenuItemPhotoRequest_Chunk); ok {
+		x.xxx_hidden_Data = nil
+	}
+}
+
+const UploadMenuItemPhotoRequest_Data_not_set_case case_UploadMenuItemPhotoRequest_Data = 0
+const UploadMenuItemPhotoRequest_MenuItemId_case case_UploadMenuItemPhotoRequest_Data = 1
+const UploadMenuItemPhotoRequest_Chunk_case case_UploadMenuItemPhotoRequest_Data = 2
+
+func (x *UploadMenuItemPhotoRequest) WhichData() case_UploadMenuItemPhotoRequest_Data {
+	if x == nil {
+		return UploadMenuItemPhotoRequest_Data_not_set_case
+	}
+	switch x.xxx_hidden_Data.(type) {
+	case *uploadMenuItemPhotoRequest_MenuItemId:
+		return UploadMenuItemPhotoRequest_MenuItemId_case
+	case *uploadMenuItemPhotoRequest_Chunk:
+		return UploadMenuItemPhotoRequest_Chunk_case
+	default:
+		return UploadMenuItemPhotoRequest_Data_not_set_case
+	}
+}
+
+type UploadMenuItemPhotoRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	ClosedAt *timestamppb.Timestamp
+	// Fields of oneof xxx_hidden_Data:
+	// Only in the first message
+	MenuItemId *string
+	// In the following messages, the bytes of a JPEG, PNG or WebP image
+	Chunk []byte
+	// -- end of xxx_hidden_Data
 }
 
-func (b0 CloseTabResponse_builder) Build() *CloseTabResponse {
-	m0 := &CloseTabResponse{}
+func (b0 UploadMenuItemPhotoRequest_builder) Build() *UploadMenuItemPhotoRequest {
+	m0 := &UploadMenuItemPhotoRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_ClosedAt = b.ClosedAt
+	if b.MenuItemId != nil {
+		x.xxx_hidden_Data = &uploadMenuItemPhotoRequest_MenuItemId{*b.MenuItemId}
+	}
+	if b.Chunk != nil {
+		x.xxx_hidden_Data = &uploadMenuItemPhotoRequest_Chunk{b.Chunk}
+	}
 	return m0
 }
 
-type GetVisitedTabsRequest struct {
+type case_UploadMenuItemPhotoRequest_Data protoreflect.FieldNumber
+
+func (x case_UploadMenuItemPhotoRequest_Data) String() string {
+	md := file_restaurant_proto_msgTypes[68].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isUploadMenuItemPhotoRequest_Data interface {
+	isUploadMenuItemPhotoRequest_Data()
+}
+
+type uploadMenuItemPhotoRequest_MenuItemId struct {
+	// Only in the first message
+	MenuItemId string `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,oneof"`
+}
+
+type uploadMenuItemPhotoRequest_Chunk struct {
+	// In the following messages, the bytes of a JPEG, PNG or WebP image
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,oneof"`
+}
+
+func (*uploadMenuItemPhotoRequest_MenuItemId) isUploadMenuItemPhotoRequest_Data() {}
+
+func (*uploadMenuItemPhotoRequest_Chunk) isUploadMenuItemPhotoRequest_Data() {}
+
+type ExportMenuRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_CustomerId  *string                `protobuf:"bytes,1,opt,name=customer_id,json=customerId"`
+	xxx_hidden_Format      *string                `protobuf:"bytes,1,opt,name=format"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetVisitedTabsRequest) Reset() {
-	*x = GetVisitedTabsRequest{}
-	mi := &file_restaurant_proto_msgTypes[28]
+func (x *ExportMenuRequest) Reset() {
+	*x = ExportMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVisitedTabsRequest) String() string {
+func (x *ExportMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVisitedTabsRequest) ProtoMessage() {}
+func (*ExportMenuRequest) ProtoMessage() {}
 
-func (x *GetVisitedTabsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[28]
+func (x *ExportMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2954,72 +8642,76 @@ func (x *GetVisitedTabsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetVisitedTabsRequest) GetCustomerId() string {
+func (x *ExportMenuRequest) GetFormat() string {
 	if x != nil {
-		if x.xxx_hidden_CustomerId != nil {
-			return *x.xxx_hidden_CustomerId
+		if x.xxx_hidden_Format != nil {
+			return *x.xxx_hidden_Format
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *GetVisitedTabsRequest) SetCustomerId(v string) {
-	x.xxx_hidden_CustomerId = &v
+func (x *ExportMenuRequest) SetFormat(v string) {
+	x.xxx_hidden_Format = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *GetVisitedTabsRequest) HasCustomerId() bool {
+func (x *ExportMenuRequest) HasFormat() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GetVisitedTabsRequest) ClearCustomerId() {
+func (x *ExportMenuRequest) ClearFormat() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_CustomerId = nil
+	x.xxx_hidden_Format = nil
 }
 
-type GetVisitedTabsRequest_builder struct {
+type ExportMenuRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	CustomerId *string
+	// json (default) or csv
+	Format *string
 }
 
-func (b0 GetVisitedTabsRequest_builder) Build() *GetVisitedTabsRequest {
-	m0 := &GetVisitedTabsRequest{}
+func (b0 ExportMenuRequest_builder) Build() *ExportMenuRequest {
+	m0 := &ExportMenuRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.CustomerId != nil {
+	if b.Format != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_CustomerId = b.CustomerId
+		x.xxx_hidden_Format = b.Format
 	}
 	return m0
 }
 
-type GetVisitedTabsResponse struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Tabs *[]*Tab                `protobuf:"bytes,1,rep,name=tabs"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ExportMenuResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data        []byte                 `protobuf:"bytes,1,opt,name=data"`
+	xxx_hidden_ContentType *string                `protobuf:"bytes,2,opt,name=content_type,json=contentType"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetVisitedTabsResponse) Reset() {
-	*x = GetVisitedTabsResponse{}
-	mi := &file_restaurant_proto_msgTypes[29]
+func (x *ExportMenuResponse) Reset() {
+	*x = ExportMenuResponse{}
+	mi := &file_restaurant_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVisitedTabsResponse) String() string {
+func (x *ExportMenuResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVisitedTabsResponse) ProtoMessage() {}
+func (*ExportMenuResponse) ProtoMessage() {}
 
-func (x *GetVisitedTabsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[29]
+func (x *ExportMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3030,247 +8722,251 @@ func (x *GetVisitedTabsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetVisitedTabsResponse) GetTabs() []*Tab {
+func (x *ExportMenuResponse) GetData() []byte {
 	if x != nil {
-		if x.xxx_hidden_Tabs != nil {
-			return *x.xxx_hidden_Tabs
-		}
+		return x.xxx_hidden_Data
 	}
 	return nil
 }
 
-func (x *GetVisitedTabsResponse) SetTabs(v []*Tab) {
-	x.xxx_hidden_Tabs = &v
+func (x *ExportMenuResponse) GetContentType() string {
+	if x != nil {
+		if x.xxx_hidden_ContentType != nil {
+			return *x.xxx_hidden_ContentType
+		}
+		return ""
+	}
+	return ""
 }
 
-type GetVisitedTabsResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *ExportMenuResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
 
-	Tabs []*Tab
+func (x *ExportMenuResponse) SetContentType(v string) {
+	x.xxx_hidden_ContentType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (b0 GetVisitedTabsResponse_builder) Build() *GetVisitedTabsResponse {
-	m0 := &GetVisitedTabsResponse{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_Tabs = &b.Tabs
-	return m0
+func (x *ExportMenuResponse) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-type Tab struct {
-	state                       protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id               *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_TotalPrice       int32                  `protobuf:"varint,2,opt,name=total_price,json=totalPrice"`
-	xxx_hidden_Orders           *[]*Order              `protobuf:"bytes,3,rep,name=orders"`
-	xxx_hidden_CustomGuestNames map[string]string      `protobuf:"bytes,4,rep,name=custom_guest_names,json=customGuestNames" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	xxx_hidden_CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt"`
-	xxx_hidden_ClosedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=closed_at,json=closedAt"`
-	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
-	XXX_presence                [1]uint32
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+func (x *ExportMenuResponse) HasContentType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *Tab) Reset() {
-	*x = Tab{}
-	mi := &file_restaurant_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ExportMenuResponse) ClearData() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Data = nil
 }
 
-func (x *Tab) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExportMenuResponse) ClearContentType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ContentType = nil
 }
 
-func (*Tab) ProtoMessage() {}
+type ExportMenuResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-func (x *Tab) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[30]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+	Data        []byte
+	ContentType *string
 }
 
-func (x *Tab) GetId() string {
-	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
+func (b0 ExportMenuResponse_builder) Build() *ExportMenuResponse {
+	m0 := &ExportMenuResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Data != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Data = b.Data
 	}
-	return ""
+	if b.ContentType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ContentType = b.ContentType
+	}
+	return m0
 }
 
-func (x *Tab) GetTotalPrice() int32 {
-	if x != nil {
-		return x.xxx_hidden_TotalPrice
-	}
-	return 0
+type ImportMenuRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Format      *string                `protobuf:"bytes,1,opt,name=format"`
+	xxx_hidden_Data        []byte                 `protobuf:"bytes,2,opt,name=data"`
+	xxx_hidden_DryRun      bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *Tab) GetOrders() []*Order {
-	if x != nil {
-		if x.xxx_hidden_Orders != nil {
-			return *x.xxx_hidden_Orders
-		}
-	}
-	return nil
+func (x *ImportMenuRequest) Reset() {
+	*x = ImportMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Tab) GetCustomGuestNames() map[string]string {
+func (*ImportMenuRequest) ProtoMessage() {}
+
+func (x *ImportMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[71]
 	if x != nil {
-		return x.xxx_hidden_CustomGuestNames
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Tab) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ImportMenuRequest) GetFormat() string {
 	if x != nil {
-		return x.xxx_hidden_CreatedAt
+		if x.xxx_hidden_Format != nil {
+			return *x.xxx_hidden_Format
+		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *Tab) GetClosedAt() *timestamppb.Timestamp {
+func (x *ImportMenuRequest) GetData() []byte {
 	if x != nil {
-		return x.xxx_hidden_ClosedAt
+		return x.xxx_hidden_Data
 	}
 	return nil
 }
 
-func (x *Tab) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
-}
-
-func (x *Tab) SetTotalPrice(v int32) {
-	x.xxx_hidden_TotalPrice = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
-}
-
-func (x *Tab) SetOrders(v []*Order) {
-	x.xxx_hidden_Orders = &v
+func (x *ImportMenuRequest) GetDryRun() bool {
+	if x != nil {
+		return x.xxx_hidden_DryRun
+	}
+	return false
 }
 
-func (x *Tab) SetCustomGuestNames(v map[string]string) {
-	x.xxx_hidden_CustomGuestNames = v
+func (x *ImportMenuRequest) SetFormat(v string) {
+	x.xxx_hidden_Format = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-func (x *Tab) SetCreatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_CreatedAt = v
+func (x *ImportMenuRequest) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *Tab) SetClosedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_ClosedAt = v
+func (x *ImportMenuRequest) SetDryRun(v bool) {
+	x.xxx_hidden_DryRun = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (x *Tab) HasId() bool {
+func (x *ImportMenuRequest) HasFormat() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *Tab) HasTotalPrice() bool {
+func (x *ImportMenuRequest) HasData() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *Tab) HasCreatedAt() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_CreatedAt != nil
-}
-
-func (x *Tab) HasClosedAt() bool {
+func (x *ImportMenuRequest) HasDryRun() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_ClosedAt != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *Tab) ClearId() {
+func (x *ImportMenuRequest) ClearFormat() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_Format = nil
 }
 
-func (x *Tab) ClearTotalPrice() {
+func (x *ImportMenuRequest) ClearData() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_TotalPrice = 0
-}
-
-func (x *Tab) ClearCreatedAt() {
-	x.xxx_hidden_CreatedAt = nil
+	x.xxx_hidden_Data = nil
 }
 
-func (x *Tab) ClearClosedAt() {
-	x.xxx_hidden_ClosedAt = nil
+func (x *ImportMenuRequest) ClearDryRun() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DryRun = false
 }
 
-type Tab_builder struct {
+type ImportMenuRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id               *string
-	TotalPrice       *int32
-	Orders           []*Order
-	CustomGuestNames map[string]string
-	CreatedAt        *timestamppb.Timestamp
-	ClosedAt         *timestamppb.Timestamp
+	// json (default) or csv
+	Format *string
+	Data   []byte
+	// Only reports the changes, without applying them
+	DryRun *bool
 }
 
-func (b0 Tab_builder) Build() *Tab {
-	m0 := &Tab{}
+func (b0 ImportMenuRequest_builder) Build() *ImportMenuRequest {
+	m0 := &ImportMenuRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
-		x.xxx_hidden_Id = b.Id
+	if b.Format != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Format = b.Format
 	}
-	if b.TotalPrice != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
-		x.xxx_hidden_TotalPrice = *b.TotalPrice
+	if b.Data != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Data = b.Data
+	}
+	if b.DryRun != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_DryRun = *b.DryRun
 	}
-	x.xxx_hidden_Orders = &b.Orders
-	x.xxx_hidden_CustomGuestNames = b.CustomGuestNames
-	x.xxx_hidden_CreatedAt = b.CreatedAt
-	x.xxx_hidden_ClosedAt = b.ClosedAt
 	return m0
 }
 
-type Order struct {
+type ImportMenuResponse struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_Items       *[]*OrderItem          `protobuf:"bytes,2,rep,name=items"`
-	xxx_hidden_SentAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=sent_at,json=sentAt"`
+	xxx_hidden_Changes     *[]*MenuChange         `protobuf:"bytes,1,rep,name=changes"`
+	xxx_hidden_Unchanged   int32                  `protobuf:"varint,2,opt,name=unchanged"`
+	xxx_hidden_Applied     bool                   `protobuf:"varint,3,opt,name=applied"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_restaurant_proto_msgTypes[31]
+func (x *ImportMenuResponse) Reset() {
+	*x = ImportMenuResponse{}
+	mi := &file_restaurant_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *ImportMenuResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*ImportMenuResponse) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[31]
+func (x *ImportMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3281,124 +8977,118 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *Order) GetId() string {
+func (x *ImportMenuResponse) GetChanges() []*MenuChange {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
+		if x.xxx_hidden_Changes != nil {
+			return *x.xxx_hidden_Changes
 		}
-		return ""
 	}
-	return ""
+	return nil
 }
 
-func (x *Order) GetItems() []*OrderItem {
+func (x *ImportMenuResponse) GetUnchanged() int32 {
 	if x != nil {
-		if x.xxx_hidden_Items != nil {
-			return *x.xxx_hidden_Items
-		}
+		return x.xxx_hidden_Unchanged
 	}
-	return nil
+	return 0
 }
 
-func (x *Order) GetSentAt() *timestamppb.Timestamp {
+func (x *ImportMenuResponse) GetApplied() bool {
 	if x != nil {
-		return x.xxx_hidden_SentAt
+		return x.xxx_hidden_Applied
 	}
-	return nil
+	return false
 }
 
-func (x *Order) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+func (x *ImportMenuResponse) SetChanges(v []*MenuChange) {
+	x.xxx_hidden_Changes = &v
 }
 
-func (x *Order) SetItems(v []*OrderItem) {
-	x.xxx_hidden_Items = &v
+func (x *ImportMenuResponse) SetUnchanged(v int32) {
+	x.xxx_hidden_Unchanged = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *Order) SetSentAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_SentAt = v
+func (x *ImportMenuResponse) SetApplied(v bool) {
+	x.xxx_hidden_Applied = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (x *Order) HasId() bool {
+func (x *ImportMenuResponse) HasUnchanged() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *Order) HasSentAt() bool {
+func (x *ImportMenuResponse) HasApplied() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_SentAt != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *Order) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *ImportMenuResponse) ClearUnchanged() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Unchanged = 0
 }
 
-func (x *Order) ClearSentAt() {
-	x.xxx_hidden_SentAt = nil
+func (x *ImportMenuResponse) ClearApplied() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Applied = false
 }
 
-type Order_builder struct {
+type ImportMenuResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id     *string
-	Items  []*OrderItem
-	SentAt *timestamppb.Timestamp
+	Changes   []*MenuChange
+	Unchanged *int32
+	Applied   *bool
 }
 
-func (b0 Order_builder) Build() *Order {
-	m0 := &Order{}
+func (b0 ImportMenuResponse_builder) Build() *ImportMenuResponse {
+	m0 := &ImportMenuResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
-		x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Changes = &b.Changes
+	if b.Unchanged != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Unchanged = *b.Unchanged
+	}
+	if b.Applied != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Applied = *b.Applied
 	}
-	x.xxx_hidden_Items = &b.Items
-	x.xxx_hidden_SentAt = b.SentAt
 	return m0
 }
 
-type OrderItem struct {
-	state                       protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id               *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_Quantity         int32                  `protobuf:"varint,2,opt,name=quantity"`
-	xxx_hidden_Modifiers        []byte                 `protobuf:"bytes,3,opt,name=modifiers"`
-	xxx_hidden_GuestOwnerIds    []string               `protobuf:"bytes,4,rep,name=guest_owner_ids,json=guestOwnerIds"`
-	xxx_hidden_CustomerOwnerIds []string               `protobuf:"bytes,5,rep,name=customer_owner_ids,json=customerOwnerIds"`
-	xxx_hidden_MenuItemId       *string                `protobuf:"bytes,6,opt,name=menu_item_id,json=menuItemId"`
-	xxx_hidden_Name             *string                `protobuf:"bytes,7,opt,name=name"`
-	xxx_hidden_Description      *string                `protobuf:"bytes,8,opt,name=description"`
-	xxx_hidden_PhotoPathinfo    *string                `protobuf:"bytes,9,opt,name=photo_pathinfo,json=photoPathinfo"`
-	xxx_hidden_Price            int32                  `protobuf:"varint,10,opt,name=price"`
-	xxx_hidden_PortionSize      int32                  `protobuf:"varint,11,opt,name=portion_size,json=portionSize"`
-	xxx_hidden_ModifiersConfig  []byte                 `protobuf:"bytes,12,opt,name=modifiers_config,json=modifiersConfig"`
-	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
-	XXX_presence                [1]uint32
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type MenuChange struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Kind        *string                `protobuf:"bytes,1,opt,name=kind"`
+	xxx_hidden_Key         *string                `protobuf:"bytes,2,opt,name=key"`
+	xxx_hidden_Action      *string                `protobuf:"bytes,3,opt,name=action"`
+	xxx_hidden_Fields      []string               `protobuf:"bytes,4,rep,name=fields"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *OrderItem) Reset() {
-	*x = OrderItem{}
-	mi := &file_restaurant_proto_msgTypes[32]
+func (x *MenuChange) Reset() {
+	*x = MenuChange{}
+	mi := &file_restaurant_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) String() string {
+func (x *MenuChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*MenuChange) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[32]
+func (x *MenuChange) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3409,389 +9099,405 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *OrderItem) GetId() string {
+func (x *MenuChange) GetKind() string {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
+		if x.xxx_hidden_Kind != nil {
+			return *x.xxx_hidden_Kind
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *OrderItem) GetQuantity() int32 {
+func (x *MenuChange) GetKey() string {
 	if x != nil {
-		return x.xxx_hidden_Quantity
+		if x.xxx_hidden_Key != nil {
+			return *x.xxx_hidden_Key
+		}
+		return ""
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderItem) GetModifiers() []byte {
+func (x *MenuChange) GetAction() string {
 	if x != nil {
-		return x.xxx_hidden_Modifiers
+		if x.xxx_hidden_Action != nil {
+			return *x.xxx_hidden_Action
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *MenuChange) GetFields() []string {
+	if x != nil {
+		return x.xxx_hidden_Fields
 	}
 	return nil
 }
 
-func (x *OrderItem) GetGuestOwnerIds() []string {
-	if x != nil {
-		return x.xxx_hidden_GuestOwnerIds
-	}
-	return nil
+func (x *MenuChange) SetKind(v string) {
+	x.xxx_hidden_Kind = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *MenuChange) SetKey(v string) {
+	x.xxx_hidden_Key = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *MenuChange) SetAction(v string) {
+	x.xxx_hidden_Action = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *MenuChange) SetFields(v []string) {
+	x.xxx_hidden_Fields = v
+}
+
+func (x *MenuChange) HasKind() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MenuChange) HasKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MenuChange) HasAction() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *MenuChange) ClearKind() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Kind = nil
+}
+
+func (x *MenuChange) ClearKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Key = nil
+}
+
+func (x *MenuChange) ClearAction() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Action = nil
+}
+
+type MenuChange_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// dimension, tag or item
+	Kind *string
+	Key  *string
+	// create or update
+	Action *string
+	// Changed by an update
+	Fields []string
+}
+
+func (b0 MenuChange_builder) Build() *MenuChange {
+	m0 := &MenuChange{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Kind != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Kind = b.Kind
+	}
+	if b.Key != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Key = b.Key
+	}
+	if b.Action != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Action = b.Action
+	}
+	x.xxx_hidden_Fields = b.Fields
+	return m0
+}
+
+type MenuItemPhoto struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ContentType *string                `protobuf:"bytes,1,opt,name=content_type,json=contentType"`
+	xxx_hidden_Width       int32                  `protobuf:"varint,2,opt,name=width"`
+	xxx_hidden_Height      int32                  `protobuf:"varint,3,opt,name=height"`
+	xxx_hidden_Blurhash    *string                `protobuf:"bytes,4,opt,name=blurhash"`
+	xxx_hidden_OriginalUrl *string                `protobuf:"bytes,5,opt,name=original_url,json=originalUrl"`
+	xxx_hidden_Variants    *[]*PhotoVariant       `protobuf:"bytes,6,rep,name=variants"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MenuItemPhoto) Reset() {
+	*x = MenuItemPhoto{}
+	mi := &file_restaurant_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) GetCustomerOwnerIds() []string {
+func (x *MenuItemPhoto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItemPhoto) ProtoMessage() {}
+
+func (x *MenuItemPhoto) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[74]
 	if x != nil {
-		return x.xxx_hidden_CustomerOwnerIds
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *OrderItem) GetMenuItemId() string {
+func (x *MenuItemPhoto) GetContentType() string {
 	if x != nil {
-		if x.xxx_hidden_MenuItemId != nil {
-			return *x.xxx_hidden_MenuItemId
+		if x.xxx_hidden_ContentType != nil {
+			return *x.xxx_hidden_ContentType
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *OrderItem) GetName() string {
+func (x *MenuItemPhoto) GetWidth() int32 {
 	if x != nil {
-		if x.xxx_hidden_Name != nil {
-			return *x.xxx_hidden_Name
-		}
-		return ""
+		return x.xxx_hidden_Width
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderItem) GetDescription() string {
+func (x *MenuItemPhoto) GetHeight() int32 {
 	if x != nil {
-		if x.xxx_hidden_Description != nil {
-			return *x.xxx_hidden_Description
-		}
-		return ""
+		return x.xxx_hidden_Height
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderItem) GetPhotoPathinfo() string {
+func (x *MenuItemPhoto) GetBlurhash() string {
 	if x != nil {
-		if x.xxx_hidden_PhotoPathinfo != nil {
-			return *x.xxx_hidden_PhotoPathinfo
+		if x.xxx_hidden_Blurhash != nil {
+			return *x.xxx_hidden_Blurhash
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *OrderItem) GetPrice() int32 {
+func (x *MenuItemPhoto) GetOriginalUrl() string {
 	if x != nil {
-		return x.xxx_hidden_Price
+		if x.xxx_hidden_OriginalUrl != nil {
+			return *x.xxx_hidden_OriginalUrl
+		}
+		return ""
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderItem) GetPortionSize() int32 {
+func (x *MenuItemPhoto) GetVariants() []*PhotoVariant {
 	if x != nil {
-		return x.xxx_hidden_PortionSize
+		if x.xxx_hidden_Variants != nil {
+			return *x.xxx_hidden_Variants
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItem) GetModifiersConfig() []byte {
+func (x *MenuItemPhoto) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.xxx_hidden_ModifiersConfig
+		return x.xxx_hidden_CreatedAt
 	}
 	return nil
 }
 
-func (x *OrderItem) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 12)
-}
-
-func (x *OrderItem) SetQuantity(v int32) {
-	x.xxx_hidden_Quantity = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 12)
-}
-
-func (x *OrderItem) SetModifiers(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
-	x.xxx_hidden_Modifiers = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 12)
-}
-
-func (x *OrderItem) SetGuestOwnerIds(v []string) {
-	x.xxx_hidden_GuestOwnerIds = v
-}
-
-func (x *OrderItem) SetCustomerOwnerIds(v []string) {
-	x.xxx_hidden_CustomerOwnerIds = v
-}
-
-func (x *OrderItem) SetMenuItemId(v string) {
-	x.xxx_hidden_MenuItemId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 12)
+func (x *MenuItemPhoto) SetContentType(v string) {
+	x.xxx_hidden_ContentType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
 }
 
-func (x *OrderItem) SetName(v string) {
-	x.xxx_hidden_Name = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 12)
+func (x *MenuItemPhoto) SetWidth(v int32) {
+	x.xxx_hidden_Width = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
 }
 
-func (x *OrderItem) SetDescription(v string) {
-	x.xxx_hidden_Description = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 12)
+func (x *MenuItemPhoto) SetHeight(v int32) {
+	x.xxx_hidden_Height = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
 }
 
-func (x *OrderItem) SetPhotoPathinfo(v string) {
-	x.xxx_hidden_PhotoPathinfo = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 12)
+func (x *MenuItemPhoto) SetBlurhash(v string) {
+	x.xxx_hidden_Blurhash = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
 }
 
-func (x *OrderItem) SetPrice(v int32) {
-	x.xxx_hidden_Price = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 12)
+func (x *MenuItemPhoto) SetOriginalUrl(v string) {
+	x.xxx_hidden_OriginalUrl = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
 }
 
-func (x *OrderItem) SetPortionSize(v int32) {
-	x.xxx_hidden_PortionSize = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 12)
+func (x *MenuItemPhoto) SetVariants(v []*PhotoVariant) {
+	x.xxx_hidden_Variants = &v
 }
 
-func (x *OrderItem) SetModifiersConfig(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
-	x.xxx_hidden_ModifiersConfig = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 12)
+func (x *MenuItemPhoto) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
 }
 
-func (x *OrderItem) HasId() bool {
+func (x *MenuItemPhoto) HasContentType() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *OrderItem) HasQuantity() bool {
+func (x *MenuItemPhoto) HasWidth() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *OrderItem) HasModifiers() bool {
+func (x *MenuItemPhoto) HasHeight() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *OrderItem) HasMenuItemId() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
-}
-
-func (x *OrderItem) HasName() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
-}
-
-func (x *OrderItem) HasDescription() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
-}
-
-func (x *OrderItem) HasPhotoPathinfo() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
-}
-
-func (x *OrderItem) HasPrice() bool {
+func (x *MenuItemPhoto) HasBlurhash() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *OrderItem) HasPortionSize() bool {
+func (x *MenuItemPhoto) HasOriginalUrl() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
 }
 
-func (x *OrderItem) HasModifiersConfig() bool {
+func (x *MenuItemPhoto) HasCreatedAt() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+	return x.xxx_hidden_CreatedAt != nil
 }
 
-func (x *OrderItem) ClearId() {
+func (x *MenuItemPhoto) ClearContentType() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_ContentType = nil
 }
 
-func (x *OrderItem) ClearQuantity() {
+func (x *MenuItemPhoto) ClearWidth() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Quantity = 0
+	x.xxx_hidden_Width = 0
 }
 
-func (x *OrderItem) ClearModifiers() {
+func (x *MenuItemPhoto) ClearHeight() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Modifiers = nil
-}
-
-func (x *OrderItem) ClearMenuItemId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_MenuItemId = nil
-}
-
-func (x *OrderItem) ClearName() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
-	x.xxx_hidden_Name = nil
-}
-
-func (x *OrderItem) ClearDescription() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
-	x.xxx_hidden_Description = nil
-}
-
-func (x *OrderItem) ClearPhotoPathinfo() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
-	x.xxx_hidden_PhotoPathinfo = nil
+	x.xxx_hidden_Height = 0
 }
 
-func (x *OrderItem) ClearPrice() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
-	x.xxx_hidden_Price = 0
+func (x *MenuItemPhoto) ClearBlurhash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Blurhash = nil
 }
 
-func (x *OrderItem) ClearPortionSize() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
-	x.xxx_hidden_PortionSize = 0
+func (x *MenuItemPhoto) ClearOriginalUrl() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_OriginalUrl = nil
 }
 
-func (x *OrderItem) ClearModifiersConfig() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
-	x.xxx_hidden_ModifiersConfig = nil
+func (x *MenuItemPhoto) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
 }
 
-type OrderItem_builder struct {
+type MenuItemPhoto_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id               *string
-	Quantity         *int32
-	Modifiers        []byte
-	GuestOwnerIds    []string
-	CustomerOwnerIds []string
-	MenuItemId       *string
-	Name             *string
-	Description      *string
-	PhotoPathinfo    *string
-	Price            *int32
-	PortionSize      *int32
-	ModifiersConfig  []byte
+	// Of the original, as sniffed from its content
+	ContentType *string
+	Width       *int32
+	Height      *int32
+	// Placeholder to show while a variant loads, see https://blurha.sh
+	Blurhash    *string
+	OriginalUrl *string
+	// JPEG variants sorted by width
+	Variants  []*PhotoVariant
+	CreatedAt *timestamppb.Timestamp
 }
 
-func (b0 OrderItem_builder) Build() *OrderItem {
-	m0 := &OrderItem{}
+func (b0 MenuItemPhoto_builder) Build() *MenuItemPhoto {
+	m0 := &MenuItemPhoto{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 12)
-		x.xxx_hidden_Id = b.Id
-	}
-	if b.Quantity != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 12)
-		x.xxx_hidden_Quantity = *b.Quantity
-	}
-	if b.Modifiers != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 12)
-		x.xxx_hidden_Modifiers = b.Modifiers
-	}
-	x.xxx_hidden_GuestOwnerIds = b.GuestOwnerIds
-	x.xxx_hidden_CustomerOwnerIds = b.CustomerOwnerIds
-	if b.MenuItemId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 12)
-		x.xxx_hidden_MenuItemId = b.MenuItemId
-	}
-	if b.Name != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 12)
-		x.xxx_hidden_Name = b.Name
-	}
-	if b.Description != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 12)
-		x.xxx_hidden_Description = b.Description
+	if b.ContentType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_ContentType = b.ContentType
 	}
-	if b.PhotoPathinfo != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 12)
-		x.xxx_hidden_PhotoPathinfo = b.PhotoPathinfo
+	if b.Width != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Width = *b.Width
 	}
-	if b.Price != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 12)
-		x.xxx_hidden_Price = *b.Price
+	if b.Height != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Height = *b.Height
 	}
-	if b.PortionSize != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 12)
-		x.xxx_hidden_PortionSize = *b.PortionSize
+	if b.Blurhash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Blurhash = b.Blurhash
 	}
-	if b.ModifiersConfig != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 12)
-		x.xxx_hidden_ModifiersConfig = b.ModifiersConfig
+	if b.OriginalUrl != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_OriginalUrl = b.OriginalUrl
 	}
+	x.xxx_hidden_Variants = &b.Variants
+	x.xxx_hidden_CreatedAt = b.CreatedAt
 	return m0
 }
 
-type MenuItem struct {
-	state                      protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id              *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_Name            *string                `protobuf:"bytes,2,opt,name=name"`
-	xxx_hidden_Description     *string                `protobuf:"bytes,3,opt,name=description"`
-	xxx_hidden_PhotoPathinfo   *string                `protobuf:"bytes,4,opt,name=photo_pathinfo,json=photoPathinfo"`
-	xxx_hidden_Price           int32                  `protobuf:"varint,5,opt,name=price"`
-	xxx_hidden_PortionSize     int32                  `protobuf:"varint,6,opt,name=portion_size,json=portionSize"`
-	xxx_hidden_Available       bool                   `protobuf:"varint,7,opt,name=available"`
-	xxx_hidden_ModifiersConfig []byte                 `protobuf:"bytes,8,opt,name=modifiers_config,json=modifiersConfig"`
-	xxx_hidden_MenuTags        *[]*MenuTag            `protobuf:"bytes,9,rep,name=menu_tags,json=menuTags"`
-	xxx_hidden_CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt"`
-	xxx_hidden_DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt"`
-	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
-	XXX_presence               [1]uint32
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+type PhotoVariant struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Url         *string                `protobuf:"bytes,2,opt,name=url"`
+	xxx_hidden_Width       int32                  `protobuf:"varint,3,opt,name=width"`
+	xxx_hidden_Height      int32                  `protobuf:"varint,4,opt,name=height"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *MenuItem) Reset() {
-	*x = MenuItem{}
-	mi := &file_restaurant_proto_msgTypes[33]
+func (x *PhotoVariant) Reset() {
+	*x = PhotoVariant{}
+	mi := &file_restaurant_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuItem) String() string {
+func (x *PhotoVariant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuItem) ProtoMessage() {}
+func (*PhotoVariant) ProtoMessage() {}
 
-func (x *MenuItem) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[33]
+func (x *PhotoVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3802,17 +9508,7 @@ func (x *MenuItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *MenuItem) GetId() string {
-	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *MenuItem) GetName() string {
+func (x *PhotoVariant) GetName() string {
 	if x != nil {
 		if x.xxx_hidden_Name != nil {
 			return *x.xxx_hidden_Name
@@ -3822,305 +9518,127 @@ func (x *MenuItem) GetName() string {
 	return ""
 }
 
-func (x *MenuItem) GetDescription() string {
-	if x != nil {
-		if x.xxx_hidden_Description != nil {
-			return *x.xxx_hidden_Description
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *MenuItem) GetPhotoPathinfo() string {
+func (x *PhotoVariant) GetUrl() string {
 	if x != nil {
-		if x.xxx_hidden_PhotoPathinfo != nil {
-			return *x.xxx_hidden_PhotoPathinfo
+		if x.xxx_hidden_Url != nil {
+			return *x.xxx_hidden_Url
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *MenuItem) GetPrice() int32 {
+func (x *PhotoVariant) GetWidth() int32 {
 	if x != nil {
-		return x.xxx_hidden_Price
+		return x.xxx_hidden_Width
 	}
 	return 0
 }
 
-func (x *MenuItem) GetPortionSize() int32 {
+func (x *PhotoVariant) GetHeight() int32 {
 	if x != nil {
-		return x.xxx_hidden_PortionSize
+		return x.xxx_hidden_Height
 	}
 	return 0
 }
 
-func (x *MenuItem) GetAvailable() bool {
-	if x != nil {
-		return x.xxx_hidden_Available
-	}
-	return false
-}
-
-func (x *MenuItem) GetModifiersConfig() []byte {
-	if x != nil {
-		return x.xxx_hidden_ModifiersConfig
-	}
-	return nil
-}
-
-func (x *MenuItem) GetMenuTags() []*MenuTag {
-	if x != nil {
-		if x.xxx_hidden_MenuTags != nil {
-			return *x.xxx_hidden_MenuTags
-		}
-	}
-	return nil
-}
-
-func (x *MenuItem) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.xxx_hidden_CreatedAt
-	}
-	return nil
-}
-
-func (x *MenuItem) GetDeletedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.xxx_hidden_DeletedAt
-	}
-	return nil
-}
-
-func (x *MenuItem) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
-}
-
-func (x *MenuItem) SetName(v string) {
+func (x *PhotoVariant) SetName(v string) {
 	x.xxx_hidden_Name = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 11)
-}
-
-func (x *MenuItem) SetDescription(v string) {
-	x.xxx_hidden_Description = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
-}
-
-func (x *MenuItem) SetPhotoPathinfo(v string) {
-	x.xxx_hidden_PhotoPathinfo = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
-}
-
-func (x *MenuItem) SetPrice(v int32) {
-	x.xxx_hidden_Price = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 11)
-}
-
-func (x *MenuItem) SetPortionSize(v int32) {
-	x.xxx_hidden_PortionSize = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 11)
-}
-
-func (x *MenuItem) SetAvailable(v bool) {
-	x.xxx_hidden_Available = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 11)
-}
-
-func (x *MenuItem) SetModifiersConfig(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
-	x.xxx_hidden_ModifiersConfig = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
-func (x *MenuItem) SetMenuTags(v []*MenuTag) {
-	x.xxx_hidden_MenuTags = &v
+func (x *PhotoVariant) SetUrl(v string) {
+	x.xxx_hidden_Url = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
-func (x *MenuItem) SetCreatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_CreatedAt = v
+func (x *PhotoVariant) SetWidth(v int32) {
+	x.xxx_hidden_Width = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
 }
 
-func (x *MenuItem) SetDeletedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_DeletedAt = v
+func (x *PhotoVariant) SetHeight(v int32) {
+	x.xxx_hidden_Height = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
 }
 
-func (x *MenuItem) HasId() bool {
+func (x *PhotoVariant) HasName() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *MenuItem) HasName() bool {
+func (x *PhotoVariant) HasUrl() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *MenuItem) HasDescription() bool {
+func (x *PhotoVariant) HasWidth() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *MenuItem) HasPhotoPathinfo() bool {
+func (x *PhotoVariant) HasHeight() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *MenuItem) HasPrice() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
-}
-
-func (x *MenuItem) HasPortionSize() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
-}
-
-func (x *MenuItem) HasAvailable() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
-}
-
-func (x *MenuItem) HasModifiersConfig() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
-}
-
-func (x *MenuItem) HasCreatedAt() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_CreatedAt != nil
-}
-
-func (x *MenuItem) HasDeletedAt() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_DeletedAt != nil
-}
-
-func (x *MenuItem) ClearId() {
+func (x *PhotoVariant) ClearName() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_Name = nil
 }
 
-func (x *MenuItem) ClearName() {
+func (x *PhotoVariant) ClearUrl() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Name = nil
+	x.xxx_hidden_Url = nil
 }
 
-func (x *MenuItem) ClearDescription() {
+func (x *PhotoVariant) ClearWidth() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Description = nil
+	x.xxx_hidden_Width = 0
 }
 
-func (x *MenuItem) ClearPhotoPathinfo() {
+func (x *PhotoVariant) ClearHeight() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_PhotoPathinfo = nil
-}
-
-func (x *MenuItem) ClearPrice() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_Price = 0
-}
-
-func (x *MenuItem) ClearPortionSize() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_PortionSize = 0
-}
-
-func (x *MenuItem) ClearAvailable() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
-	x.xxx_hidden_Available = false
-}
-
-func (x *MenuItem) ClearModifiersConfig() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
-	x.xxx_hidden_ModifiersConfig = nil
-}
-
-func (x *MenuItem) ClearCreatedAt() {
-	x.xxx_hidden_CreatedAt = nil
-}
-
-func (x *MenuItem) ClearDeletedAt() {
-	x.xxx_hidden_DeletedAt = nil
+	x.xxx_hidden_Height = 0
 }
 
-type MenuItem_builder struct {
+type PhotoVariant_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id              *string
-	Name            *string
-	Description     *string
-	PhotoPathinfo   *string
-	Price           *int32
-	PortionSize     *int32
-	Available       *bool
-	ModifiersConfig []byte
-	MenuTags        []*MenuTag
-	CreatedAt       *timestamppb.Timestamp
-	DeletedAt       *timestamppb.Timestamp
+	Name   *string
+	Url    *string
+	Width  *int32
+	Height *int32
 }
 
-func (b0 MenuItem_builder) Build() *MenuItem {
-	m0 := &MenuItem{}
+func (b0 PhotoVariant_builder) Build() *PhotoVariant {
+	m0 := &PhotoVariant{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
-		x.xxx_hidden_Id = b.Id
-	}
 	if b.Name != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
 		x.xxx_hidden_Name = b.Name
 	}
-	if b.Description != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
-		x.xxx_hidden_Description = b.Description
-	}
-	if b.PhotoPathinfo != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
-		x.xxx_hidden_PhotoPathinfo = b.PhotoPathinfo
-	}
-	if b.Price != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 11)
-		x.xxx_hidden_Price = *b.Price
-	}
-	if b.PortionSize != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 11)
-		x.xxx_hidden_PortionSize = *b.PortionSize
+	if b.Url != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Url = b.Url
 	}
-	if b.Available != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 11)
-		x.xxx_hidden_Available = *b.Available
+	if b.Width != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Width = *b.Width
 	}
-	if b.ModifiersConfig != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 11)
-		x.xxx_hidden_ModifiersConfig = b.ModifiersConfig
+	if b.Height != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Height = *b.Height
 	}
-	x.xxx_hidden_MenuTags = &b.MenuTags
-	x.xxx_hidden_CreatedAt = b.CreatedAt
-	x.xxx_hidden_DeletedAt = b.DeletedAt
 	return m0
 }
 
@@ -4141,7 +9659,7 @@ type MenuTag struct {
 
 func (x *MenuTag) Reset() {
 	*x = MenuTag{}
-	mi := &file_restaurant_proto_msgTypes[34]
+	mi := &file_restaurant_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4153,7 +9671,7 @@ func (x *MenuTag) String() string {
 func (*MenuTag) ProtoMessage() {}
 
 func (x *MenuTag) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[34]
+	mi := &file_restaurant_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4374,7 +9892,7 @@ type MenuTagDimension struct {
 
 func (x *MenuTagDimension) Reset() {
 	*x = MenuTagDimension{}
-	mi := &file_restaurant_proto_msgTypes[35]
+	mi := &file_restaurant_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4386,7 +9904,7 @@ func (x *MenuTagDimension) String() string {
 func (*MenuTagDimension) ProtoMessage() {}
 
 func (x *MenuTagDimension) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[35]
+	mi := &file_restaurant_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4566,7 +10084,44 @@ const file_restaurant_proto_rawDesc = "" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
 	"\fphone_number\x18\x05 \x01(\tR\vphoneNumber\"(\n" +
 	"\x16GetCustomerByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xdd\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x16UpdateMyProfileRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fphone_number\x18\x02 \x01(\tR\vphoneNumber\"M\n" +
+	"\x14ChangeLoginIDRequest\x12\x19\n" +
+	"\blogin_id\x18\x01 \x01(\tR\aloginId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"F\n" +
+	"\x12ChangeEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"e\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"4\n" +
+	"\x16DeleteMyAccountRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"2\n" +
+	"\x14ExportMyDataResponse\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\tR\bdocument\"i\n" +
+	"\x13SaveFavoriteRequest\x12 \n" +
+	"\fmenu_item_id\x18\x01 \x01(\tR\n" +
+	"menuItemId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tmodifiers\x18\x03 \x01(\fR\tmodifiers\"'\n" +
+	"\x15DeleteFavoriteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
+	"\x15ListFavoritesResponse\x122\n" +
+	"\tfavorites\x18\x01 \x03(\v2\x14.restaurant.FavoriteR\tfavorites\"\xa6\x02\n" +
+	"\bFavorite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\fmenu_item_id\x18\x02 \x01(\tR\n" +
+	"menuItemId\x12$\n" +
+	"\x0emenu_item_name\x18\x03 \x01(\tR\fmenuItemName\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1c\n" +
+	"\tmodifiers\x18\x05 \x01(\fR\tmodifiers\x12\x1a\n" +
+	"\boutdated\x18\x06 \x01(\bR\boutdated\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9f\x02\n" +
 	"\bCustomer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -4575,7 +10130,9 @@ const file_restaurant_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"M\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\blogin_id\x18\a \x01(\tR\aloginId\x12%\n" +
+	"\x0eemail_verified\x18\b \x01(\bR\remailVerified\"M\n" +
 	"\x14GenerateTokenRequest\x12\x19\n" +
 	"\blogin_id\x18\x01 \x01(\tR\aloginId\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"~\n" +
@@ -4583,7 +10140,21 @@ const file_restaurant_proto_rawDesc = "" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"J\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"O\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"3\n" +
+	"\x15StartOIDCLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"E\n" +
+	"\x16StartOIDCLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\"D\n" +
+	"\x18CompleteOIDCLoginRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"J\n" +
 	"\x15CreateMenuItemRequest\x121\n" +
 	"\tmenu_item\x18\x01 \x01(\v2\x14.restaurant.MenuItemR\bmenuItem\"$\n" +
 	"\x12GetMenuItemRequest\x12\x0e\n" +
@@ -4603,7 +10174,27 @@ const file_restaurant_proto_rawDesc = "" +
 	"\x0fguest_owner_ids\x18\x05 \x03(\tR\rguestOwnerIds\x12,\n" +
 	"\x12customer_owner_ids\x18\x06 \x03(\tR\x10customerOwnerIds\"\x1d\n" +
 	"\vOrderItemID\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x19AddFavoriteToOrderRequest\x12\x1f\n" +
+	"\vfavorite_id\x18\x01 \x01(\tR\n" +
+	"favoriteId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xa4\x01\n" +
+	"\x0eReorderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12(\n" +
+	"\x0fsource_order_id\x18\x02 \x01(\tH\x00R\rsourceOrderId\x12$\n" +
+	"\rsource_tab_id\x18\x03 \x01(\tH\x00R\vsourceTabId\x12\x1d\n" +
+	"\n" +
+	"only_owned\x18\x04 \x01(\bR\tonlyOwnedB\b\n" +
+	"\x06source\"\x93\x01\n" +
+	"\x0fReorderResponse\x12=\n" +
+	"\x0eorder_item_ids\x18\x01 \x03(\v2\x17.restaurant.OrderItemIDR\forderItemIds\x12A\n" +
+	"\rskipped_items\x18\x02 \x03(\v2\x1c.restaurant.SkippedOrderItemR\fskippedItems\"`\n" +
+	"\x10SkippedOrderItem\x12 \n" +
+	"\fmenu_item_id\x18\x01 \x01(\tR\n" +
+	"menuItemId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"(\n" +
 	"\x16DeleteOrderItemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"c\n" +
 	"\x1fUpdateOrderItemModifiersRequest\x12\"\n" +
@@ -4635,23 +10226,54 @@ const file_restaurant_proto_rawDesc = "" +
 	"\vcustomer_id\x18\x02 \x01(\tR\n" +
 	"customerId\"+\n" +
 	"\x12CreateGuestRequest\x12\x15\n" +
-	"\x06tab_id\x18\x01 \x01(\tR\x05tabId\"\x19\n" +
+	"\x06tab_id\x18\x01 \x01(\tR\x05tabId\"F\n" +
+	"\x13CreateGuestResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vclaim_token\x18\x02 \x01(\tR\n" +
+	"claimToken\"\x19\n" +
 	"\aGuestID\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
 	"\x16UpdateGuestNameRequest\x12\x19\n" +
 	"\bguest_id\x18\x01 \x01(\tR\aguestId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"*\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"O\n" +
+	"\x11ClaimGuestRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\tR\aguestId\x12\x1f\n" +
+	"\vclaim_token\x18\x02 \x01(\tR\n" +
+	"claimToken\"*\n" +
 	"\x11GetOpenTabRequest\x12\x15\n" +
 	"\x06tab_id\x18\x01 \x01(\tR\x05tabId\"(\n" +
 	"\x0fCloseTabRequest\x12\x15\n" +
 	"\x06tab_id\x18\x01 \x01(\tR\x05tabId\"K\n" +
 	"\x10CloseTabResponse\x127\n" +
-	"\tclosed_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"8\n" +
+	"\tclosed_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"\x8a\x02\n" +
 	"\x15GetVisitedTabsRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
-	"customerId\"=\n" +
+	"customerId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x16\n" +
+	"\x06closed\x18\x06 \x01(\bR\x06closed\x12\x18\n" +
+	"\asummary\x18\a \x01(\bR\asummary\"e\n" +
 	"\x16GetVisitedTabsResponse\x12#\n" +
-	"\x04tabs\x18\x01 \x03(\v2\x0f.restaurant.TabR\x04tabs\"\xef\x02\n" +
+	"\x04tabs\x18\x01 \x03(\v2\x0f.restaurant.TabR\x04tabs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"9\n" +
+	"\x16GetSpendSummaryRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"\x91\x01\n" +
+	"\fSpendSummary\x12\x1f\n" +
+	"\vtotal_spend\x18\x01 \x01(\x03R\n" +
+	"totalSpend\x12\x1f\n" +
+	"\vvisit_count\x18\x02 \x01(\x03R\n" +
+	"visitCount\x12?\n" +
+	"\x0efavorite_items\x18\x03 \x03(\v2\x18.restaurant.FavoriteItemR\rfavoriteItems\"}\n" +
+	"\fFavoriteItem\x12 \n" +
+	"\fmenu_item_id\x18\x01 \x01(\tR\n" +
+	"menuItemId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x1b\n" +
+	"\ttab_count\x18\x04 \x01(\x03R\btabCount\"\xef\x02\n" +
 	"\x03Tab\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vtotal_price\x18\x02 \x01(\x05R\n" +
@@ -4682,7 +10304,7 @@ const file_restaurant_proto_rawDesc = "" +
 	"\x05price\x18\n" +
 	" \x01(\x05R\x05price\x12!\n" +
 	"\fportion_size\x18\v \x01(\x05R\vportionSize\x12)\n" +
-	"\x10modifiers_config\x18\f \x01(\fR\x0fmodifiersConfig\"\xa1\x03\n" +
+	"\x10modifiers_config\x18\f \x01(\fR\x0fmodifiersConfig\"\xe9\x04\n" +
 	"\bMenuItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -4697,7 +10319,86 @@ const file_restaurant_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xbe\x02\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12/\n" +
+	"\x05photo\x18\f \x01(\v2\x19.restaurant.MenuItemPhotoR\x05photo\x12\x19\n" +
+	"\bmenu_ids\x18\r \x03(\tR\amenuIds\x12U\n" +
+	"\x15availability_override\x18\x0e \x01(\v2 .restaurant.AvailabilityOverrideR\x14availabilityOverride\x12#\n" +
+	"\ravailable_now\x18\x0f \x01(\bR\favailableNow\"f\n" +
+	"\x14AvailabilityOverride\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\x88\x01\n" +
+	"&SetMenuItemAvailabilityOverrideRequest\x12 \n" +
+	"\fmenu_item_id\x18\x01 \x01(\tR\n" +
+	"menuItemId\x12<\n" +
+	"\boverride\x18\x02 \x01(\v2 .restaurant.AvailabilityOverrideR\boverride\"\xd2\x02\n" +
+	"\x04Menu\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x126\n" +
+	"\tschedules\x18\x05 \x03(\v2\x18.restaurant.MenuScheduleR\tschedules\x12\"\n" +
+	"\rmenu_item_ids\x18\x06 \x03(\tR\vmenuItemIds\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9e\x01\n" +
+	"\fMenuSchedule\x12\x1a\n" +
+	"\bweekdays\x18\x01 \x03(\x05R\bweekdays\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\"9\n" +
+	"\x11CreateMenuRequest\x12$\n" +
+	"\x04menu\x18\x01 \x01(\v2\x10.restaurant.MenuR\x04menu\" \n" +
+	"\x0eGetMenuRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x11ListMenusResponse\x12&\n" +
+	"\x05menus\x18\x01 \x03(\v2\x10.restaurant.MenuR\x05menus\"9\n" +
+	"\x11UpdateMenuRequest\x12$\n" +
+	"\x04menu\x18\x01 \x01(\v2\x10.restaurant.MenuR\x04menu\"#\n" +
+	"\x11DeleteMenuRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x1aUploadMenuItemPhotoRequest\x12\"\n" +
+	"\fmenu_item_id\x18\x01 \x01(\tH\x00R\n" +
+	"menuItemId\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"+\n" +
+	"\x11ExportMenuRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"K\n" +
+	"\x12ExportMenuResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"X\n" +
+	"\x11ImportMenuRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"~\n" +
+	"\x12ImportMenuResponse\x120\n" +
+	"\achanges\x18\x01 \x03(\v2\x16.restaurant.MenuChangeR\achanges\x12\x1c\n" +
+	"\tunchanged\x18\x02 \x01(\x05R\tunchanged\x12\x18\n" +
+	"\aapplied\x18\x03 \x01(\bR\aapplied\"b\n" +
+	"\n" +
+	"MenuChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\"\x90\x02\n" +
+	"\rMenuItemPhoto\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12\x1a\n" +
+	"\bblurhash\x18\x04 \x01(\tR\bblurhash\x12!\n" +
+	"\foriginal_url\x18\x05 \x01(\tR\voriginalUrl\x124\n" +
+	"\bvariants\x18\x06 \x03(\v2\x18.restaurant.PhotoVariantR\bvariants\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"b\n" +
+	"\fPhotoVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\"\xbe\x02\n" +
 	"\aMenuTag\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\x12 \n" +
@@ -4715,18 +10416,48 @@ const file_restaurant_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt2\xad\x01\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt2\xab\a\n" +
 	"\x0fCustomerService\x12K\n" +
 	"\x0eCreateCustomer\x12!.restaurant.CreateCustomerRequest\x1a\x14.restaurant.Customer\"\x00\x12M\n" +
-	"\x0fGetCustomerByID\x12\".restaurant.GetCustomerByIDRequest\x1a\x14.restaurant.Customer\"\x002e\n" +
+	"\x0fGetCustomerByID\x12\".restaurant.GetCustomerByIDRequest\x1a\x14.restaurant.Customer\"\x00\x12>\n" +
+	"\fGetMyProfile\x12\x16.google.protobuf.Empty\x1a\x14.restaurant.Customer\"\x00\x12M\n" +
+	"\x0fUpdateMyProfile\x12\".restaurant.UpdateMyProfileRequest\x1a\x14.restaurant.Customer\"\x00\x12I\n" +
+	"\rChangeLoginID\x12 .restaurant.ChangeLoginIDRequest\x1a\x14.restaurant.Customer\"\x00\x12E\n" +
+	"\vChangeEmail\x12\x1e.restaurant.ChangeEmailRequest\x1a\x14.restaurant.Customer\"\x00\x12X\n" +
+	"\x0eChangePassword\x12!.restaurant.ChangePasswordRequest\x1a!.restaurant.GenerateTokenResponse\"\x00\x12O\n" +
+	"\x0fDeleteMyAccount\x12\".restaurant.DeleteMyAccountRequest\x1a\x16.google.protobuf.Empty\"\x00\x12J\n" +
+	"\fExportMyData\x12\x16.google.protobuf.Empty\x1a .restaurant.ExportMyDataResponse\"\x00\x12G\n" +
+	"\fSaveFavorite\x12\x1f.restaurant.SaveFavoriteRequest\x1a\x14.restaurant.Favorite\"\x00\x12L\n" +
+	"\rListFavorites\x12\x16.google.protobuf.Empty\x1a!.restaurant.ListFavoritesResponse\"\x00\x12M\n" +
+	"\x0eDeleteFavorite\x12!.restaurant.DeleteFavoriteRequest\x1a\x16.google.protobuf.Empty\"\x002\xdf\x04\n" +
 	"\vAuthService\x12V\n" +
-	"\rGenerateToken\x12 .restaurant.GenerateTokenRequest\x1a!.restaurant.GenerateTokenResponse\"\x002\x8b\x03\n" +
+	"\rGenerateToken\x12 .restaurant.GenerateTokenRequest\x1a!.restaurant.GenerateTokenResponse\"\x00\x12G\n" +
+	"\vVerifyEmail\x12\x1e.restaurant.VerifyEmailRequest\x1a\x16.google.protobuf.Empty\"\x00\x12L\n" +
+	"\x18RequestEmailVerification\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12Y\n" +
+	"\x14RequestPasswordReset\x12'.restaurant.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\"\x00\x12K\n" +
+	"\rResetPassword\x12 .restaurant.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\"\x00\x12Y\n" +
+	"\x0eStartOIDCLogin\x12!.restaurant.StartOIDCLoginRequest\x1a\".restaurant.StartOIDCLoginResponse\"\x00\x12^\n" +
+	"\x11CompleteOIDCLogin\x12$.restaurant.CompleteOIDCLoginRequest\x1a!.restaurant.GenerateTokenResponse\"\x002\xc0\b\n" +
 	"\vMenuService\x12K\n" +
 	"\x0eCreateMenuItem\x12!.restaurant.CreateMenuItemRequest\x1a\x14.restaurant.MenuItem\"\x00\x12E\n" +
 	"\vGetMenuItem\x12\x1e.restaurant.GetMenuItemRequest\x1a\x14.restaurant.MenuItem\"\x00\x12L\n" +
 	"\rListMenuItems\x12\x16.google.protobuf.Empty\x1a!.restaurant.ListMenuItemsResponse\"\x00\x12K\n" +
 	"\x0eUpdateMenuItem\x12!.restaurant.UpdateMenuItemRequest\x1a\x14.restaurant.MenuItem\"\x00\x12M\n" +
-	"\x0eDeleteMenuItem\x12!.restaurant.DeleteMenuItemRequest\x1a\x16.google.protobuf.Empty\"\x002\xce\x06\n" +
+	"\x0eDeleteMenuItem\x12!.restaurant.DeleteMenuItemRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\\\n" +
+	"\x13UploadMenuItemPhoto\x12&.restaurant.UploadMenuItemPhotoRequest\x1a\x19.restaurant.MenuItemPhoto\"\x00(\x01\x12M\n" +
+	"\n" +
+	"ExportMenu\x12\x1d.restaurant.ExportMenuRequest\x1a\x1e.restaurant.ExportMenuResponse\"\x00\x12M\n" +
+	"\n" +
+	"ImportMenu\x12\x1d.restaurant.ImportMenuRequest\x1a\x1e.restaurant.ImportMenuResponse\"\x00\x12m\n" +
+	"\x1fSetMenuItemAvailabilityOverride\x122.restaurant.SetMenuItemAvailabilityOverrideRequest\x1a\x14.restaurant.MenuItem\"\x00\x12?\n" +
+	"\n" +
+	"CreateMenu\x12\x1d.restaurant.CreateMenuRequest\x1a\x10.restaurant.Menu\"\x00\x129\n" +
+	"\aGetMenu\x12\x1a.restaurant.GetMenuRequest\x1a\x10.restaurant.Menu\"\x00\x12D\n" +
+	"\tListMenus\x12\x16.google.protobuf.Empty\x1a\x1d.restaurant.ListMenusResponse\"\x00\x12?\n" +
+	"\n" +
+	"UpdateMenu\x12\x1d.restaurant.UpdateMenuRequest\x1a\x10.restaurant.Menu\"\x00\x12E\n" +
+	"\n" +
+	"DeleteMenu\x12\x1d.restaurant.DeleteMenuRequest\x1a\x16.google.protobuf.Empty\"\x002\xec\a\n" +
 	"\fOrderService\x12P\n" +
 	"\x0fCreateOrderItem\x12\".restaurant.CreateOrderItemRequest\x1a\x17.restaurant.OrderItemID\"\x00\x12O\n" +
 	"\x0fDeleteOrderItem\x12\".restaurant.DeleteOrderItemRequest\x1a\x16.google.protobuf.Empty\"\x00\x12a\n" +
@@ -4736,136 +10467,262 @@ const file_restaurant_proto_rawDesc = "" +
 	"\x19RemoveOrderItemGuestOwner\x12,.restaurant.RemoveOrderItemGuestOwnerRequest\x1a\x16.google.protobuf.Empty\"\x00\x12c\n" +
 	"\x19AddOrderItemCustomerOwner\x12,.restaurant.AddOrderItemCustomerOwnerRequest\x1a\x16.google.protobuf.Empty\"\x00\x12i\n" +
 	"\x1cRemoveOrderItemCustomerOwner\x12/.restaurant.RemoveOrderItemCustomerOwnerRequest\x1a\x16.google.protobuf.Empty\"\x00\x12C\n" +
-	"\tSendOrder\x12\x1c.restaurant.SendOrderRequest\x1a\x16.google.protobuf.Empty\"\x002\x84\x04\n" +
+	"\tSendOrder\x12\x1c.restaurant.SendOrderRequest\x1a\x16.google.protobuf.Empty\"\x00\x12D\n" +
+	"\aReorder\x12\x1a.restaurant.ReorderRequest\x1a\x1b.restaurant.ReorderResponse\"\x00\x12V\n" +
+	"\x12AddFavoriteToOrder\x12%.restaurant.AddFavoriteToOrderRequest\x1a\x17.restaurant.OrderItemID\"\x002\xaa\x05\n" +
 	"\n" +
 	"TabService\x128\n" +
 	"\tCreateTab\x12\x16.google.protobuf.Empty\x1a\x11.restaurant.TabID\"\x00\x12A\n" +
-	"\bVisitTab\x12\x1b.restaurant.VisitTabRequest\x1a\x16.google.protobuf.Empty\"\x00\x12D\n" +
-	"\vCreateGuest\x12\x1e.restaurant.CreateGuestRequest\x1a\x13.restaurant.GuestID\"\x00\x12O\n" +
-	"\x0fUpdateGuestName\x12\".restaurant.UpdateGuestNameRequest\x1a\x16.google.protobuf.Empty\"\x00\x12>\n" +
+	"\bVisitTab\x12\x1b.restaurant.VisitTabRequest\x1a\x16.google.protobuf.Empty\"\x00\x12P\n" +
+	"\vCreateGuest\x12\x1e.restaurant.CreateGuestRequest\x1a\x1f.restaurant.CreateGuestResponse\"\x00\x12O\n" +
+	"\x0fUpdateGuestName\x12\".restaurant.UpdateGuestNameRequest\x1a\x16.google.protobuf.Empty\"\x00\x12E\n" +
+	"\n" +
+	"ClaimGuest\x12\x1d.restaurant.ClaimGuestRequest\x1a\x16.google.protobuf.Empty\"\x00\x12>\n" +
 	"\n" +
 	"GetOpenTab\x12\x1d.restaurant.GetOpenTabRequest\x1a\x0f.restaurant.Tab\"\x00\x12G\n" +
 	"\bCloseTab\x12\x1b.restaurant.CloseTabRequest\x1a\x1c.restaurant.CloseTabResponse\"\x00\x12Y\n" +
-	"\x0eGetVisitedTabs\x12!.restaurant.GetVisitedTabsRequest\x1a\".restaurant.GetVisitedTabsResponse\"\x00B4Z*restaurant-ordering-system/api/proto;proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+	"\x0eGetVisitedTabs\x12!.restaurant.GetVisitedTabsRequest\x1a\".restaurant.GetVisitedTabsResponse\"\x00\x12Q\n" +
+	"\x0fGetSpendSummary\x12\".restaurant.GetSpendSummaryRequest\x1a\x18.restaurant.SpendSummary\"\x00B4Z*restaurant-ordering-system/api/proto;proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
 
-var file_restaurant_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_restaurant_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_restaurant_proto_goTypes = []any{
-	(*CreateCustomerRequest)(nil),               // 0: restaurant.CreateCustomerRequest
-	(*GetCustomerByIDRequest)(nil),              // 1: restaurant.GetCustomerByIDRequest
-	(*Customer)(nil),                            // 2: restaurant.Customer
-	(*GenerateTokenRequest)(nil),                // 3: restaurant.GenerateTokenRequest
-	(*GenerateTokenResponse)(nil),               // 4: restaurant.GenerateTokenResponse
-	(*CreateMenuItemRequest)(nil),               // 5: restaurant.CreateMenuItemRequest
-	(*GetMenuItemRequest)(nil),                  // 6: restaurant.GetMenuItemRequest
-	(*ListMenuItemsResponse)(nil),               // 7: restaurant.ListMenuItemsResponse
-	(*UpdateMenuItemRequest)(nil),               // 8: restaurant.UpdateMenuItemRequest
-	(*DeleteMenuItemRequest)(nil),               // 9: restaurant.DeleteMenuItemRequest
-	(*CreateOrderItemRequest)(nil),              // 10: restaurant.CreateOrderItemRequest
-	(*OrderItemID)(nil),                         // 11: restaurant.OrderItemID
-	(*DeleteOrderItemRequest)(nil),              // 12: restaurant.DeleteOrderItemRequest
-	(*UpdateOrderItemModifiersRequest)(nil),     // 13: restaurant.UpdateOrderItemModifiersRequest
-	(*UpdateOrderItemQuantityRequest)(nil),      // 14: restaurant.UpdateOrderItemQuantityRequest
-	(*AddOrderItemGuestOwnerRequest)(nil),       // 15: restaurant.AddOrderItemGuestOwnerRequest
-	(*RemoveOrderItemGuestOwnerRequest)(nil),    // 16: restaurant.RemoveOrderItemGuestOwnerRequest
-	(*AddOrderItemCustomerOwnerRequest)(nil),    // 17: restaurant.AddOrderItemCustomerOwnerRequest
-	(*RemoveOrderItemCustomerOwnerRequest)(nil), // 18: restaurant.RemoveOrderItemCustomerOwnerRequest
-	(*SendOrderRequest)(nil),                    // 19: restaurant.SendOrderRequest
-	(*TabID)(nil),                               // 20: restaurant.TabID
-	(*VisitTabRequest)(nil),                     // 21: restaurant.VisitTabRequest
-	(*CreateGuestRequest)(nil),                  // 22: restaurant.CreateGuestRequest
-	(*GuestID)(nil),                             // 23: restaurant.GuestID
-	(*UpdateGuestNameRequest)(nil),              // 24: restaurant.UpdateGuestNameRequest
-	(*GetOpenTabRequest)(nil),                   // 25: restaurant.GetOpenTabRequest
-	(*CloseTabRequest)(nil),                     // 26: restaurant.CloseTabRequest
-	(*CloseTabResponse)(nil),                    // 27: restaurant.CloseTabResponse
-	(*GetVisitedTabsRequest)(nil),               // 28: restaurant.GetVisitedTabsRequest
-	(*GetVisitedTabsResponse)(nil),              // 29: restaurant.GetVisitedTabsResponse
-	(*Tab)(nil),                                 // 30: restaurant.Tab
-	(*Order)(nil),                               // 31: restaurant.Order
-	(*OrderItem)(nil),                           // 32: restaurant.OrderItem
-	(*MenuItem)(nil),                            // 33: restaurant.MenuItem
-	(*MenuTag)(nil),                             // 34: restaurant.MenuTag
-	(*MenuTagDimension)(nil),                    // 35: restaurant.MenuTagDimension
-	nil,                                         // 36: restaurant.Tab.CustomGuestNamesEntry
-	(*timestamppb.Timestamp)(nil),               // 37: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                       // 38: google.protobuf.Empty
+	(*CreateCustomerRequest)(nil),                  // 0: restaurant.CreateCustomerRequest
+	(*GetCustomerByIDRequest)(nil),                 // 1: restaurant.GetCustomerByIDRequest
+	(*UpdateMyProfileRequest)(nil),                 // 2: restaurant.UpdateMyProfileRequest
+	(*ChangeLoginIDRequest)(nil),                   // 3: restaurant.ChangeLoginIDRequest
+	(*ChangeEmailRequest)(nil),                     // 4: restaurant.ChangeEmailRequest
+	(*ChangePasswordRequest)(nil),                  // 5: restaurant.ChangePasswordRequest
+	(*DeleteMyAccountRequest)(nil),                 // 6: restaurant.DeleteMyAccountRequest
+	(*ExportMyDataResponse)(nil),                   // 7: restaurant.ExportMyDataResponse
+	(*SaveFavoriteRequest)(nil),                    // 8: restaurant.SaveFavoriteRequest
+	(*DeleteFavoriteRequest)(nil),                  // 9: restaurant.DeleteFavoriteRequest
+	(*ListFavoritesResponse)(nil),                  // 10: restaurant.ListFavoritesResponse
+	(*Favorite)(nil),                               // 11: restaurant.Favorite
+	(*Customer)(nil),                               // 12: restaurant.Customer
+	(*GenerateTokenRequest)(nil),                   // 13: restaurant.GenerateTokenRequest
+	(*GenerateTokenResponse)(nil),                  // 14: restaurant.GenerateTokenResponse
+	(*VerifyEmailRequest)(nil),                     // 15: restaurant.VerifyEmailRequest
+	(*RequestPasswordResetRequest)(nil),            // 16: restaurant.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),                   // 17: restaurant.ResetPasswordRequest
+	(*StartOIDCLoginRequest)(nil),                  // 18: restaurant.StartOIDCLoginRequest
+	(*StartOIDCLoginResponse)(nil),                 // 19: restaurant.StartOIDCLoginResponse
+	(*CompleteOIDCLoginRequest)(nil),               // 20: restaurant.CompleteOIDCLoginRequest
+	(*CreateMenuItemRequest)(nil),                  // 21: restaurant.CreateMenuItemRequest
+	(*GetMenuItemRequest)(nil),                     // 22: restaurant.GetMenuItemRequest
+	(*ListMenuItemsResponse)(nil),                  // 23: restaurant.ListMenuItemsResponse
+	(*UpdateMenuItemRequest)(nil),                  // 24: restaurant.UpdateMenuItemRequest
+	(*DeleteMenuItemRequest)(nil),                  // 25: restaurant.DeleteMenuItemRequest
+	(*CreateOrderItemRequest)(nil),                 // 26: restaurant.CreateOrderItemRequest
+	(*OrderItemID)(nil),                            // 27: restaurant.OrderItemID
+	(*AddFavoriteToOrderRequest)(nil),              // 28: restaurant.AddFavoriteToOrderRequest
+	(*ReorderRequest)(nil),                         // 29: restaurant.ReorderRequest
+	(*ReorderResponse)(nil),                        // 30: restaurant.ReorderResponse
+	(*SkippedOrderItem)(nil),                       // 31: restaurant.SkippedOrderItem
+	(*DeleteOrderItemRequest)(nil),                 // 32: restaurant.DeleteOrderItemRequest
+	(*UpdateOrderItemModifiersRequest)(nil),        // 33: restaurant.UpdateOrderItemModifiersRequest
+	(*UpdateOrderItemQuantityRequest)(nil),         // 34: restaurant.UpdateOrderItemQuantityRequest
+	(*AddOrderItemGuestOwnerRequest)(nil),          // 35: restaurant.AddOrderItemGuestOwnerRequest
+	(*RemoveOrderItemGuestOwnerRequest)(nil),       // 36: restaurant.RemoveOrderItemGuestOwnerRequest
+	(*AddOrderItemCustomerOwnerRequest)(nil),       // 37: restaurant.AddOrderItemCustomerOwnerRequest
+	(*RemoveOrderItemCustomerOwnerRequest)(nil),    // 38: restaurant.RemoveOrderItemCustomerOwnerRequest
+	(*SendOrderRequest)(nil),                       // 39: restaurant.SendOrderRequest
+	(*TabID)(nil),                                  // 40: restaurant.TabID
+	(*VisitTabRequest)(nil),                        // 41: restaurant.VisitTabRequest
+	(*CreateGuestRequest)(nil),                     // 42: restaurant.CreateGuestRequest
+	(*CreateGuestResponse)(nil),                    // 43: restaurant.CreateGuestResponse
+	(*GuestID)(nil),                                // 44: restaurant.GuestID
+	(*UpdateGuestNameRequest)(nil),                 // 45: restaurant.UpdateGuestNameRequest
+	(*ClaimGuestRequest)(nil),                      // 46: restaurant.ClaimGuestRequest
+	(*GetOpenTabRequest)(nil),                      // 47: restaurant.GetOpenTabRequest
+	(*CloseTabRequest)(nil),                        // 48: restaurant.CloseTabRequest
+	(*CloseTabResponse)(nil),                       // 49: restaurant.CloseTabResponse
+	(*GetVisitedTabsRequest)(nil),                  // 50: restaurant.GetVisitedTabsRequest
+	(*GetVisitedTabsResponse)(nil),                 // 51: restaurant.GetVisitedTabsResponse
+	(*GetSpendSummaryRequest)(nil),                 // 52: restaurant.GetSpendSummaryRequest
+	(*SpendSummary)(nil),                           // 53: restaurant.SpendSummary
+	(*FavoriteItem)(nil),                           // 54: restaurant.FavoriteItem
+	(*Tab)(nil),                                    // 55: restaurant.Tab
+	(*Order)(nil),                                  // 56: restaurant.Order
+	(*OrderItem)(nil),                              // 57: restaurant.OrderItem
+	(*MenuItem)(nil),                               // 58: restaurant.MenuItem
+	(*AvailabilityOverride)(nil),                   // 59: restaurant.AvailabilityOverride
+	(*SetMenuItemAvailabilityOverrideRequest)(nil), // 60: restaurant.SetMenuItemAvailabilityOverrideRequest
+	(*Menu)(nil),                                   // 61: restaurant.Menu
+	(*MenuSchedule)(nil),                           // 62: restaurant.MenuSchedule
+	(*CreateMenuRequest)(nil),                      // 63: restaurant.CreateMenuRequest
+	(*GetMenuRequest)(nil),                         // 64: restaurant.GetMenuRequest
+	(*ListMenusResponse)(nil),                      // 65: restaurant.ListMenusResponse
+	(*UpdateMenuRequest)(nil),                      // 66: restaurant.UpdateMenuRequest
+	(*DeleteMenuRequest)(nil),                      // 67: restaurant.DeleteMenuRequest
+	(*UploadMenuItemPhotoRequest)(nil),             // 68: restaurant.UploadMenuItemPhotoRequest
+	(*ExportMenuRequest)(nil),                      // 69: restaurant.ExportMenuRequest
+	(*ExportMenuResponse)(nil),                     // 70: restaurant.ExportMenuResponse
+	(*ImportMenuRequest)(nil),                      // 71: restaurant.ImportMenuRequest
+	(*ImportMenuResponse)(nil),                     // 72: restaurant.ImportMenuResponse
+	(*MenuChange)(nil),                             // 73: restaurant.MenuChange
+	(*MenuItemPhoto)(nil),                          // 74: restaurant.MenuItemPhoto
+	(*PhotoVariant)(nil),                           // 75: restaurant.PhotoVariant
+	(*MenuTag)(nil),                                // 76: restaurant.MenuTag
+	(*MenuTagDimension)(nil),                       // 77: restaurant.MenuTagDimension
+	nil,                                            // 78: restaurant.Tab.CustomGuestNamesEntry
+	(*timestamppb.Timestamp)(nil),                  // 79: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                          // 80: google.protobuf.Empty
 }
 var file_restaurant_proto_depIdxs = []int32{
-	37, // 0: restaurant.Customer.created_at:type_name -> google.protobuf.Timestamp
-	37, // 1: restaurant.Customer.updated_at:type_name -> google.protobuf.Timestamp
-	33, // 2: restaurant.CreateMenuItemRequest.menu_item:type_name -> restaurant.MenuItem
-	33, // 3: restaurant.ListMenuItemsResponse.items:type_name -> restaurant.MenuItem
-	33, // 4: restaurant.UpdateMenuItemRequest.menu_item:type_name -> restaurant.MenuItem
-	37, // 5: restaurant.CloseTabResponse.closed_at:type_name -> google.protobuf.Timestamp
-	30, // 6: restaurant.GetVisitedTabsResponse.tabs:type_name -> restaurant.Tab
-	31, // 7: restaurant.Tab.orders:type_name -> restaurant.Order
-	36, // 8: restaurant.Tab.custom_guest_names:type_name -> restaurant.Tab.CustomGuestNamesEntry
-	37, // 9: restaurant.Tab.created_at:type_name -> google.protobuf.Timestamp
-	37, // 10: restaurant.Tab.closed_at:type_name -> google.protobuf.Timestamp
-	32, // 11: restaurant.Order.items:type_name -> restaurant.OrderItem
-	37, // 12: restaurant.Order.sent_at:type_name -> google.protobuf.Timestamp
-	34, // 13: restaurant.MenuItem.menu_tags:type_name -> restaurant.MenuTag
-	37, // 14: restaurant.MenuItem.created_at:type_name -> google.protobuf.Timestamp
-	37, // 15: restaurant.MenuItem.deleted_at:type_name -> google.protobuf.Timestamp
-	35, // 16: restaurant.MenuTag.dimension:type_name -> restaurant.MenuTagDimension
-	34, // 17: restaurant.MenuTag.prerequisites:type_name -> restaurant.MenuTag
-	37, // 18: restaurant.MenuTag.created_at:type_name -> google.protobuf.Timestamp
-	37, // 19: restaurant.MenuTag.updated_at:type_name -> google.protobuf.Timestamp
-	37, // 20: restaurant.MenuTagDimension.created_at:type_name -> google.protobuf.Timestamp
-	37, // 21: restaurant.MenuTagDimension.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 22: restaurant.CustomerService.CreateCustomer:input_type -> restaurant.CreateCustomerRequest
-	1,  // 23: restaurant.CustomerService.GetCustomerByID:input_type -> restaurant.GetCustomerByIDRequest
-	3,  // 24: restaurant.AuthService.GenerateToken:input_type -> restaurant.GenerateTokenRequest
-	5,  // 25: restaurant.MenuService.CreateMenuItem:input_type -> restaurant.CreateMenuItemRequest
-	6,  // 26: restaurant.MenuService.GetMenuItem:input_type -> restaurant.GetMenuItemRequest
-	38, // 27: restaurant.MenuService.ListMenuItems:input_type -> google.protobuf.Empty
-	8,  // 28: restaurant.MenuService.UpdateMenuItem:input_type -> restaurant.UpdateMenuItemRequest
-	9,  // 29: restaurant.MenuService.DeleteMenuItem:input_type -> restaurant.DeleteMenuItemRequest
-	10, // 30: restaurant.OrderService.CreateOrderItem:input_type -> restaurant.CreateOrderItemRequest
-	12, // 31: restaurant.OrderService.DeleteOrderItem:input_type -> restaurant.DeleteOrderItemRequest
-	13, // 32: restaurant.OrderService.UpdateOrderItemModifiers:input_type -> restaurant.UpdateOrderItemModifiersRequest
-	14, // 33: restaurant.OrderService.UpdateOrderItemQuantity:input_type -> restaurant.UpdateOrderItemQuantityRequest
-	15, // 34: restaurant.OrderService.AddOrderItemGuestOwner:input_type -> restaurant.AddOrderItemGuestOwnerRequest
-	16, // 35: restaurant.OrderService.RemoveOrderItemGuestOwner:input_type -> restaurant.RemoveOrderItemGuestOwnerRequest
-	17, // 36: restaurant.OrderService.AddOrderItemCustomerOwner:input_type -> restaurant.AddOrderItemCustomerOwnerRequest
-	18, // 37: restaurant.OrderService.RemoveOrderItemCustomerOwner:input_type -> restaurant.RemoveOrderItemCustomerOwnerRequest
-	19, // 38: restaurant.OrderService.SendOrder:input_type -> restaurant.SendOrderRequest
-	38, // 39: restaurant.TabService.CreateTab:input_type -> google.protobuf.Empty
-	21, // 40: restaurant.TabService.VisitTab:input_type -> restaurant.VisitTabRequest
-	22, // 41: restaurant.TabService.CreateGuest:input_type -> restaurant.CreateGuestRequest
-	24, // 42: restaurant.TabService.UpdateGuestName:input_type -> restaurant.UpdateGuestNameRequest
-	25, // 43: restaurant.TabService.GetOpenTab:input_type -> restaurant.GetOpenTabRequest
-	26, // 44: restaurant.TabService.CloseTab:input_type -> restaurant.CloseTabRequest
-	28, // 45: restaurant.TabService.GetVisitedTabs:input_type -> restaurant.GetVisitedTabsRequest
-	2,  // 46: restaurant.CustomerService.CreateCustomer:output_type -> restaurant.Customer
-	2,  // 47: restaurant.CustomerService.GetCustomerByID:output_type -> restaurant.Customer
-	4,  // 48: restaurant.AuthService.GenerateToken:output_type -> restaurant.GenerateTokenResponse
-	33, // 49: restaurant.MenuService.CreateMenuItem:output_type -> restaurant.MenuItem
-	33, // 50: restaurant.MenuService.GetMenuItem:output_type -> restaurant.MenuItem
-	7,  // 51: restaurant.MenuService.ListMenuItems:output_type -> restaurant.ListMenuItemsResponse
-	33, // 52: restaurant.MenuService.UpdateMenuItem:output_type -> restaurant.MenuItem
-	38, // 53: restaurant.MenuService.DeleteMenuItem:output_type -> google.protobuf.Empty
-	11, // 54: restaurant.OrderService.CreateOrderItem:output_type -> restaurant.OrderItemID
-	38, // 55: restaurant.OrderService.DeleteOrderItem:output_type -> google.protobuf.Empty
-	38, // 56: restaurant.OrderService.UpdateOrderItemModifiers:output_type -> google.protobuf.Empty
-	38, // 57: restaurant.OrderService.UpdateOrderItemQuantity:output_type -> google.protobuf.Empty
-	38, // 58: restaurant.OrderService.AddOrderItemGuestOwner:output_type -> google.protobuf.Empty
-	38, // 59: restaurant.OrderService.RemoveOrderItemGuestOwner:output_type -> google.protobuf.Empty
-	38, // 60: restaurant.OrderService.AddOrderItemCustomerOwner:output_type -> google.protobuf.Empty
-	38, // 61: restaurant.OrderService.RemoveOrderItemCustomerOwner:output_type -> google.protobuf.Empty
-	38, // 62: restaurant.OrderService.SendOrder:output_type -> google.protobuf.Empty
-	20, // 63: restaurant.TabService.CreateTab:output_type -> restaurant.TabID
-	38, // 64: restaurant.TabService.VisitTab:output_type -> google.protobuf.Empty
-	23, // 65: restaurant.TabService.CreateGuest:output_type -> restaurant.GuestID
-	38, // 66: restaurant.TabService.UpdateGuestName:output_type -> google.protobuf.Empty
-	30, // 67: restaurant.TabService.GetOpenTab:output_type -> restaurant.Tab
-	27, // 68: restaurant.TabService.CloseTab:output_type -> restaurant.CloseTabResponse
-	29, // 69: restaurant.TabService.GetVisitedTabs:output_type -> restaurant.GetVisitedTabsResponse
-	46, // [46:70] is the sub-list for method output_type
-	22, // [22:46] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	11, // 0: restaurant.ListFavoritesResponse.favorites:type_name -> restaurant.Favorite
+	79, // 1: restaurant.Favorite.created_at:type_name -> google.protobuf.Timestamp
+	79, // 2: restaurant.Favorite.updated_at:type_name -> google.protobuf.Timestamp
+	79, // 3: restaurant.Customer.created_at:type_name -> google.protobuf.Timestamp
+	79, // 4: restaurant.Customer.updated_at:type_name -> google.protobuf.Timestamp
+	58, // 5: restaurant.CreateMenuItemRequest.menu_item:type_name -> restaurant.MenuItem
+	58, // 6: restaurant.ListMenuItemsResponse.items:type_name -> restaurant.MenuItem
+	58, // 7: restaurant.UpdateMenuItemRequest.menu_item:type_name -> restaurant.MenuItem
+	27, // 8: restaurant.ReorderResponse.order_item_ids:type_name -> restaurant.OrderItemID
+	31, // 9: restaurant.ReorderResponse.skipped_items:type_name -> restaurant.SkippedOrderItem
+	79, // 10: restaurant.CloseTabResponse.closed_at:type_name -> google.protobuf.Timestamp
+	79, // 11: restaurant.GetVisitedTabsRequest.since:type_name -> google.protobuf.Timestamp
+	79, // 12: restaurant.GetVisitedTabsRequest.until:type_name -> google.protobuf.Timestamp
+	55, // 13: restaurant.GetVisitedTabsResponse.tabs:type_name -> restaurant.Tab
+	54, // 14: restaurant.SpendSummary.favorite_items:type_name -> restaurant.FavoriteItem
+	56, // 15: restaurant.Tab.orders:type_name -> restaurant.Order
+	78, // 16: restaurant.Tab.custom_guest_names:type_name -> restaurant.Tab.CustomGuestNamesEntry
+	79, // 17: restaurant.Tab.created_at:type_name -> google.protobuf.Timestamp
+	79, // 18: restaurant.Tab.closed_at:type_name -> google.protobuf.Timestamp
+	57, // 19: restaurant.Order.items:type_name -> restaurant.OrderItem
+	79, // 20: restaurant.Order.sent_at:type_name -> google.protobuf.Timestamp
+	76, // 21: restaurant.MenuItem.menu_tags:type_name -> restaurant.MenuTag
+	79, // 22: restaurant.MenuItem.created_at:type_name -> google.protobuf.Timestamp
+	79, // 23: restaurant.MenuItem.deleted_at:type_name -> google.protobuf.Timestamp
+	74, // 24: restaurant.MenuItem.photo:type_name -> restaurant.MenuItemPhoto
+	59, // 25: restaurant.MenuItem.availability_override:type_name -> restaurant.AvailabilityOverride
+	79, // 26: restaurant.AvailabilityOverride.until:type_name -> google.protobuf.Timestamp
+	59, // 27: restaurant.SetMenuItemAvailabilityOverrideRequest.override:type_name -> restaurant.AvailabilityOverride
+	62, // 28: restaurant.Menu.schedules:type_name -> restaurant.MenuSchedule
+	79, // 29: restaurant.Menu.created_at:type_name -> google.protobuf.Timestamp
+	79, // 30: restaurant.Menu.updated_at:type_name -> google.protobuf.Timestamp
+	61, // 31: restaurant.CreateMenuRequest.menu:type_name -> restaurant.Menu
+	61, // 32: restaurant.ListMenusResponse.menus:type_name -> restaurant.Menu
+	61, // 33: restaurant.UpdateMenuRequest.menu:type_name -> restaurant.Menu
+	73, // 34: restaurant.ImportMenuResponse.changes:type_name -> restaurant.MenuChange
+	75, // 35: restaurant.MenuItemPhoto.variants:type_name -> restaurant.PhotoVariant
+	79, // 36: restaurant.MenuItemPhoto.created_at:type_name -> google.protobuf.Timestamp
+	77, // 37: restaurant.MenuTag.dimension:type_name -> restaurant.MenuTagDimension
+	76, // 38: restaurant.MenuTag.prerequisites:type_name -> restaurant.MenuTag
+	79, // 39: restaurant.MenuTag.created_at:type_name -> google.protobuf.Timestamp
+	79, // 40: restaurant.MenuTag.updated_at:type_name -> google.protobuf.Timestamp
+	79, // 41: restaurant.MenuTagDimension.created_at:type_name -> google.protobuf.Timestamp
+	79, // 42: restaurant.MenuTagDimension.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 43: restaurant.CustomerService.CreateCustomer:input_type -> restaurant.CreateCustomerRequest
+	1,  // 44: restaurant.CustomerService.GetCustomerByID:input_type -> restaurant.GetCustomerByIDRequest
+	80, // 45: restaurant.CustomerService.GetMyProfile:input_type -> google.protobuf.Empty
+	2,  // 46: restaurant.CustomerService.UpdateMyProfile:input_type -> restaurant.UpdateMyProfileRequest
+	3,  // 47: restaurant.CustomerService.ChangeLoginID:input_type -> restaurant.ChangeLoginIDRequest
+	4,  // 48: restaurant.CustomerService.ChangeEmail:input_type -> restaurant.ChangeEmailRequest
+	5,  // 49: restaurant.CustomerService.ChangePassword:input_type -> restaurant.ChangePasswordRequest
+	6,  // 50: restaurant.CustomerService.DeleteMyAccount:input_type -> restaurant.DeleteMyAccountRequest
+	80, // 51: restaurant.CustomerService.ExportMyData:input_type -> google.protobuf.Empty
+	8,  // 52: restaurant.CustomerService.SaveFavorite:input_type -> restaurant.SaveFavoriteRequest
+	80, // 53: restaurant.CustomerService.ListFavorites:input_type -> google.protobuf.Empty
+	9,  // 54: restaurant.CustomerService.DeleteFavorite:input_type -> restaurant.DeleteFavoriteRequest
+	13, // 55: restaurant.AuthService.GenerateToken:input_type -> restaurant.GenerateTokenRequest
+	15, // 56: restaurant.AuthService.VerifyEmail:input_type -> restaurant.VerifyEmailRequest
+	80, // 57: restaurant.AuthService.RequestEmailVerification:input_type -> google.protobuf.Empty
+	16, // 58: restaurant.AuthService.RequestPasswordReset:input_type -> restaurant.RequestPasswordResetRequest
+	17, // 59: restaurant.AuthService.ResetPassword:input_type -> restaurant.ResetPasswordRequest
+	18, // 60: restaurant.AuthService.StartOIDCLogin:input_type -> restaurant.StartOIDCLoginRequest
+	20, // 61: restaurant.AuthService.CompleteOIDCLogin:input_type -> restaurant.CompleteOIDCLoginRequest
+	21, // 62: restaurant.MenuService.CreateMenuItem:input_type -> restaurant.CreateMenuItemRequest
+	22, // 63: restaurant.MenuService.GetMenuItem:input_type -> restaurant.GetMenuItemRequest
+	80, // 64: restaurant.MenuService.ListMenuItems:input_type -> google.protobuf.Empty
+	24, // 65: restaurant.MenuService.UpdateMenuItem:input_type -> restaurant.UpdateMenuItemRequest
+	25, // 66: restaurant.MenuService.DeleteMenuItem:input_type -> restaurant.DeleteMenuItemRequest
+	68, // 67: restaurant.MenuService.UploadMenuItemPhoto:input_type -> restaurant.UploadMenuItemPhotoRequest
+	69, // 68: restaurant.MenuService.ExportMenu:input_type -> restaurant.ExportMenuRequest
+	71, // 69: restaurant.MenuService.ImportMenu:input_type -> restaurant.ImportMenuRequest
+	60, // 70: restaurant.MenuService.SetMenuItemAvailabilityOverride:input_type -> restaurant.SetMenuItemAvailabilityOverrideRequest
+	63, // 71: restaurant.MenuService.CreateMenu:input_type -> restaurant.CreateMenuRequest
+	64, // 72: restaurant.MenuService.GetMenu:input_type -> restaurant.GetMenuRequest
+	80, // 73: restaurant.MenuService.ListMenus:input_type -> google.protobuf.Empty
+	66, // 74: restaurant.MenuService.UpdateMenu:input_type -> restaurant.UpdateMenuRequest
+	67, // 75: restaurant.MenuService.DeleteMenu:input_type -> restaurant.DeleteMenuRequest
+	26, // 76: restaurant.OrderService.CreateOrderItem:input_type -> restaurant.CreateOrderItemRequest
+	32, // 77: restaurant.OrderService.DeleteOrderItem:input_type -> restaurant.DeleteOrderItemRequest
+	33, // 78: restaurant.OrderService.UpdateOrderItemModifiers:input_type -> restaurant.UpdateOrderItemModifiersRequest
+	34, // 79: restaurant.OrderService.UpdateOrderItemQuantity:input_type -> restaurant.UpdateOrderItemQuantityRequest
+	35, // 80: restaurant.OrderService.AddOrderItemGuestOwner:input_type -> restaurant.AddOrderItemGuestOwnerRequest
+	36, // 81: restaurant.OrderService.RemoveOrderItemGuestOwner:input_type -> restaurant.RemoveOrderItemGuestOwnerRequest
+	37, // 82: restaurant.OrderService.AddOrderItemCustomerOwner:input_type -> restaurant.AddOrderItemCustomerOwnerRequest
+	38, // 83: restaurant.OrderService.RemoveOrderItemCustomerOwner:input_type -> restaurant.RemoveOrderItemCustomerOwnerRequest
+	39, // 84: restaurant.OrderService.SendOrder:input_type -> restaurant.SendOrderRequest
+	29, // 85: restaurant.OrderService.Reorder:input_type -> restaurant.ReorderRequest
+	28, // 86: restaurant.OrderService.AddFavoriteToOrder:input_type -> restaurant.AddFavoriteToOrderRequest
+	80, // 87: restaurant.TabService.CreateTab:input_type -> google.protobuf.Empty
+	41, // 88: restaurant.TabService.VisitTab:input_type -> restaurant.VisitTabRequest
+	42, // 89: restaurant.TabService.CreateGuest:input_type -> restaurant.CreateGuestRequest
+	45, // 90: restaurant.TabService.UpdateGuestName:input_type -> restaurant.UpdateGuestNameRequest
+	46, // 91: restaurant.TabService.ClaimGuest:input_type -> restaurant.ClaimGuestRequest
+	47, // 92: restaurant.TabService.GetOpenTab:input_type -> restaurant.GetOpenTabRequest
+	48, // 93: restaurant.TabService.CloseTab:input_type -> restaurant.CloseTabRequest
+	50, // 94: restaurant.TabService.GetVisitedTabs:input_type -> restaurant.GetVisitedTabsRequest
+	52, // 95: restaurant.TabService.GetSpendSummary:input_type -> restaurant.GetSpendSummaryRequest
+	12, // 96: restaurant.CustomerService.CreateCustomer:output_type -> restaurant.Customer
+	12, // 97: restaurant.CustomerService.GetCustomerByID:output_type -> restaurant.Customer
+	12, // 98: restaurant.CustomerService.GetMyProfile:output_type -> restaurant.Customer
+	12, // 99: restaurant.CustomerService.UpdateMyProfile:output_type -> restaurant.Customer
+	12, // 100: restaurant.CustomerService.ChangeLoginID:output_type -> restaurant.Customer
+	12, // 101: restaurant.CustomerService.ChangeEmail:output_type -> restaurant.Customer
+	14, // 102: restaurant.CustomerService.ChangePassword:output_type -> restaurant.GenerateTokenResponse
+	80, // 103: restaurant.CustomerService.DeleteMyAccount:output_type -> google.protobuf.Empty
+	7,  // 104: restaurant.CustomerService.ExportMyData:output_type -> restaurant.ExportMyDataResponse
+	11, // 105: restaurant.CustomerService.SaveFavorite:output_type -> restaurant.Favorite
+	10, // 106: restaurant.CustomerService.ListFavorites:output_type -> restaurant.ListFavoritesResponse
+	80, // 107: restaurant.CustomerService.DeleteFavorite:output_type -> google.protobuf.Empty
+	14, // 108: restaurant.AuthService.GenerateToken:output_type -> restaurant.GenerateTokenResponse
+	80, // 109: restaurant.AuthService.VerifyEmail:output_type -> google.protobuf.Empty
+	80, // 110: restaurant.AuthService.RequestEmailVerification:output_type -> google.protobuf.Empty
+	80, // 111: restaurant.AuthService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	80, // 112: restaurant.AuthService.ResetPassword:output_type -> google.protobuf.Empty
+	19, // 113: restaurant.AuthService.StartOIDCLogin:output_type -> restaurant.StartOIDCLoginResponse
+	14, // 114: restaurant.AuthService.CompleteOIDCLogin:output_type -> restaurant.GenerateTokenResponse
+	58, // 115: restaurant.MenuService.CreateMenuItem:output_type -> restaurant.MenuItem
+	58, // 116: restaurant.MenuService.GetMenuItem:output_type -> restaurant.MenuItem
+	23, // 117: restaurant.MenuService.ListMenuItems:output_type -> restaurant.ListMenuItemsResponse
+	58, // 118: restaurant.MenuService.UpdateMenuItem:output_type -> restaurant.MenuItem
+	80, // 119: restaurant.MenuService.DeleteMenuItem:output_type -> google.protobuf.Empty
+	74, // 120: restaurant.MenuService.UploadMenuItemPhoto:output_type -> restaurant.MenuItemPhoto
+	70, // 121: restaurant.MenuService.ExportMenu:output_type -> restaurant.ExportMenuResponse
+	72, // 122: restaurant.MenuService.ImportMenu:output_type -> restaurant.ImportMenuResponse
+	58, // 123: restaurant.MenuService.SetMenuItemAvailabilityOverride:output_type -> restaurant.MenuItem
+	61, // 124: restaurant.MenuService.CreateMenu:output_type -> restaurant.Menu
+	61, // 125: restaurant.MenuService.GetMenu:output_type -> restaurant.Menu
+	65, // 126: restaurant.MenuService.ListMenus:output_type -> restaurant.ListMenusResponse
+	61, // 127: restaurant.MenuService.UpdateMenu:output_type -> restaurant.Menu
+	80, // 128: restaurant.MenuService.DeleteMenu:output_type -> google.protobuf.Empty
+	27, // 129: restaurant.OrderService.CreateOrderItem:output_type -> restaurant.OrderItemID
+	80, // 130: restaurant.OrderService.DeleteOrderItem:output_type -> google.protobuf.Empty
+	80, // 131: restaurant.OrderService.UpdateOrderItemModifiers:output_type -> google.protobuf.Empty
+	80, // 132: restaurant.OrderService.UpdateOrderItemQuantity:output_type -> google.protobuf.Empty
+	80, // 133: restaurant.OrderService.AddOrderItemGuestOwner:output_type -> google.protobuf.Empty
+	80, // 134: restaurant.OrderService.RemoveOrderItemGuestOwner:output_type -> google.protobuf.Empty
+	80, // 135: restaurant.OrderService.AddOrderItemCustomerOwner:output_type -> google.protobuf.Empty
+	80, // 136: restaurant.OrderService.RemoveOrderItemCustomerOwner:output_type -> google.protobuf.Empty
+	80, // 137: restaurant.OrderService.SendOrder:output_type -> google.protobuf.Empty
+	30, // 138: restaurant.OrderService.Reorder:output_type -> restaurant.ReorderResponse
+	27, // 139: restaurant.OrderService.AddFavoriteToOrder:output_type -> restaurant.OrderItemID
+	40, // 140: restaurant.TabService.CreateTab:output_type -> restaurant.TabID
+	80, // 141: restaurant.TabService.VisitTab:output_type -> google.protobuf.Empty
+	43, // 142: restaurant.TabService.CreateGuest:output_type -> restaurant.CreateGuestResponse
+	80, // 143: restaurant.TabService.UpdateGuestName:output_type -> google.protobuf.Empty
+	80, // 144: restaurant.TabService.ClaimGuest:output_type -> google.protobuf.Empty
+	55, // 145: restaurant.TabService.GetOpenTab:output_type -> restaurant.Tab
+	49, // 146: restaurant.TabService.CloseTab:output_type -> restaurant.CloseTabResponse
+	51, // 147: restaurant.TabService.GetVisitedTabs:output_type -> restaurant.GetVisitedTabsResponse
+	53, // 148: restaurant.TabService.GetSpendSummary:output_type -> restaurant.SpendSummary
+	96, // [96:149] is the sub-list for method output_type
+	43, // [43:96] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_restaurant_proto_init() }
@@ -4873,13 +10730,21 @@ func file_restaurant_proto_init() {
 	if File_restaurant_proto != nil {
 		return
 	}
+	file_restaurant_proto_msgTypes[29].OneofWrappers = []any{
+		(*reorderRequest_SourceOrderId)(nil),
+		(*reorderRequest_SourceTabId)(nil),
+	}
+	file_restaurant_proto_msgTypes[68].OneofWrappers = []any{
+		(*uploadMenuItemPhotoRequest_MenuItemId)(nil),
+		(*uploadMenuItemPhotoRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_restaurant_proto_rawDesc), len(file_restaurant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   5,
 		},
@@ -22,6 +22,16 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	CustomerService_CreateCustomer_FullMethodName  = "/restaurant.CustomerService/CreateCustomer"
 	CustomerService_GetCustomerByID_FullMethodName = "/restaurant.CustomerService/GetCustomerByID"
+	CustomerService_GetMyProfile_FullMethodName    = "/restaurant.CustomerService/GetMyProfile"
+	CustomerService_UpdateMyProfile_FullMethodName = "/restaurant.CustomerService/UpdateMyProfile"
+	CustomerService_ChangeLoginID_FullMethodName   = "/restaurant.CustomerService/ChangeLoginID"
+	CustomerService_ChangeEmail_FullMethodName     = "/restaurant.CustomerService/ChangeEmail"
+	CustomerService_ChangePassword_FullMethodName  = "/restaurant.CustomerService/ChangePassword"
+	CustomerService_DeleteMyAccount_FullMethodName = "/restaurant.CustomerService/DeleteMyAccount"
+	CustomerService_ExportMyData_FullMethodName    = "/restaurant.CustomerService/ExportMyData"
+	CustomerService_SaveFavorite_FullMethodName    = "/restaurant.CustomerService/SaveFavorite"
+	CustomerService_ListFavorites_FullMethodName   = "/restaurant.CustomerService/ListFavorites"
+	CustomerService_DeleteFavorite_FullMethodName  = "/restaurant.CustomerService/DeleteFavorite"
 )
 
 // CustomerServiceClient is the client API for CustomerService service.
@@ -30,6 +40,19 @@ const (
 type CustomerServiceClient interface {
 	CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*Customer, error)
 	GetCustomerByID(ctx context.Context, in *GetCustomerByIDRequest, opts ...grpc.CallOption) (*Customer, error)
+	GetMyProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Customer, error)
+	UpdateMyProfile(ctx context.Context, in *UpdateMyProfileRequest, opts ...grpc.CallOption) (*Customer, error)
+	ChangeLoginID(ctx context.Context, in *ChangeLoginIDRequest, opts ...grpc.CallOption) (*Customer, error)
+	ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*Customer, error)
+	// ChangePassword revokes every other session and returns a new token for the current one
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error)
+	// DeleteMyAccount anonymizes the customer, who is removed from the tabs they visited
+	DeleteMyAccount(ctx context.Context, in *DeleteMyAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportMyData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportMyDataResponse, error)
+	// SaveFavorite replaces the favorite of the customer with the same name, if any
+	SaveFavorite(ctx context.Context, in *SaveFavoriteRequest, opts ...grpc.CallOption) (*Favorite, error)
+	ListFavorites(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFavoritesResponse, error)
+	DeleteFavorite(ctx context.Context, in *DeleteFavoriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type customerServiceClient struct {
@@ -60,12 +83,125 @@ func (c *customerServiceClient) GetCustomerByID(ctx context.Context, in *GetCust
 	return out, nil
 }
 
+func (c *customerServiceClient) GetMyProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Customer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Customer)
+	err := c.cc.Invoke(ctx, CustomerService_GetMyProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UpdateMyProfile(ctx context.Context, in *UpdateMyProfileRequest, opts ...grpc.CallOption) (*Customer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Customer)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateMyProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ChangeLoginID(ctx context.Context, in *ChangeLoginIDRequest, opts ...grpc.CallOption) (*Customer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Customer)
+	err := c.cc.Invoke(ctx, CustomerService_ChangeLoginID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*Customer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Customer)
+	err := c.cc.Invoke(ctx, CustomerService_ChangeEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateTokenResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DeleteMyAccount(ctx context.Context, in *DeleteMyAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CustomerService_DeleteMyAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ExportMyData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportMyDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMyDataResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ExportMyData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) SaveFavorite(ctx context.Context, in *SaveFavoriteRequest, opts ...grpc.CallOption) (*Favorite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Favorite)
+	err := c.cc.Invoke(ctx, CustomerService_SaveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListFavorites(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFavoritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavoritesResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DeleteFavorite(ctx context.Context, in *DeleteFavoriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CustomerService_DeleteFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CustomerServiceServer is the server API for CustomerService service.
 // All implementations must embed UnimplementedCustomerServiceServer
 // for forward compatibility.
 type CustomerServiceServer interface {
 	CreateCustomer(context.Context, *CreateCustomerRequest) (*Customer, error)
 	GetCustomerByID(context.Context, *GetCustomerByIDRequest) (*Customer, error)
+	GetMyProfile(context.Context, *emptypb.Empty) (*Customer, error)
+	UpdateMyProfile(context.Context, *UpdateMyProfileRequest) (*Customer, error)
+	ChangeLoginID(context.Context, *ChangeLoginIDRequest) (*Customer, error)
+	ChangeEmail(context.Context, *ChangeEmailRequest) (*Customer, error)
+	// ChangePassword revokes every other session and returns a new token for the current one
+	ChangePassword(context.Context, *ChangePasswordRequest) (*GenerateTokenResponse, error)
+	// DeleteMyAccount anonymizes the customer, who is removed from the tabs they visited
+	DeleteMyAccount(context.Context, *DeleteMyAccountRequest) (*emptypb.Empty, error)
+	ExportMyData(context.Context, *emptypb.Empty) (*ExportMyDataResponse, error)
+	// SaveFavorite replaces the favorite of the customer with the same name, if any
+	SaveFavorite(context.Context, *SaveFavoriteRequest) (*Favorite, error)
+	ListFavorites(context.Context, *emptypb.Empty) (*ListFavoritesResponse, error)
+	DeleteFavorite(context.Context, *DeleteFavoriteRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedCustomerServiceServer()
 }
 
@@ -82,6 +218,36 @@ func (UnimplementedCustomerServiceServer) CreateCustomer(context.Context, *Creat
 func (UnimplementedCustomerServiceServer) GetCustomerByID(context.Context, *GetCustomerByIDRequest) (*Customer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerByID not implemented")
 }
+func (UnimplementedCustomerServiceServer) GetMyProfile(context.Context, *emptypb.Empty) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyProfile not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdateMyProfile(context.Context, *UpdateMyProfileRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMyProfile not implemented")
+}
+func (UnimplementedCustomerServiceServer) ChangeLoginID(context.Context, *ChangeLoginIDRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeLoginID not implemented")
+}
+func (UnimplementedCustomerServiceServer) ChangeEmail(context.Context, *ChangeEmailRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (UnimplementedCustomerServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*GenerateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedCustomerServiceServer) DeleteMyAccount(context.Context, *DeleteMyAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMyAccount not implemented")
+}
+func (UnimplementedCustomerServiceServer) ExportMyData(context.Context, *emptypb.Empty) (*ExportMyDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (UnimplementedCustomerServiceServer) SaveFavorite(context.Context, *SaveFavoriteRequest) (*Favorite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveFavorite not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListFavorites(context.Context, *emptypb.Empty) (*ListFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedCustomerServiceServer) DeleteFavorite(context.Context, *DeleteFavoriteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavorite not implemented")
+}
 func (UnimplementedCustomerServiceServer) mustEmbedUnimplementedCustomerServiceServer() {}
 func (UnimplementedCustomerServiceServer) testEmbeddedByValue()                         {}
 
@@ -139,6 +305,186 @@ func _CustomerService_GetCustomerByID_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomerService_GetMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetMyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetMyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetMyProfile(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdateMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateMyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateMyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateMyProfile(ctx, req.(*UpdateMyProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ChangeLoginID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeLoginIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ChangeLoginID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ChangeLoginID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ChangeLoginID(ctx, req.(*ChangeLoginIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ChangeEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ChangeEmail(ctx, req.(*ChangeEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DeleteMyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMyAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeleteMyAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_DeleteMyAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).DeleteMyAccount(ctx, req.(*DeleteMyAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ExportMyData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ExportMyData(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_SaveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).SaveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_SaveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).SaveFavorite(ctx, req.(*SaveFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListFavorites(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DeleteFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeleteFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_DeleteFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).DeleteFavorite(ctx, req.(*DeleteFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CustomerService_ServiceDesc is the grpc.ServiceDesc for CustomerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,13 +500,59 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCustomerByID",
 			Handler:    _CustomerService_GetCustomerByID_Handler,
 		},
+		{
+			MethodName: "GetMyProfile",
+			Handler:    _CustomerService_GetMyProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMyProfile",
+			Handler:    _CustomerService_UpdateMyProfile_Handler,
+		},
+		{
+			MethodName: "ChangeLoginID",
+			Handler:    _CustomerService_ChangeLoginID_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _CustomerService_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _CustomerService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "DeleteMyAccount",
+			Handler:    _CustomerService_DeleteMyAccount_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _CustomerService_ExportMyData_Handler,
+		},
+		{
+			MethodName: "SaveFavorite",
+			Handler:    _CustomerService_SaveFavorite_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _CustomerService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "DeleteFavorite",
+			Handler:    _CustomerService_DeleteFavorite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaurant.proto",
 }
 
 const (
-	AuthService_GenerateToken_FullMethodName = "/restaurant.AuthService/GenerateToken"
+	AuthService_GenerateToken_FullMethodName            = "/restaurant.AuthService/GenerateToken"
+	AuthService_VerifyEmail_FullMethodName              = "/restaurant.AuthService/VerifyEmail"
+	AuthService_RequestEmailVerification_FullMethodName = "/restaurant.AuthService/RequestEmailVerification"
+	AuthService_RequestPasswordReset_FullMethodName     = "/restaurant.AuthService/RequestPasswordReset"
+	AuthService_ResetPassword_FullMethodName            = "/restaurant.AuthService/ResetPassword"
+	AuthService_StartOIDCLogin_FullMethodName           = "/restaurant.AuthService/StartOIDCLogin"
+	AuthService_CompleteOIDCLogin_FullMethodName        = "/restaurant.AuthService/CompleteOIDCLogin"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -168,6 +560,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuthServiceClient interface {
 	GenerateToken(ctx context.Context, in *GenerateTokenRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RequestEmailVerification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// StartOIDCLogin returns the URL of the provider where the customer logs in
+	StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error)
+	// CompleteOIDCLogin trades the code and state the provider redirected the customer with for a token
+	CompleteOIDCLogin(ctx context.Context, in *CompleteOIDCLoginRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error)
 }
 
 type authServiceClient struct {
@@ -188,11 +588,79 @@ func (c *authServiceClient) GenerateToken(ctx context.Context, in *GenerateToken
 	return out, nil
 }
 
+func (c *authServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestEmailVerification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_RequestEmailVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_StartOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CompleteOIDCLogin(ctx context.Context, in *CompleteOIDCLoginRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_CompleteOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
 type AuthServiceServer interface {
 	GenerateToken(context.Context, *GenerateTokenRequest) (*GenerateTokenResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error)
+	RequestEmailVerification(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
+	// StartOIDCLogin returns the URL of the provider where the customer logs in
+	StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error)
+	// CompleteOIDCLogin trades the code and state the provider redirected the customer with for a token
+	CompleteOIDCLogin(context.Context, *CompleteOIDCLoginRequest) (*GenerateTokenResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -206,6 +674,24 @@ type UnimplementedAuthServiceServer struct{}
 func (UnimplementedAuthServiceServer) GenerateToken(context.Context, *GenerateTokenRequest) (*GenerateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateToken not implemented")
 }
+func (UnimplementedAuthServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestEmailVerification(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailVerification not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAuthServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOIDCLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CompleteOIDCLogin(context.Context, *CompleteOIDCLoginRequest) (*GenerateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOIDCLogin not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -245,6 +731,114 @@ func _AuthService_GenerateToken_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestEmailVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestEmailVerification(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_StartOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).StartOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_StartOIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).StartOIDCLogin(ctx, req.(*StartOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CompleteOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CompleteOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CompleteOIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CompleteOIDCLogin(ctx, req.(*CompleteOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,17 +850,50 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateToken",
 			Handler:    _AuthService_GenerateToken_Handler,
 		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _AuthService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "RequestEmailVerification",
+			Handler:    _AuthService_RequestEmailVerification_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _AuthService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "StartOIDCLogin",
+			Handler:    _AuthService_StartOIDCLogin_Handler,
+		},
+		{
+			MethodName: "CompleteOIDCLogin",
+			Handler:    _AuthService_CompleteOIDCLogin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaurant.proto",
 }
 
 const (
-	MenuService_CreateMenuItem_FullMethodName = "/restaurant.MenuService/CreateMenuItem"
-	MenuService_GetMenuItem_FullMethodName    = "/restaurant.MenuService/GetMenuItem"
-	MenuService_ListMenuItems_FullMethodName  = "/restaurant.MenuService/ListMenuItems"
-	MenuService_UpdateMenuItem_FullMethodName = "/restaurant.MenuService/UpdateMenuItem"
-	MenuService_DeleteMenuItem_FullMethodName = "/restaurant.MenuService/DeleteMenuItem"
+	MenuService_CreateMenuItem_FullMethodName                  = "/restaurant.MenuService/CreateMenuItem"
+	MenuService_GetMenuItem_FullMethodName                     = "/restaurant.MenuService/GetMenuItem"
+	MenuService_ListMenuItems_FullMethodName                   = "/restaurant.MenuService/ListMenuItems"
+	MenuService_UpdateMenuItem_FullMethodName                  = "/restaurant.MenuService/UpdateMenuItem"
+	MenuService_DeleteMenuItem_FullMethodName                  = "/restaurant.MenuService/DeleteMenuItem"
+	MenuService_UploadMenuItemPhoto_FullMethodName             = "/restaurant.MenuService/UploadMenuItemPhoto"
+	MenuService_ExportMenu_FullMethodName                      = "/restaurant.MenuService/ExportMenu"
+	MenuService_ImportMenu_FullMethodName                      = "/restaurant.MenuService/ImportMenu"
+	MenuService_SetMenuItemAvailabilityOverride_FullMethodName = "/restaurant.MenuService/SetMenuItemAvailabilityOverride"
+	MenuService_CreateMenu_FullMethodName                      = "/restaurant.MenuService/CreateMenu"
+	MenuService_GetMenu_FullMethodName                         = "/restaurant.MenuService/GetMenu"
+	MenuService_ListMenus_FullMethodName                       = "/restaurant.MenuService/ListMenus"
+	MenuService_UpdateMenu_FullMethodName                      = "/restaurant.MenuService/UpdateMenu"
+	MenuService_DeleteMenu_FullMethodName                      = "/restaurant.MenuService/DeleteMenu"
 )
 
 // MenuServiceClient is the client API for MenuService service.
@@ -278,60 +905,168 @@ type MenuServiceClient interface {
 	ListMenuItems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMenuItemsResponse, error)
 	UpdateMenuItem(ctx context.Context, in *UpdateMenuItemRequest, opts ...grpc.CallOption) (*MenuItem, error)
 	DeleteMenuItem(ctx context.Context, in *DeleteMenuItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Uploads the photo of a menu item in chunks, after a first message naming the menu item,
+	// replacing its previous photo
+	UploadMenuItemPhoto(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadMenuItemPhotoRequest, MenuItemPhoto], error)
+	// Exports the menu items not deleted with every tag and dimension, see the menufile package
+	ExportMenu(ctx context.Context, in *ExportMenuRequest, opts ...grpc.CallOption) (*ExportMenuResponse, error)
+	// Creates or updates the entries of a menu document by key, all or nothing
+	ImportMenu(ctx context.Context, in *ImportMenuRequest, opts ...grpc.CallOption) (*ImportMenuResponse, error)
+	// Forces a menu item available or unavailable regardless of its menus, or removes the override
+	SetMenuItemAvailabilityOverride(ctx context.Context, in *SetMenuItemAvailabilityOverrideRequest, opts ...grpc.CallOption) (*MenuItem, error)
+	CreateMenu(ctx context.Context, in *CreateMenuRequest, opts ...grpc.CallOption) (*Menu, error)
+	GetMenu(ctx context.Context, in *GetMenuRequest, opts ...grpc.CallOption) (*Menu, error)
+	ListMenus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMenusResponse, error)
+	// Replaces every field of a menu, including its schedules and menu items
+	UpdateMenu(ctx context.Context, in *UpdateMenuRequest, opts ...grpc.CallOption) (*Menu, error)
+	DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type menuServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewMenuServiceClient(cc grpc.ClientConnInterface) MenuServiceClient {
-	return &menuServiceClient{cc}
+func NewMenuServiceClient(cc grpc.ClientConnInterface) MenuServiceClient {
+	return &menuServiceClient{cc}
+}
+
+func (c *menuServiceClient) CreateMenuItem(ctx context.Context, in *CreateMenuItemRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItem)
+	err := c.cc.Invoke(ctx, MenuService_CreateMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) GetMenuItem(ctx context.Context, in *GetMenuItemRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItem)
+	err := c.cc.Invoke(ctx, MenuService_GetMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ListMenuItems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMenuItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMenuItemsResponse)
+	err := c.cc.Invoke(ctx, MenuService_ListMenuItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) UpdateMenuItem(ctx context.Context, in *UpdateMenuItemRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItem)
+	err := c.cc.Invoke(ctx, MenuService_UpdateMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) DeleteMenuItem(ctx context.Context, in *DeleteMenuItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MenuService_DeleteMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) UploadMenuItemPhoto(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadMenuItemPhotoRequest, MenuItemPhoto], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MenuService_ServiceDesc.Streams[0], MenuService_UploadMenuItemPhoto_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadMenuItemPhotoRequest, MenuItemPhoto]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MenuService_UploadMenuItemPhotoClient = grpc.ClientStreamingClient[UploadMenuItemPhotoRequest, MenuItemPhoto]
+
+func (c *menuServiceClient) ExportMenu(ctx context.Context, in *ExportMenuRequest, opts ...grpc.CallOption) (*ExportMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMenuResponse)
+	err := c.cc.Invoke(ctx, MenuService_ExportMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ImportMenu(ctx context.Context, in *ImportMenuRequest, opts ...grpc.CallOption) (*ImportMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMenuResponse)
+	err := c.cc.Invoke(ctx, MenuService_ImportMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) SetMenuItemAvailabilityOverride(ctx context.Context, in *SetMenuItemAvailabilityOverrideRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItem)
+	err := c.cc.Invoke(ctx, MenuService_SetMenuItemAvailabilityOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *menuServiceClient) CreateMenuItem(ctx context.Context, in *CreateMenuItemRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+func (c *menuServiceClient) CreateMenu(ctx context.Context, in *CreateMenuRequest, opts ...grpc.CallOption) (*Menu, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MenuItem)
-	err := c.cc.Invoke(ctx, MenuService_CreateMenuItem_FullMethodName, in, out, cOpts...)
+	out := new(Menu)
+	err := c.cc.Invoke(ctx, MenuService_CreateMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *menuServiceClient) GetMenuItem(ctx context.Context, in *GetMenuItemRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+func (c *menuServiceClient) GetMenu(ctx context.Context, in *GetMenuRequest, opts ...grpc.CallOption) (*Menu, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MenuItem)
-	err := c.cc.Invoke(ctx, MenuService_GetMenuItem_FullMethodName, in, out, cOpts...)
+	out := new(Menu)
+	err := c.cc.Invoke(ctx, MenuService_GetMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *menuServiceClient) ListMenuItems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMenuItemsResponse, error) {
+func (c *menuServiceClient) ListMenus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMenusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListMenuItemsResponse)
-	err := c.cc.Invoke(ctx, MenuService_ListMenuItems_FullMethodName, in, out, cOpts...)
+	out := new(ListMenusResponse)
+	err := c.cc.Invoke(ctx, MenuService_ListMenus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *menuServiceClient) UpdateMenuItem(ctx context.Context, in *UpdateMenuItemRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+func (c *menuServiceClient) UpdateMenu(ctx context.Context, in *UpdateMenuRequest, opts ...grpc.CallOption) (*Menu, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MenuItem)
-	err := c.cc.Invoke(ctx, MenuService_UpdateMenuItem_FullMethodName, in, out, cOpts...)
+	out := new(Menu)
+	err := c.cc.Invoke(ctx, MenuService_UpdateMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *menuServiceClient) DeleteMenuItem(ctx context.Context, in *DeleteMenuItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *menuServiceClient) DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, MenuService_DeleteMenuItem_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, MenuService_DeleteMenu_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -347,6 +1082,21 @@ type MenuServiceServer interface {
 	ListMenuItems(context.Context, *emptypb.Empty) (*ListMenuItemsResponse, error)
 	UpdateMenuItem(context.Context, *UpdateMenuItemRequest) (*MenuItem, error)
 	DeleteMenuItem(context.Context, *DeleteMenuItemRequest) (*emptypb.Empty, error)
+	// Uploads the photo of a menu item in chunks, after a first message naming the menu item,
+	// replacing its previous photo
+	UploadMenuItemPhoto(grpc.ClientStreamingServer[UploadMenuItemPhotoRequest, MenuItemPhoto]) error
+	// Exports the menu items not deleted with every tag and dimension, see the menufile package
+	ExportMenu(context.Context, *ExportMenuRequest) (*ExportMenuResponse, error)
+	// Creates or updates the entries of a menu document by key, all or nothing
+	ImportMenu(context.Context, *ImportMenuRequest) (*ImportMenuResponse, error)
+	// Forces a menu item available or unavailable regardless of its menus, or removes the override
+	SetMenuItemAvailabilityOverride(context.Context, *SetMenuItemAvailabilityOverrideRequest) (*MenuItem, error)
+	CreateMenu(context.Context, *CreateMenuRequest) (*Menu, error)
+	GetMenu(context.Context, *GetMenuRequest) (*Menu, error)
+	ListMenus(context.Context, *emptypb.Empty) (*ListMenusResponse, error)
+	// Replaces every field of a menu, including its schedules and menu items
+	UpdateMenu(context.Context, *UpdateMenuRequest) (*Menu, error)
+	DeleteMenu(context.Context, *DeleteMenuRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMenuServiceServer()
 }
 
@@ -372,6 +1122,33 @@ func (UnimplementedMenuServiceServer) UpdateMenuItem(context.Context, *UpdateMen
 func (UnimplementedMenuServiceServer) DeleteMenuItem(context.Context, *DeleteMenuItemRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenuItem not implemented")
 }
+func (UnimplementedMenuServiceServer) UploadMenuItemPhoto(grpc.ClientStreamingServer[UploadMenuItemPhotoRequest, MenuItemPhoto]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMenuItemPhoto not implemented")
+}
+func (UnimplementedMenuServiceServer) ExportMenu(context.Context, *ExportMenuRequest) (*ExportMenuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMenu not implemented")
+}
+func (UnimplementedMenuServiceServer) ImportMenu(context.Context, *ImportMenuRequest) (*ImportMenuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMenu not implemented")
+}
+func (UnimplementedMenuServiceServer) SetMenuItemAvailabilityOverride(context.Context, *SetMenuItemAvailabilityOverrideRequest) (*MenuItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMenuItemAvailabilityOverride not implemented")
+}
+func (UnimplementedMenuServiceServer) CreateMenu(context.Context, *CreateMenuRequest) (*Menu, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMenu not implemented")
+}
+func (UnimplementedMenuServiceServer) GetMenu(context.Context, *GetMenuRequest) (*Menu, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenu not implemented")
+}
+func (UnimplementedMenuServiceServer) ListMenus(context.Context, *emptypb.Empty) (*ListMenusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMenus not implemented")
+}
+func (UnimplementedMenuServiceServer) UpdateMenu(context.Context, *UpdateMenuRequest) (*Menu, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMenu not implemented")
+}
+func (UnimplementedMenuServiceServer) DeleteMenu(context.Context, *DeleteMenuRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenu not implemented")
+}
 func (UnimplementedMenuServiceServer) mustEmbedUnimplementedMenuServiceServer() {}
 func (UnimplementedMenuServiceServer) testEmbeddedByValue()                     {}
 
@@ -483,6 +1260,157 @@ func _MenuService_DeleteMenuItem_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MenuService_UploadMenuItemPhoto_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MenuServiceServer).UploadMenuItemPhoto(&grpc.GenericServerStream[UploadMenuItemPhotoRequest, MenuItemPhoto]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MenuService_UploadMenuItemPhotoServer = grpc.ClientStreamingServer[UploadMenuItemPhotoRequest, MenuItemPhoto]
+
+func _MenuService_ExportMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ExportMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ExportMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ExportMenu(ctx, req.(*ExportMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_ImportMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ImportMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ImportMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ImportMenu(ctx, req.(*ImportMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_SetMenuItemAvailabilityOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuItemAvailabilityOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).SetMenuItemAvailabilityOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_SetMenuItemAvailabilityOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).SetMenuItemAvailabilityOverride(ctx, req.(*SetMenuItemAvailabilityOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_CreateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).CreateMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_CreateMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).CreateMenu(ctx, req.(*CreateMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenu(ctx, req.(*GetMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_ListMenus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ListMenus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ListMenus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ListMenus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_UpdateMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).UpdateMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_UpdateMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).UpdateMenu(ctx, req.(*UpdateMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_DeleteMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).DeleteMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_DeleteMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).DeleteMenu(ctx, req.(*DeleteMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MenuService_ServiceDesc is the grpc.ServiceDesc for MenuService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -510,8 +1438,46 @@ var MenuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMenuItem",
 			Handler:    _MenuService_DeleteMenuItem_Handler,
 		},
+		{
+			MethodName: "ExportMenu",
+			Handler:    _MenuService_ExportMenu_Handler,
+		},
+		{
+			MethodName: "ImportMenu",
+			Handler:    _MenuService_ImportMenu_Handler,
+		},
+		{
+			MethodName: "SetMenuItemAvailabilityOverride",
+			Handler:    _MenuService_SetMenuItemAvailabilityOverride_Handler,
+		},
+		{
+			MethodName: "CreateMenu",
+			Handler:    _MenuService_CreateMenu_Handler,
+		},
+		{
+			MethodName: "GetMenu",
+			Handler:    _MenuService_GetMenu_Handler,
+		},
+		{
+			MethodName: "ListMenus",
+			Handler:    _MenuService_ListMenus_Handler,
+		},
+		{
+			MethodName: "UpdateMenu",
+			Handler:    _MenuService_UpdateMenu_Handler,
+		},
+		{
+			MethodName: "DeleteMenu",
+			Handler:    _MenuService_DeleteMenu_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadMenuItemPhoto",
+			Handler:       _MenuService_UploadMenuItemPhoto_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaurant.proto",
 }
 
@@ -525,6 +1491,8 @@ const (
 	OrderService_AddOrderItemCustomerOwner_FullMethodName    = "/restaurant.OrderService/AddOrderItemCustomerOwner"
 	OrderService_RemoveOrderItemCustomerOwner_FullMethodName = "/restaurant.OrderService/RemoveOrderItemCustomerOwner"
 	OrderService_SendOrder_FullMethodName                    = "/restaurant.OrderService/SendOrder"
+	OrderService_Reorder_FullMethodName                      = "/restaurant.OrderService/Reorder"
+	OrderService_AddFavoriteToOrder_FullMethodName           = "/restaurant.OrderService/AddFavoriteToOrder"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -540,6 +1508,10 @@ type OrderServiceClient interface {
 	AddOrderItemCustomerOwner(ctx context.Context, in *AddOrderItemCustomerOwnerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveOrderItemCustomerOwner(ctx context.Context, in *RemoveOrderItemCustomerOwnerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SendOrder(ctx context.Context, in *SendOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Clones the sent items of a past order or tab of the customer into an order not sent yet
+	Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*ReorderResponse, error)
+	// Creates an item of a favorite of the customer, which must not be outdated
+	AddFavoriteToOrder(ctx context.Context, in *AddFavoriteToOrderRequest, opts ...grpc.CallOption) (*OrderItemID, error)
 }
 
 type orderServiceClient struct {
@@ -640,6 +1612,26 @@ func (c *orderServiceClient) SendOrder(ctx context.Context, in *SendOrderRequest
 	return out, nil
 }
 
+func (c *orderServiceClient) Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*ReorderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderResponse)
+	err := c.cc.Invoke(ctx, OrderService_Reorder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) AddFavoriteToOrder(ctx context.Context, in *AddFavoriteToOrderRequest, opts ...grpc.CallOption) (*OrderItemID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderItemID)
+	err := c.cc.Invoke(ctx, OrderService_AddFavoriteToOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -653,6 +1645,10 @@ type OrderServiceServer interface {
 	AddOrderItemCustomerOwner(context.Context, *AddOrderItemCustomerOwnerRequest) (*emptypb.Empty, error)
 	RemoveOrderItemCustomerOwner(context.Context, *RemoveOrderItemCustomerOwnerRequest) (*emptypb.Empty, error)
 	SendOrder(context.Context, *SendOrderRequest) (*emptypb.Empty, error)
+	// Clones the sent items of a past order or tab of the customer into an order not sent yet
+	Reorder(context.Context, *ReorderRequest) (*ReorderResponse, error)
+	// Creates an item of a favorite of the customer, which must not be outdated
+	AddFavoriteToOrder(context.Context, *AddFavoriteToOrderRequest) (*OrderItemID, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -690,6 +1686,12 @@ func (UnimplementedOrderServiceServer) RemoveOrderItemCustomerOwner(context.Cont
 func (UnimplementedOrderServiceServer) SendOrder(context.Context, *SendOrderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) Reorder(context.Context, *ReorderRequest) (*ReorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reorder not implemented")
+}
+func (UnimplementedOrderServiceServer) AddFavoriteToOrder(context.Context, *AddFavoriteToOrderRequest) (*OrderItemID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavoriteToOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -873,6 +1875,42 @@ func _OrderService_SendOrder_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_Reorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Reorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_Reorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Reorder(ctx, req.(*ReorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_AddFavoriteToOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteToOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).AddFavoriteToOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_AddFavoriteToOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).AddFavoriteToOrder(ctx, req.(*AddFavoriteToOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -916,6 +1954,14 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendOrder",
 			Handler:    _OrderService_SendOrder_Handler,
 		},
+		{
+			MethodName: "Reorder",
+			Handler:    _OrderService_Reorder_Handler,
+		},
+		{
+			MethodName: "AddFavoriteToOrder",
+			Handler:    _OrderService_AddFavoriteToOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaurant.proto",
@@ -926,9 +1972,11 @@ const (
 	TabService_VisitTab_FullMethodName        = "/restaurant.TabService/VisitTab"
 	TabService_CreateGuest_FullMethodName     = "/restaurant.TabService/CreateGuest"
 	TabService_UpdateGuestName_FullMethodName = "/restaurant.TabService/UpdateGuestName"
+	TabService_ClaimGuest_FullMethodName      = "/restaurant.TabService/ClaimGuest"
 	TabService_GetOpenTab_FullMethodName      = "/restaurant.TabService/GetOpenTab"
 	TabService_CloseTab_FullMethodName        = "/restaurant.TabService/CloseTab"
 	TabService_GetVisitedTabs_FullMethodName  = "/restaurant.TabService/GetVisitedTabs"
+	TabService_GetSpendSummary_FullMethodName = "/restaurant.TabService/GetSpendSummary"
 )
 
 // TabServiceClient is the client API for TabService service.
@@ -937,11 +1985,16 @@ const (
 type TabServiceClient interface {
 	CreateTab(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TabID, error)
 	VisitTab(ctx context.Context, in *VisitTabRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	CreateGuest(ctx context.Context, in *CreateGuestRequest, opts ...grpc.CallOption) (*GuestID, error)
+	// CreateGuest returns the ID of the new guest and the token claiming it, kept by the device of the guest
+	CreateGuest(ctx context.Context, in *CreateGuestRequest, opts ...grpc.CallOption) (*CreateGuestResponse, error)
 	UpdateGuestName(ctx context.Context, in *UpdateGuestNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ClaimGuest hands the order items and the name of a guest over to the authenticated customer,
+	// given the claim token of the guest
+	ClaimGuest(ctx context.Context, in *ClaimGuestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetOpenTab(ctx context.Context, in *GetOpenTabRequest, opts ...grpc.CallOption) (*Tab, error)
 	CloseTab(ctx context.Context, in *CloseTabRequest, opts ...grpc.CallOption) (*CloseTabResponse, error)
 	GetVisitedTabs(ctx context.Context, in *GetVisitedTabsRequest, opts ...grpc.CallOption) (*GetVisitedTabsResponse, error)
+	GetSpendSummary(ctx context.Context, in *GetSpendSummaryRequest, opts ...grpc.CallOption) (*SpendSummary, error)
 }
 
 type tabServiceClient struct {
@@ -972,9 +2025,9 @@ func (c *tabServiceClient) VisitTab(ctx context.Context, in *VisitTabRequest, op
 	return out, nil
 }
 
-func (c *tabServiceClient) CreateGuest(ctx context.Context, in *CreateGuestRequest, opts ...grpc.CallOption) (*GuestID, error) {
+func (c *tabServiceClient) CreateGuest(ctx context.Context, in *CreateGuestRequest, opts ...grpc.CallOption) (*CreateGuestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GuestID)
+	out := new(CreateGuestResponse)
 	err := c.cc.Invoke(ctx, TabService_CreateGuest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -992,6 +2045,16 @@ func (c *tabServiceClient) UpdateGuestName(ctx context.Context, in *UpdateGuestN
 	return out, nil
 }
 
+func (c *tabServiceClient) ClaimGuest(ctx context.Context, in *ClaimGuestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TabService_ClaimGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabServiceClient) GetOpenTab(ctx context.Context, in *GetOpenTabRequest, opts ...grpc.CallOption) (*Tab, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Tab)
@@ -1022,17 +2085,32 @@ func (c *tabServiceClient) GetVisitedTabs(ctx context.Context, in *GetVisitedTab
 	return out, nil
 }
 
+func (c *tabServiceClient) GetSpendSummary(ctx context.Context, in *GetSpendSummaryRequest, opts ...grpc.CallOption) (*SpendSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendSummary)
+	err := c.cc.Invoke(ctx, TabService_GetSpendSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabServiceServer is the server API for TabService service.
 // All implementations must embed UnimplementedTabServiceServer
 // for forward compatibility.
 type TabServiceServer interface {
 	CreateTab(context.Context, *emptypb.Empty) (*TabID, error)
 	VisitTab(context.Context, *VisitTabRequest) (*emptypb.Empty, error)
-	CreateGuest(context.Context, *CreateGuestRequest) (*GuestID, error)
+	// CreateGuest returns the ID of the new guest and the token claiming it, kept by the device of the guest
+	CreateGuest(context.Context, *CreateGuestRequest) (*CreateGuestResponse, error)
 	UpdateGuestName(context.Context, *UpdateGuestNameRequest) (*emptypb.Empty, error)
+	// ClaimGuest hands the order items and the name of a guest over to the authenticated customer,
+	// given the claim token of the guest
+	ClaimGuest(context.Context, *ClaimGuestRequest) (*emptypb.Empty, error)
 	GetOpenTab(context.Context, *GetOpenTabRequest) (*Tab, error)
 	CloseTab(context.Context, *CloseTabRequest) (*CloseTabResponse, error)
 	GetVisitedTabs(context.Context, *GetVisitedTabsRequest) (*GetVisitedTabsResponse, error)
+	GetSpendSummary(context.Context, *GetSpendSummaryRequest) (*SpendSummary, error)
 	mustEmbedUnimplementedTabServiceServer()
 }
 
@@ -1049,12 +2127,15 @@ func (UnimplementedTabServiceServer) CreateTab(context.Context, *emptypb.Empty)
 func (UnimplementedTabServiceServer) VisitTab(context.Context, *VisitTabRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VisitTab not implemented")
 }
-func (UnimplementedTabServiceServer) CreateGuest(context.Context, *CreateGuestRequest) (*GuestID, error) {
+func (UnimplementedTabServiceServer) CreateGuest(context.Context, *CreateGuestRequest) (*CreateGuestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGuest not implemented")
 }
 func (UnimplementedTabServiceServer) UpdateGuestName(context.Context, *UpdateGuestNameRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuestName not implemented")
 }
+func (UnimplementedTabServiceServer) ClaimGuest(context.Context, *ClaimGuestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimGuest not implemented")
+}
 func (UnimplementedTabServiceServer) GetOpenTab(context.Context, *GetOpenTabRequest) (*Tab, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOpenTab not implemented")
 }
@@ -1064,6 +2145,9 @@ func (UnimplementedTabServiceServer) CloseTab(context.Context, *CloseTabRequest)
 func (UnimplementedTabServiceServer) GetVisitedTabs(context.Context, *GetVisitedTabsRequest) (*GetVisitedTabsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVisitedTabs not implemented")
 }
+func (UnimplementedTabServiceServer) GetSpendSummary(context.Context, *GetSpendSummaryRequest) (*SpendSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendSummary not implemented")
+}
 func (UnimplementedTabServiceServer) mustEmbedUnimplementedTabServiceServer() {}
 func (UnimplementedTabServiceServer) testEmbeddedByValue()                    {}
 
@@ -1157,6 +2241,24 @@ func _TabService_UpdateGuestName_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabService_ClaimGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabServiceServer).ClaimGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TabService_ClaimGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabServiceServer).ClaimGuest(ctx, req.(*ClaimGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabService_GetOpenTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOpenTabRequest)
 	if err := dec(in); err != nil {
@@ -1211,6 +2313,24 @@ func _TabService_GetVisitedTabs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabService_GetSpendSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabServiceServer).GetSpendSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TabService_GetSpendSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabServiceServer).GetSpendSummary(ctx, req.(*GetSpendSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TabService_ServiceDesc is the grpc.ServiceDesc for TabService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1234,6 +2354,10 @@ var TabService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGuestName",
 			Handler:    _TabService_UpdateGuestName_Handler,
 		},
+		{
+			MethodName: "ClaimGuest",
+			Handler:    _TabService_ClaimGuest_Handler,
+		},
 		{
 			MethodName: "GetOpenTab",
 			Handler:    _TabService_GetOpenTab_Handler,
@@ -1246,6 +2370,10 @@ var TabService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVisitedTabs",
 			Handler:    _TabService_GetVisitedTabs_Handler,
 		},
+		{
+			MethodName: "GetSpendSummary",
+			Handler:    _TabService_GetSpendSummary_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaurant.proto",
@@ -2,40 +2,56 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"restaurant-ordering-system/internal/pkg/auth"
 	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/datastore"
+	"restaurant-ordering-system/internal/pkg/menufile"
+	"restaurant-ordering-system/internal/pkg/repository/cache"
+	"restaurant-ordering-system/internal/pkg/service"
+	"restaurant-ordering-system/internal/pkg/storage"
 
 	"github.com/jackc/pgx/v5"
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: cli [migrate|seed]")
+	configPath := flag.String("config", "configs/config.json", "path to the configuration file, empty to use only the defaults and the environment")
+	tokenTTL := flag.Duration("ttl", time.Hour, "lifetime of the tokens issued by admin-token")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		fmt.Println("Usage: cli [--config path] [--ttl duration] [migrate|seed|migrate-cache-keys|admin-token|menu import|menu export]")
 		os.Exit(1)
 	}
 
-	cmd := os.Args[1]
+	cmd := flag.Arg(0)
 
 	// Load configuration
-	cfg, err := config.LoadConfig("configs/config.json")
+	cfg, err := config.LoadConfig(*configPath)
 	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
 		os.Exit(1)
 	}
 
-	dsn := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Database.Host,
-		cfg.Database.Port,
-		cfg.Database.User,
-		cfg.Database.Password,
-		cfg.Database.Database,
-		cfg.Database.SSLMode,
-	)
-	conn, err := pgx.Connect(context.Background(), dsn)
+	switch cmd {
+	case "migrate-cache-keys":
+		doMigrateCacheKeys(cfg.Redis)
+		return
+	case "admin-token":
+		doAdminToken(cfg.JWT, *tokenTTL)
+		return
+	case "menu":
+		doMenu(cfg, flag.Args()[1:])
+		return
+	}
+
+	conn, err := datastore.ConnectPostgres(context.Background(), cfg.Database)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to connect to database: %v\n", err)
 		os.Exit(1)
@@ -101,3 +117,127 @@ func doSeed(db *pgx.Conn) {
 	}
 	fmt.Println("Seeding complete.")
 }
+
+func doMigrateCacheKeys(cfg config.RedisConfig) {
+	rdb, err := datastore.NewRedisClient(cfg)
+	if err != nil {
+		fmt.Printf("Failed to create redis client: %v\n", err)
+		os.Exit(1)
+	}
+	defer rdb.Close()
+
+	migrated, err := cache.MigrateLegacyKeys(context.Background(), rdb)
+	if err != nil {
+		fmt.Printf("Cache key migration failed after %d keys: %v\n", migrated, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Cache key migration complete, %d keys migrated.\n", migrated)
+}
+
+func doAdminToken(cfg config.JWTConfig, ttl time.Duration) {
+	keyring, err := auth.LoadKeyring(cfg)
+	if err != nil {
+		fmt.Printf("Failed to load JWT keys: %v\n", err)
+		os.Exit(1)
+	}
+	token, err := auth.NewAdminJWTGenerator(keyring, ttl)()
+	if err != nil {
+		fmt.Printf("Failed to sign admin token: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(token)
+}
+
+// doMenu runs menu import [--dry-run] [--format json|csv] file|- and
+// menu export [--format json|csv] [file|-], the format defaulting to the extension of the file
+func doMenu(cfg *config.Config, args []string) {
+	if len(args) < 1 || (args[0] != "import" && args[0] != "export") {
+		fmt.Println("Usage: cli menu import [--dry-run] [--format json|csv] file|-, cli menu export [--format json|csv] [file|-]")
+		os.Exit(1)
+	}
+	fs := flag.NewFlagSet("menu "+args[0], flag.ExitOnError)
+	format := fs.String("format", "", "json or csv, by default from the extension of the file")
+	dryRun := fs.Bool("dry-run", false, "print the changes of the import without applying them")
+	fs.Parse(args[1:])
+	path := fs.Arg(0)
+	if path == "" {
+		if args[0] == "import" {
+			fmt.Println("Usage: cli menu import [--dry-run] [--format json|csv] file|-")
+			os.Exit(1)
+		}
+		path = "-"
+	}
+	if *format == "" {
+		*format = menufile.FormatFromPath(path)
+	}
+
+	ctx := context.Background()
+	pool, err := datastore.NewPostgresPool(ctx, cfg.Database)
+	if err != nil {
+		fmt.Printf("Unable to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+	defer pool.Close()
+	store, err := storage.New(cfg.Storage)
+	if err != nil {
+		fmt.Printf("Failed to create storage: %v\n", err)
+		os.Exit(1)
+	}
+	menuService := service.NewMenuService(pool, store, cfg.Photos)
+
+	if args[0] == "export" {
+		doc, err := menuService.ExportMenu(ctx)
+		if err != nil {
+			fmt.Printf("Menu export failed: %v\n", err)
+			os.Exit(1)
+		}
+		out := io.Writer(os.Stdout)
+		if path != "-" {
+			f, err := os.Create(path)
+			if err != nil {
+				fmt.Printf("Failed to create %s: %v\n", path, err)
+				os.Exit(1)
+			}
+			defer f.Close()
+			out = f
+		}
+		if err := menufile.Encode(out, *format, doc); err != nil {
+			fmt.Printf("Menu export failed: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	in := io.Reader(os.Stdin)
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Printf("Failed to open %s: %v\n", path, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		in = f
+	}
+	doc, err := menufile.Decode(in, *format)
+	if err != nil {
+		fmt.Printf("Failed to read %s: %v\n", path, err)
+		os.Exit(1)
+	}
+	result, err := menuService.ImportMenu(ctx, doc, *dryRun)
+	if err != nil {
+		fmt.Printf("Menu import failed: %v\n", err)
+		os.Exit(1)
+	}
+	for _, change := range result.Changes {
+		fmt.Printf("%s %s %s", change.Action, change.Kind, change.Key)
+		if len(change.Fields) > 0 {
+			fmt.Printf(" (%s)", strings.Join(change.Fields, ", "))
+		}
+		fmt.Println()
+	}
+	if result.Applied {
+		fmt.Printf("Menu import complete, %d changed, %d unchanged.\n", len(result.Changes), result.Unchanged)
+	} else {
+		fmt.Printf("Dry run, %d would change, %d unchanged.\n", len(result.Changes), result.Unchanged)
+	}
+}
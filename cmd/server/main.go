@@ -3,101 +3,220 @@ package main
 
 import (
 	"context"
-	"crypto/tls"
+	"errors"
+	"flag"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
-	"strconv"
 	"syscall"
+	"time"
+	// Menu schedules are evaluated in their timezone, whatever the image provides
+	_ "time/tzdata"
 
-	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	"restaurant-ordering-system/api/proto"
+	"restaurant-ordering-system/internal/app/gateway"
 	grpcapp "restaurant-ordering-system/internal/app/grpc"
 	"restaurant-ordering-system/internal/pkg/auth"
+	"restaurant-ordering-system/internal/pkg/breaker"
 	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/datastore"
+	"restaurant-ordering-system/internal/pkg/health"
+	"restaurant-ordering-system/internal/pkg/mailer"
 	"restaurant-ordering-system/internal/pkg/middleware"
+	"restaurant-ordering-system/internal/pkg/oidc"
+	"restaurant-ordering-system/internal/pkg/repository/cache"
 	"restaurant-ordering-system/internal/pkg/service"
+	"restaurant-ordering-system/internal/pkg/storage"
+	"restaurant-ordering-system/internal/pkg/telemetry"
+	"restaurant-ordering-system/internal/pkg/tlsconfig"
 )
 
 func main() {
 	// Initialize logger
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	slog.SetDefault(logger) // Used by the services for their best effort work
+
+	configPath := flag.String("config", "configs/config.json", "path to the configuration file, empty to use only the defaults and the environment")
+	flag.Parse()
 
 	// Load configuration
-	cfg, err := config.LoadConfig("configs/config.json")
+	cfg, err := config.LoadConfig(*configPath)
 	if err != nil {
 		logger.Error("Failed to load config", "error", err)
 		os.Exit(1)
 	}
 
-	cert, err := tls.LoadX509KeyPair("certs/server_cert.pem", "certs/server_key.pem")
+	// Initialize metrics
+	shutdownMetrics, err := telemetry.SetupMetrics(context.Background(), cfg.Telemetry.Metrics)
+	if err != nil {
+		logger.Error("Failed to setup metrics", "error", err)
+		os.Exit(1)
+	}
+	defer shutdownMetrics(context.Background())
+
+	tlsConfig, certReloader, err := tlsconfig.NewServerConfig(cfg.Server.TLS)
 	if err != nil {
-		logger.Error("Failed to load key pair", "error", err)
+		logger.Error("Failed to load TLS configuration", "error", err)
 		os.Exit(1)
 	}
+	if certReloader != nil && cfg.Server.TLS.ReloadInterval > 0 {
+		reloadCtx, stopReloader := context.WithCancel(context.Background())
+		defer stopReloader()
+		go certReloader.Run(reloadCtx, cfg.Server.TLS.ReloadInterval, logger)
+	}
 
 	// Connect to database
-	dsn := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Database.Host,
-		cfg.Database.Port,
-		cfg.Database.User,
-		cfg.Database.Password,
-		cfg.Database.Database,
-		cfg.Database.SSLMode,
-	)
-	dbpool, err := pgxpool.New(context.Background(), dsn)
+	dbpool, err := datastore.NewPostgresPool(context.Background(), cfg.Database)
 	if err != nil {
 		logger.Error("Failed to create dbpool", "error", err)
 		os.Exit(1)
 	}
 	defer dbpool.Close()
 
-	rdb := redis.NewClient(&redis.Options{
-		Addr: cfg.Redis.Host + ":" + strconv.Itoa(cfg.Redis.Port),
-	})
+	rdb, err := datastore.NewRedisClient(cfg.Redis)
+	if err != nil {
+		logger.Error("Failed to create redis client", "error", err)
+		os.Exit(1)
+	}
+	defer rdb.Close()
+	if cfg.Redis.Breaker.FailureThreshold > 0 {
+		redisBreaker := breaker.New(cfg.Redis.Breaker.FailureThreshold, cfg.Redis.Breaker.OpenTimeout)
+		redisBreaker.OnStateChange(func(from, to breaker.State) {
+			logger.Warn("Redis circuit breaker state changed", "from", from, "to", to)
+		})
+		rdb.AddHook(cache.NewBreakerHook(redisBreaker))
+	}
+	// Tab event followers block on their own pool, so that they never starve the other commands
+	eventsRdb, err := datastore.NewRedisEventsClient(cfg.Redis)
+	if err != nil {
+		logger.Error("Failed to create redis events client", "error", err)
+		os.Exit(1)
+	}
+	defer eventsRdb.Close()
+	if err := cache.LoadScripts(context.Background(), rdb); err != nil {
+		// Scripts are loaded again on demand once Redis is reachable
+		logger.Warn("Failed to load redis scripts", "error", err)
+	}
+
+	// Initialize JWT keyring and generator
+	keyring, err := auth.LoadKeyring(cfg.JWT)
+	if err != nil {
+		logger.Error("Failed to load JWT keys", "error", err)
+		os.Exit(1)
+	}
+	jwtGenerator := auth.NewCustomerJWTGenerator(keyring, cfg.JWT.Expiry)
+
+	// Initialize mailer
+	mail, err := mailer.New(cfg.Mailer, logger)
+	if err != nil {
+		logger.Error("Failed to initialize mailer", "error", err)
+		os.Exit(1)
+	}
 
-	// Initialize JWT generator
-	jwtGenerator := auth.NewCustomerJWTGenerator([]byte(cfg.JWT.Secret), cfg.JWT.Expiry)
+	// Initialize OpenID Connect providers, discovered on first use
+	oidcProviders := oidc.NewProviders(cfg.Account.OIDC, &http.Client{Timeout: 10 * time.Second})
+
+	// Initialize the storage of uploaded files
+	store, err := storage.New(cfg.Storage)
+	if err != nil {
+		logger.Error("Failed to initialize storage", "error", err)
+		os.Exit(1)
+	}
 
 	// Initialize services
-	customerService := service.NewCustomerService(dbpool)
-	authService := service.NewAuthService(dbpool, jwtGenerator)
-	menuService := service.NewMenuService(dbpool)
 	cacheService := service.NewCacheService(dbpool, rdb)
-	orderService := service.NewOrderService(dbpool, rdb, cacheService)
-	tabService := service.NewTabService(dbpool, rdb, cacheService)
+	authService := service.NewAuthService(dbpool, rdb, jwtGenerator, cfg.JWT.Expiry, mail, cfg.Account, oidcProviders)
+	customerService := service.NewCustomerService(dbpool, rdb, cacheService, authService)
+	menuService := service.NewMenuService(dbpool, store, cfg.Photos)
+	orderService := service.NewOrderService(dbpool, rdb, cacheService, cfg.Limits.Tab)
+	tabService := service.NewTabService(dbpool, rdb, eventsRdb, cacheService, cfg.Limits.Tab)
 
 	// Initialize JWT parser
-	jwtParser := auth.NewJWTParser([]byte(cfg.JWT.Secret))
+	jwtParser := auth.NewJWTParser(keyring)
 
 	// Initialize gRPC server
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			middleware.NewJWTUnaryInterceptor(jwtParser),
-			middleware.UnaryServerInterceptor(logger),
+	// Calls are logged even when they are rate limited or fail to authenticate
+	takeRateLimitToken := cache.New(rdb).TakeRateLimitToken
+	trustedProxies := cfg.Server.TrustedProxyPrefixes()
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.NewClientIPUnaryInterceptor(trustedProxies),
+		middleware.UnaryServerInterceptor(logger),
+		middleware.NewRateLimitUnaryInterceptor(takeRateLimitToken, cfg.Limits.RateLimit),
+		middleware.NewJWTUnaryInterceptor(jwtParser, authService.IsTokenRevoked),
+	}
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(
+			middleware.NewClientIPStreamInterceptor(trustedProxies),
+			middleware.StreamServerInterceptor(logger),
+			middleware.NewRateLimitStreamInterceptor(takeRateLimitToken, cfg.Limits.RateLimit),
+			middleware.NewJWTStreamInterceptor(jwtParser, authService.IsTokenRevoked),
 		),
-		grpc.StreamInterceptor(middleware.StreamServerInterceptor(logger)),
-		grpc.Creds(credentials.NewServerTLSFromCert(&cert)),
-	)
+	}
+	if tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
+
+	// Initialize HTTP/JSON and gRPC-Web gateway
+	gw := gateway.New(cfg.Server.Gateway.CORS, interceptors...)
+	// Event streams are not calls, they are limited like the interceptors limit calls
+	const tabEventsPattern = "GET /tabs/{id}/events"
+	gw.Handle(tabEventsPattern, middleware.NewClientIPHandler(trustedProxies, gateway.Guard(
+		middleware.NewRateLimitHTTPChecker(takeRateLimitToken, cfg.Limits.RateLimit, tabEventsPattern),
+		gateway.NewTabEventsHandler(tabService, cfg.Server.Gateway.EventsHeartbeat),
+	)))
+	gw.Handle("GET /.well-known/jwks.json", gateway.NewJWKSHandler(keyring))
+	if local, ok := store.(*storage.LocalStorage); ok {
+		gw.Handle("GET /media/", http.StripPrefix("/media/", gateway.NewMediaHandler(local.Dir())))
+	}
 
 	// Register services
+	registrars := []grpc.ServiceRegistrar{grpcServer}
+	if cfg.Server.Gateway.Enabled {
+		registrars = append(registrars, gw)
+	}
 	grpcappCustomerService := grpcapp.NewCustomerServiceServer(customerService)
-	proto.RegisterCustomerServiceServer(grpcServer, grpcappCustomerService)
 	grpcappAuthService := grpcapp.NewAuthServiceServer(authService)
-	proto.RegisterAuthServiceServer(grpcServer, grpcappAuthService)
 	grpcappMenuService := grpcapp.NewMenuServiceServer(menuService)
-	proto.RegisterMenuServiceServer(grpcServer, grpcappMenuService)
 	grpcappOrderService := grpcapp.NewOrderServiceServer(orderService)
-	proto.RegisterOrderServiceServer(grpcServer, grpcappOrderService)
 	grpcappTabService := grpcapp.NewTabServiceServer(tabService)
-	proto.RegisterTabServiceServer(grpcServer, grpcappTabService)
+	healthServer := grpchealth.NewServer()
+	for _, r := range registrars {
+		proto.RegisterCustomerServiceServer(r, grpcappCustomerService)
+		proto.RegisterAuthServiceServer(r, grpcappAuthService)
+		proto.RegisterMenuServiceServer(r, grpcappMenuService)
+		proto.RegisterOrderServiceServer(r, grpcappOrderService)
+		proto.RegisterTabServiceServer(r, grpcappTabService)
+		healthpb.RegisterHealthServer(r, healthServer)
+	}
+	if cfg.Server.Reflection {
+		reflection.Register(grpcServer)
+	}
+
+	// Track the health of the dependencies
+	healthChecker := health.NewChecker(healthServer, logger, cfg.Server.HealthCheck.Interval, cfg.Server.HealthCheck.Timeout)
+	healthChecker.AddDependency("postgres", dbpool.Ping)
+	healthChecker.AddDependency("redis", func(ctx context.Context) error {
+		return rdb.Ping(ctx).Err()
+	})
+	healthChecker.AddService("", "postgres")
+	healthChecker.AddService(proto.CustomerService_ServiceDesc.ServiceName, "postgres")
+	healthChecker.AddService(proto.AuthService_ServiceDesc.ServiceName, "postgres")
+	healthChecker.AddService(proto.MenuService_ServiceDesc.ServiceName, "postgres")
+	healthChecker.AddService(proto.OrderService_ServiceDesc.ServiceName, "postgres", "redis")
+	healthChecker.AddService(proto.TabService_ServiceDesc.ServiceName, "postgres", "redis")
+	healthCtx, stopHealthChecker := context.WithCancel(context.Background())
+	go healthChecker.Run(healthCtx)
 
 	// Start server
 	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
@@ -108,21 +227,75 @@ func main() {
 		os.Exit(1)
 	}
 
-	go func() {
-		logger.Info("Starting gRPC server", "address", addr)
-		if err := grpcServer.Serve(lis); err != nil {
-			logger.Error("Failed to serve", "error", err)
-			dbpool.Close()
-			os.Exit(1)
+	var httpServer *http.Server
+	if cfg.Server.Gateway.Enabled {
+		httpServer = &http.Server{
+			Handler:   gw,
+			TLSConfig: tlsConfig,
+			ErrorLog:  slog.NewLogLogger(logger.Handler(), slog.LevelWarn),
+		}
+		if tlsConfig == nil {
+			// Native gRPC requires HTTP/2, which is negotiated by TLS otherwise
+			httpServer.Protocols = new(http.Protocols)
+			httpServer.Protocols.SetHTTP1(true)
+			httpServer.Protocols.SetUnencryptedHTTP2(true)
+		}
+		gatewayLis := lis
+		if cfg.Server.Gateway.Port != 0 {
+			gatewayAddr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Gateway.Port)
+			gatewayLis, err = net.Listen("tcp", gatewayAddr)
+			if err != nil {
+				logger.Error("Failed to listen", "error", err)
+				dbpool.Close()
+				os.Exit(1)
+			}
+		} else {
+			// Native gRPC requests share the listener of the gateway
+			gw.HandleGRPC(grpcServer)
 		}
-	}()
+
+		go func() {
+			logger.Info("Starting HTTP gateway", "address", gatewayLis.Addr().String())
+			serve := httpServer.Serve
+			if tlsConfig != nil {
+				serve = func(l net.Listener) error {
+					return httpServer.ServeTLS(l, "", "")
+				}
+			}
+			if err := serve(gatewayLis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("Failed to serve", "error", err)
+				dbpool.Close()
+				os.Exit(1)
+			}
+		}()
+	}
+
+	if !cfg.Server.Gateway.Enabled || cfg.Server.Gateway.Port != 0 {
+		go func() {
+			logger.Info("Starting gRPC server", "address", addr)
+			if err := grpcServer.Serve(lis); err != nil {
+				logger.Error("Failed to serve", "error", err)
+				dbpool.Close()
+				os.Exit(1)
+			}
+		}()
+	}
 
 	// Wait for interrupt signal
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 	<-sigCh
 
-	// Graceful shutdown
+	// Graceful shutdown, letting load balancers drain traffic first
 	logger.Info("Shutting down gRPC server")
+	stopHealthChecker()
+	healthServer.Shutdown()
+	if httpServer != nil {
+		// Also drains the gRPC requests served through the gateway listener
+		if err := httpServer.Shutdown(context.Background()); err != nil {
+			logger.Error("Failed to shut down HTTP gateway", "error", err)
+		}
+	}
 	grpcServer.GracefulStop()
+	authService.Wait()
 }
@@ -0,0 +1,65 @@
+package gateway
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+var (
+	corsAllowedMethods = strings.Join([]string{http.MethodGet, http.MethodPost, http.MethodOptions}, ", ")
+	corsAllowedHeaders = strings.Join([]string{
+		"Authorization", "Content-Type", "Last-Event-ID", "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout",
+	}, ", ")
+	corsExposedHeaders = strings.Join([]string{"Grpc-Status", "Grpc-Message"}, ", ")
+)
+
+type cors struct {
+	allowedOrigins []string
+	anyOrigin      bool
+	maxAge         string
+}
+
+func newCORS(cfg config.CORSConfig) *cors {
+	return &cors{
+		allowedOrigins: cfg.AllowedOrigins,
+		anyOrigin:      slices.Contains(cfg.AllowedOrigins, "*"),
+		maxAge:         strconv.Itoa(int(cfg.MaxAge.Seconds())),
+	}
+}
+
+// handle sets the CORS headers of cross-origin requests from allowed origins.
+// It reports whether r was a preflight request, which is fully answered.
+func (c *cors) handle(w http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return false
+	}
+	preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+	w.Header().Add("Vary", "Origin")
+	if !c.anyOrigin && !slices.Contains(c.allowedOrigins, origin) {
+		if preflight {
+			w.WriteHeader(http.StatusForbidden)
+		}
+		return preflight
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	// Cookies, like the one binding OIDC logins, are only sent from listed origins
+	if !c.anyOrigin {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	if !preflight {
+		w.Header().Set("Access-Control-Expose-Headers", corsExposedHeaders)
+		return false
+	}
+	w.Header().Set("Access-Control-Allow-Methods", corsAllowedMethods)
+	w.Header().Set("Access-Control-Allow-Headers", corsAllowedHeaders)
+	w.Header().Set("Access-Control-Max-Age", c.maxAge)
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
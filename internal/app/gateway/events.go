@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"restaurant-ordering-system/internal/pkg/model"
+)
+
+const (
+	// retryInterval is how long browsers wait before reconnecting to an interrupted stream
+	retryInterval = 3 * time.Second
+	// defaultHeartbeat is used when no positive heartbeat is given
+	defaultHeartbeat = 15 * time.Second
+)
+
+// TabEventSource follows the events of tabs, see service.TabService
+type TabEventSource interface {
+	GetTabEventsCursor(ctx context.Context, tabID model.TabID, lastEventID string) (cursor string, missed bool, err error)
+	WaitTabEvents(ctx context.Context, tabID model.TabID, cursor string, block time.Duration) ([]*model.TabEvent, error)
+}
+
+// NewTabEventsHandler streams the events of the tab identified by the {id} path
+// wildcard as Server-Sent Events, e.g. for GET /tabs/{id}/events. Streams resume
+// after the Last-Event-ID header, or the lastEventId query parameter, and a comment
+// is sent every heartbeat while no events happen. The stream ends once the tab is closed.
+func NewTabEventsHandler(tabs TabEventSource, heartbeat time.Duration) http.Handler {
+	if heartbeat <= 0 {
+		heartbeat = defaultHeartbeat
+	}
+	return &tabEventsHandler{
+		tabs:      tabs,
+		heartbeat: heartbeat,
+	}
+}
+
+type tabEventsHandler struct {
+	tabs      TabEventSource
+	heartbeat time.Duration
+}
+
+func (h *tabEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tabID, err := model.ParseTabID(r.PathValue("id"))
+	if err != nil {
+		writeJSONError(w, status.Error(codes.InvalidArgument, "invalid tab ID"))
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSONError(w, status.Error(codes.Internal, "streaming is not supported"))
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+	cursor, missed, err := h.tabs.GetTabEventsCursor(ctx, tabID, lastEventID)
+	if err != nil {
+		writeJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable buffering by reverse proxies
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", retryInterval.Milliseconds())
+	if missed {
+		writeTabEvent(w, &model.TabEvent{ID: cursor, Type: model.TabResync})
+	}
+	flusher.Flush()
+
+	for {
+		events, err := h.tabs.WaitTabEvents(ctx, tabID, cursor, h.heartbeat)
+		if err != nil {
+			return // The client resumes from the last event it received
+		}
+		if len(events) == 0 {
+			io.WriteString(w, ": heartbeat\n\n")
+		}
+		for _, event := range events {
+			writeTabEvent(w, event)
+			cursor = event.ID
+			if event.Type == model.TabClosed {
+				flusher.Flush()
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func writeTabEvent(w io.Writer, event *model.TabEvent) {
+	data, _ := json.Marshal(event)
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+}
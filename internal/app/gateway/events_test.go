@@ -0,0 +1,87 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"restaurant-ordering-system/internal/pkg/model"
+)
+
+type tabEventSource struct {
+	lastEventID string
+	batches     [][]*model.TabEvent
+}
+
+func (s *tabEventSource) GetTabEventsCursor(ctx context.Context, tabID model.TabID, lastEventID string) (string, bool, error) {
+	s.lastEventID = lastEventID
+	switch {
+	case tabID == model.TabID{}:
+		return "", false, status.Error(codes.NotFound, "tab not found")
+	case lastEventID == "1-0":
+		return "5-0", true, nil
+	case lastEventID == "not-an-id":
+		return "", false, status.Error(codes.InvalidArgument, "last event ID is invalid")
+	}
+	return lastEventID, false, nil
+}
+
+func (s *tabEventSource) WaitTabEvents(ctx context.Context, tabID model.TabID, cursor string, block time.Duration) ([]*model.TabEvent, error) {
+	if len(s.batches) == 0 {
+		return nil, context.Canceled
+	}
+	events := s.batches[0]
+	s.batches = s.batches[1:]
+	return events, nil
+}
+
+func TestTabEventsHandler(t *testing.T) {
+	tabID := model.TabID(uuid.MustParse("0198c0f1-6a34-7d2e-9f4b-3c1a2b3c4d5e"))
+	orderItemID := model.OrderItemID{OrderID: model.OrderID{TabID: tabID, Scoped: 1}, Scoped: 2}
+	source := &tabEventSource{
+		batches: [][]*model.TabEvent{
+			{{ID: "6-0", Type: model.OrderItemCreated, OrderItemID: &orderItemID}},
+			nil,
+			{{ID: "7-0", Type: model.TabClosed}, {ID: "8-0", Type: model.TabVisited}},
+		},
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /tabs/{id}/events", NewTabEventsHandler(source, time.Second))
+
+	req := httptest.NewRequest(http.MethodGet, "/tabs/"+tabID.String()+"/events", nil)
+	req.Header.Set("Last-Event-ID", "1-0")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	require.Equal(t, "1-0", source.lastEventID)
+	require.Equal(t, "retry: 3000\n\n"+
+		"id: 5-0\nevent: resync\ndata: {\"type\":\"resync\"}\n\n"+
+		"id: 6-0\nevent: order_item_created\ndata: {\"type\":\"order_item_created\",\"order_item_id\":\""+orderItemID.String()+"\"}\n\n"+
+		": heartbeat\n\n"+
+		"id: 7-0\nevent: tab_closed\ndata: {\"type\":\"tab_closed\"}\n\n",
+		rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/tabs/not-a-tab/events", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/tabs/"+tabID.String()+"/events?lastEventId=not-an-id", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/tabs/"+model.TabID{}.String()+"/events", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
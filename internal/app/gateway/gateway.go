@@ -0,0 +1,272 @@
+// Package gateway serves the gRPC services to browsers over HTTP/JSON and gRPC-Web
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+// maxRequestSize bounds the size of request bodies, like the default
+// maximum message size of the gRPC server
+const maxRequestSize = 4 << 20
+
+// Gateway dispatches HTTP requests to the unary methods of the registered
+// services through the same interceptors as the gRPC server.
+//
+// JSON requests are served at POST /v1/<service>/<method>, e.g.
+// /v1/restaurant.MenuService/ListMenuItems, with the request message as body.
+// gRPC-Web requests are served at the gRPC path of the method.
+// Native gRPC requests are handed to the gRPC server, if any.
+type Gateway struct {
+	methods     map[string]*method
+	interceptor grpc.UnaryServerInterceptor
+	grpcServer  http.Handler
+	cors        *cors
+	mux         *http.ServeMux
+}
+
+type method struct {
+	srv     any
+	handler grpc.MethodHandler
+}
+
+// New creates a gateway calling interceptors in order around every method
+func New(cfg config.CORSConfig, interceptors ...grpc.UnaryServerInterceptor) *Gateway {
+	g := &Gateway{
+		methods:     make(map[string]*method),
+		interceptor: chainUnaryInterceptors(interceptors),
+		cors:        newCORS(cfg),
+		mux:         http.NewServeMux(),
+	}
+	g.mux.HandleFunc("POST /v1/{service}/{method}", g.serveJSON)
+	return g
+}
+
+// RegisterService implements grpc.ServiceRegistrar. Streaming methods are not served.
+func (g *Gateway) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	for _, m := range desc.Methods {
+		g.methods["/"+desc.ServiceName+"/"+m.MethodName] = &method{
+			srv:     impl,
+			handler: m.Handler,
+		}
+	}
+}
+
+// HandleGRPC hands native gRPC requests to grpcServer, so that the gateway
+// and the gRPC server can share a listener
+func (g *Gateway) HandleGRPC(grpcServer http.Handler) {
+	g.grpcServer = grpcServer
+}
+
+// Handle registers handler for pattern next to the methods of the services
+func (g *Gateway) Handle(pattern string, handler http.Handler) {
+	g.mux.Handle(pattern, handler)
+}
+
+// Guard serves the requests of handler which check lets through, and answers the
+// others with the error of check, like a failed call
+func Guard(check func(r *http.Request) error, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := check(r); err != nil {
+			writeJSONError(w, err)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	if g.grpcServer != nil && r.ProtoMajor == 2 && strings.HasPrefix(contentType, "application/grpc") &&
+		!strings.HasPrefix(contentType, "application/grpc-web") {
+		g.grpcServer.ServeHTTP(w, r)
+		return
+	}
+
+	if g.cors.handle(w, r) {
+		return
+	}
+
+	if strings.HasPrefix(contentType, "application/grpc-web") {
+		g.serveGRPCWeb(w, r)
+		return
+	}
+	g.mux.ServeHTTP(w, r)
+}
+
+// invoke calls the method named fullMethod, decoding the request with dec.
+// The headers set by the method, see grpc.SetHeader, are added to w.
+func (g *Gateway) invoke(w http.ResponseWriter, r *http.Request, fullMethod string, dec func(any) error) (any, error) {
+	m, ok := g.methods[fullMethod]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "unknown method %s", fullMethod)
+	}
+	stream := &headerStream{method: fullMethod}
+	ctx := grpc.NewContextWithServerTransportStream(incomingContext(r), stream)
+	resp, err := m.handler(m.srv, ctx, dec, g.interceptor)
+	for key, values := range stream.header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	return resp, err
+}
+
+// headerStream collects the headers set by a method, as the gRPC server sends them
+type headerStream struct {
+	method string
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string {
+	return s.method
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+// SetTrailer drops the trailers, which no method sets
+func (s *headerStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+func (g *Gateway) serveJSON(w http.ResponseWriter, r *http.Request) {
+	fullMethod := "/" + r.PathValue("service") + "/" + r.PathValue("method")
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		writeJSONError(w, status.Error(codes.ResourceExhausted, "request body is too large"))
+		return
+	}
+	resp, err := g.invoke(w, r, fullMethod, func(req any) error {
+		if len(body) == 0 {
+			return nil
+		}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, req.(proto.Message)); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		writeJSONError(w, err)
+		return
+	}
+
+	b, err := protojson.Marshal(resp.(proto.Message))
+	if err != nil {
+		writeJSONError(w, status.Error(codes.Internal, err.Error()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
+
+// writeJSONError writes err as {"code": ..., "message": ...}
+func writeJSONError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusFromCode(st.Code()))
+	json.NewEncoder(w).Encode(struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	})
+}
+
+// incomingContext makes the request headers and remote address available to
+// interceptors and handlers as they would be for a gRPC request
+func incomingContext(r *http.Request) context.Context {
+	md := make(metadata.MD, len(r.Header))
+	for key, values := range r.Header {
+		key = strings.ToLower(key)
+		if strings.HasPrefix(key, "grpc-") {
+			continue // Reserved for the gRPC protocol
+		}
+		md[key] = values
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		p := &peer.Peer{Addr: addr, LocalAddr: localAddr(r)}
+		if r.TLS != nil {
+			p.AuthInfo = credentials.TLSInfo{
+				State:          *r.TLS,
+				CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+			}
+		}
+		ctx = peer.NewContext(ctx, p)
+	}
+	return ctx
+}
+
+func localAddr(r *http.Request) net.Addr {
+	addr, _ := r.Context().Value(http.LocalAddrContextKey).(net.Addr)
+	return addr
+}
+
+// chainUnaryInterceptors calls interceptors in order, like grpc.ChainUnaryInterceptor
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// httpStatusFromCode maps gRPC codes to HTTP statuses, following google.rpc.Code
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
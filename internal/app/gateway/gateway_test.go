@@ -0,0 +1,184 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"restaurant-ordering-system/api/proto"
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+type menuServiceServer struct {
+	proto.UnimplementedMenuServiceServer
+}
+
+func (menuServiceServer) GetMenuItem(ctx context.Context, req *proto.GetMenuItemRequest) (*proto.MenuItem, error) {
+	if req.GetId() != "1" {
+		return nil, status.Error(codes.NotFound, "menu item not found")
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs("set-cookie", "seen=1")); err != nil {
+		return nil, err
+	}
+	return proto.MenuItem_builder{Id: protobuf.String(req.GetId()), Name: protobuf.String("Fried Rice")}.Build(), nil
+}
+
+func newTestGateway(t *testing.T) *Gateway {
+	t.Helper()
+	requireAuthorization := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md["authorization"]) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "authorization metadata missing")
+		}
+		require.Equal(t, "/restaurant.MenuService/GetMenuItem", info.FullMethod)
+		return handler(ctx, req)
+	}
+	g := New(config.CORSConfig{AllowedOrigins: []string{"https://example.com"}, MaxAge: time.Minute}, requireAuthorization)
+	proto.RegisterMenuServiceServer(g, menuServiceServer{})
+	return g
+}
+
+func TestGatewayJSON(t *testing.T) {
+	g := newTestGateway(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/restaurant.MenuService/GetMenuItem", strings.NewReader(`{"id": "1"}`))
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"id": "1", "name": "Fried Rice"}`, rec.Body.String())
+	// Headers set by methods are sent along, e.g. cookies
+	require.Equal(t, "seen=1", rec.Header().Get("Set-Cookie"))
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/restaurant.MenuService/GetMenuItem", strings.NewReader(`{"id": "2"}`))
+	req.Header.Set("Authorization", "Bearer token")
+	rec = httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.JSONEq(t, `{"code": "NotFound", "message": "menu item not found"}`, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/restaurant.MenuService/GetMenuItem", strings.NewReader(`{"id": "1"}`))
+	rec = httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/restaurant.MenuService/Unknown", nil)
+	rec = httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotImplemented, rec.Code)
+}
+
+func TestGatewayGRPCWeb(t *testing.T) {
+	g := newTestGateway(t)
+
+	msg, err := protobuf.Marshal(proto.GetMenuItemRequest_builder{Id: protobuf.String("1")}.Build())
+	require.NoError(t, err)
+	var frame bytes.Buffer
+	writeFrame(&frame, 0, msg)
+
+	for _, text := range []bool{false, true} {
+		body := frame.Bytes()
+		contentType := grpcWebContentType + "+proto"
+		if text {
+			body = []byte(base64.StdEncoding.EncodeToString(body))
+			contentType = grpcWebTextContentType + "+proto"
+		}
+		req := httptest.NewRequest(http.MethodPost, "/restaurant.MenuService/GetMenuItem", bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Authorization", "Bearer token")
+		rec := httptest.NewRecorder()
+		g.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, contentType, rec.Header().Get("Content-Type"))
+
+		var resp io.Reader = rec.Body
+		if text {
+			resp = base64.NewDecoder(base64.StdEncoding, resp)
+		}
+		data := readTestFrame(t, resp, 0)
+		var item proto.MenuItem
+		require.NoError(t, protobuf.Unmarshal(data, &item))
+		require.Equal(t, "Fried Rice", item.GetName())
+		require.Equal(t, "grpc-status: 0\r\n", string(readTestFrame(t, resp, trailerFlag)))
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/restaurant.MenuService/GetMenuItem", bytes.NewReader(frame.Bytes()))
+	req.Header.Set("Content-Type", grpcWebContentType)
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "grpc-status: 16\r\ngrpc-message: authorization%20metadata%20missing\r\n",
+		string(readTestFrame(t, rec.Body, trailerFlag)))
+}
+
+func readTestFrame(t *testing.T, r io.Reader, flags byte) []byte {
+	t.Helper()
+	var header [frameHeaderSize]byte
+	_, err := io.ReadFull(r, header[:])
+	require.NoError(t, err)
+	require.Equal(t, flags, header[0])
+	payload := make([]byte, binary.BigEndian.Uint32(header[1:]))
+	_, err = io.ReadFull(r, payload)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestGatewayCORS(t *testing.T) {
+	g := newTestGateway(t)
+
+	req := httptest.NewRequest(http.MethodOptions, "/v1/restaurant.MenuService/GetMenuItem", nil)
+	req.Header.Set("Origin", "https://example.com")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, "https://example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), "Authorization")
+	require.Equal(t, "60", rec.Header().Get("Access-Control-Max-Age"))
+	require.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+
+	req = httptest.NewRequest(http.MethodOptions, "/v1/restaurant.MenuService/GetMenuItem", nil)
+	req.Header.Set("Origin", "https://evil.example")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	rec = httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestMediaHandler(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "menu-items", "1"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "menu-items", "1", "large.jpg"), []byte("jpeg"), 0o644))
+	gw := New(config.CORSConfig{})
+	gw.Handle("GET /media/", http.StripPrefix("/media/", NewMediaHandler(dir)))
+
+	rec := httptest.NewRecorder()
+	gw.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/media/menu-items/1/large.jpg", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "jpeg", rec.Body.String())
+	require.Contains(t, rec.Header().Get("Cache-Control"), "immutable")
+
+	// Directories are not listed
+	for _, path := range []string{"/media/", "/media/menu-items/1/"} {
+		rec = httptest.NewRecorder()
+		gw.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusNotFound, rec.Code, path)
+	}
+}
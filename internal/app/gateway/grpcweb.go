@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+
+	// frameHeaderSize is the size of the flags byte and the length prefix of a frame
+	frameHeaderSize = 5
+	// trailerFlag marks the frame carrying the trailers
+	trailerFlag byte = 0x80
+)
+
+// serveGRPCWeb serves a unary gRPC-Web call, in binary or base64 text format.
+// The status is always sent in a trailer frame with a 200 response.
+func (g *Gateway) serveGRPCWeb(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+	if r.Method != http.MethodPost || (!text && !isGRPCWebBinary(contentType)) {
+		http.Error(w, "unsupported gRPC-Web request", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var body io.Reader = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	if text {
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+
+	var buf bytes.Buffer
+	msg, err := readFrame(body)
+	if err == nil {
+		var resp any
+		resp, err = g.invoke(w, r, r.URL.Path, func(req any) error {
+			if err := proto.Unmarshal(msg, req.(proto.Message)); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil
+		})
+		if err == nil {
+			var b []byte
+			if b, err = proto.Marshal(resp.(proto.Message)); err == nil {
+				writeFrame(&buf, 0, b)
+			}
+		}
+	}
+	writeFrame(&buf, trailerFlag, trailers(status.Convert(err)))
+
+	if text {
+		w.Header().Set("Content-Type", grpcWebTextContentType+"+proto")
+		w.Write([]byte(base64.StdEncoding.EncodeToString(buf.Bytes())))
+		return
+	}
+	w.Header().Set("Content-Type", grpcWebContentType+"+proto")
+	w.Write(buf.Bytes())
+}
+
+func isGRPCWebBinary(contentType string) bool {
+	return contentType == grpcWebContentType || strings.HasPrefix(contentType, grpcWebContentType+"+proto")
+}
+
+// readFrame reads the single data frame of a unary request
+func readFrame(r io.Reader) ([]byte, error) {
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "malformed gRPC-Web frame")
+	}
+	if header[0] != 0 {
+		return nil, status.Error(codes.Unimplemented, "compressed gRPC-Web frames are not supported")
+	}
+	length := binary.BigEndian.Uint32(header[1:])
+	if length > maxRequestSize {
+		return nil, status.Error(codes.ResourceExhausted, "request message is too large")
+	}
+	msg := make([]byte, length)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "malformed gRPC-Web frame")
+	}
+	return msg, nil
+}
+
+func writeFrame(buf *bytes.Buffer, flags byte, payload []byte) {
+	var header [frameHeaderSize]byte
+	header[0] = flags
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+	buf.Write(header[:])
+	buf.Write(payload)
+}
+
+// trailers encodes st as the HTTP/1 style header block of a trailer frame
+func trailers(st *status.Status) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "grpc-status: %d\r\n", st.Code())
+	if st.Message() != "" {
+		fmt.Fprintf(&b, "grpc-message: %s\r\n", url.PathEscape(st.Message()))
+	}
+	return []byte(b.String())
+}
@@ -0,0 +1,29 @@
+package gateway
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JWKSSource returns the JWKS document of the keys verifying tokens, see auth.Keyring
+type JWKSSource interface {
+	JWKS() ([]byte, error)
+}
+
+// NewJWKSHandler serves the JWKS document of keys, e.g. for GET /.well-known/jwks.json,
+// so that other services can verify tokens
+func NewJWKSHandler(keys JWKSSource) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jwks, err := keys.JWKS()
+		if err != nil {
+			writeJSONError(w, status.Error(codes.Internal, "unable to encode keys"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		// Verifiers may cache the keys for a while, new keys are published before signing with them
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.Write(jwks)
+	})
+}
@@ -0,0 +1,22 @@
+package gateway
+
+import (
+	"net/http"
+	"strings"
+)
+
+// NewMediaHandler serves the files of dir, e.g. for GET /media/ with the local storage,
+// without listing its directories. Files are cached for a year since their keys are
+// never reused for other contents.
+func NewMediaHandler(dir string) http.Handler {
+	files := http.FileServer(http.Dir(dir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "" || strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		files.ServeHTTP(w, r)
+	})
+}
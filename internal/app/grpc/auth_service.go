@@ -2,12 +2,22 @@ package grpcapp
 
 import (
 	"context"
+	"net/http"
 
 	"restaurant-ordering-system/api/proto"
+	"restaurant-ordering-system/internal/pkg/auth"
 	"restaurant-ordering-system/internal/pkg/model"
 	"restaurant-ordering-system/internal/pkg/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// oidcBindingCookie holds the binding of the OIDC login started by the browser,
+// see service.AuthService.StartOIDCLogin
+const oidcBindingCookie = "oidc_binding"
+
 type AuthServiceServer struct {
 	proto.UnimplementedAuthServiceServer
 	AuthService *service.AuthService
@@ -20,12 +30,104 @@ func NewAuthServiceServer(authService *service.AuthService) *AuthServiceServer {
 func (s *AuthServiceServer) GenerateToken(ctx context.Context, req *proto.GenerateTokenRequest) (*proto.GenerateTokenResponse, error) {
 	loginID := model.LoginID(req.GetLoginId())
 	password := req.GetPassword()
-	token, err := s.AuthService.GenerateToken(ctx, loginID, password)
+	token, err := s.AuthService.GenerateToken(ctx, loginID, password, auth.ClientIPFromContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 	resp := &proto.GenerateTokenResponse{}
 	resp.SetAccessToken(token)
-	resp.SetExpiresIn(86400) // TODO: set real expiry
+	resp.SetExpiresIn(int64(s.AuthService.TokenTTL().Seconds()))
 	return resp, nil
 }
+
+func (s *AuthServiceServer) VerifyEmail(ctx context.Context, req *proto.VerifyEmailRequest) (*emptypb.Empty, error) {
+	if err := s.AuthService.VerifyEmail(ctx, req.GetToken()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) RequestEmailVerification(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.AuthService.SendEmailVerification(ctx, id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) RequestPasswordReset(ctx context.Context, req *proto.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := s.AuthService.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) ResetPassword(ctx context.Context, req *proto.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if err := s.AuthService.ResetPassword(ctx, req.GetToken(), []byte(req.GetNewPassword())); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServiceServer) StartOIDCLogin(ctx context.Context, req *proto.StartOIDCLoginRequest) (*proto.StartOIDCLoginResponse, error) {
+	authURL, binding, err := s.AuthService.StartOIDCLogin(ctx, req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+	if err := setOIDCBindingCookie(ctx, binding, 0); err != nil {
+		return nil, err
+	}
+	resp := &proto.StartOIDCLoginResponse{}
+	resp.SetAuthorizationUrl(authURL)
+	return resp, nil
+}
+
+func (s *AuthServiceServer) CompleteOIDCLogin(ctx context.Context, req *proto.CompleteOIDCLoginRequest) (*proto.GenerateTokenResponse, error) {
+	token, err := s.AuthService.CompleteOIDCLogin(ctx, req.GetState(), req.GetCode(), oidcBinding(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// The binding was used up with the state
+	if err := setOIDCBindingCookie(ctx, "", -1); err != nil {
+		return nil, err
+	}
+	resp := &proto.GenerateTokenResponse{}
+	resp.SetAccessToken(token)
+	resp.SetExpiresIn(int64(s.AuthService.TokenTTL().Seconds()))
+	return resp, nil
+}
+
+// setOIDCBindingCookie sets the binding cookie with the response headers. Scripts can
+// not read it, and the browser does not send it with the requests of other sites.
+func setOIDCBindingCookie(ctx context.Context, binding string, maxAge int) error {
+	cookie := &http.Cookie{
+		Name:     oidcBindingCookie,
+		Value:    binding,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs("set-cookie", cookie.String()))
+}
+
+// oidcBinding returns the binding cookie sent with the call, or an empty string
+func oidcBinding(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, header := range md.Get("cookie") {
+		cookies, err := http.ParseCookie(header)
+		if err != nil {
+			continue
+		}
+		for _, cookie := range cookies {
+			if cookie.Name == oidcBindingCookie {
+				return cookie.Value
+			}
+		}
+	}
+	return ""
+}
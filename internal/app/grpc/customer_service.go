@@ -2,11 +2,15 @@ package grpcapp
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	"restaurant-ordering-system/api/proto"
+	"restaurant-ordering-system/internal/pkg/auth"
 	"restaurant-ordering-system/internal/pkg/model"
 	"restaurant-ordering-system/internal/pkg/service"
 
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -50,13 +54,198 @@ func (s *CustomerServiceServer) GetCustomerByID(ctx context.Context, req *proto.
 	return modelCustomerToProtoCustomer(&customer), nil
 }
 
+func (s *CustomerServiceServer) GetMyProfile(ctx context.Context, req *emptypb.Empty) (*proto.Customer, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	customer, err := s.CustomerService.GetCustomerByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return modelCustomerToProtoCustomer(&customer), nil
+}
+
+func (s *CustomerServiceServer) UpdateMyProfile(ctx context.Context, req *proto.UpdateMyProfileRequest) (*proto.Customer, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var params model.UpdateCustomerProfileParams
+	if req.HasName() {
+		name := req.GetName()
+		params.Name = &name
+	}
+	if req.HasPhoneNumber() {
+		phoneNumber := req.GetPhoneNumber()
+		params.PhoneNumber = &phoneNumber
+	}
+	customer, err := s.CustomerService.UpdateCustomerProfile(ctx, id, params)
+	if err != nil {
+		return nil, err
+	}
+	return modelCustomerToProtoCustomer(&customer), nil
+}
+
+func (s *CustomerServiceServer) ChangeLoginID(ctx context.Context, req *proto.ChangeLoginIDRequest) (*proto.Customer, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	loginID, err := model.ParseLoginID(req.GetLoginId())
+	if err != nil {
+		return nil, err
+	}
+	customer, err := s.CustomerService.ChangeLoginID(ctx, id, loginID, []byte(req.GetPassword()))
+	if err != nil {
+		return nil, err
+	}
+	return modelCustomerToProtoCustomer(&customer), nil
+}
+
+func (s *CustomerServiceServer) ChangeEmail(ctx context.Context, req *proto.ChangeEmailRequest) (*proto.Customer, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	customer, err := s.CustomerService.ChangeEmail(ctx, id, req.GetEmail(), []byte(req.GetPassword()))
+	if err != nil {
+		return nil, err
+	}
+	return modelCustomerToProtoCustomer(&customer), nil
+}
+
+func (s *CustomerServiceServer) ChangePassword(ctx context.Context, req *proto.ChangePasswordRequest) (*proto.GenerateTokenResponse, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.CustomerService.ChangePassword(ctx, id, []byte(req.GetCurrentPassword()), []byte(req.GetNewPassword()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.GenerateTokenResponse{}
+	resp.SetAccessToken(token)
+	resp.SetExpiresIn(int64(s.CustomerService.TokenTTL().Seconds()))
+	return resp, nil
+}
+
+func (s *CustomerServiceServer) DeleteMyAccount(ctx context.Context, req *proto.DeleteMyAccountRequest) (*emptypb.Empty, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.CustomerService.DeleteCustomer(ctx, id, []byte(req.GetPassword())); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CustomerServiceServer) ExportMyData(ctx context.Context, req *emptypb.Empty) (*proto.ExportMyDataResponse, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export, err := s.CustomerService.ExportCustomerData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	document, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ExportMyDataResponse{}
+	resp.SetDocument(string(document))
+	return resp, nil
+}
+
+func (s *CustomerServiceServer) SaveFavorite(ctx context.Context, req *proto.SaveFavoriteRequest) (*proto.Favorite, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	menuItemID, err := model.ParseMenuItemID(req.GetMenuItemId())
+	if err != nil {
+		return nil, err
+	}
+	favorite, err := s.CustomerService.SaveFavorite(ctx, id, model.SaveFavoriteParams{
+		MenuItemID: menuItemID,
+		Name:       req.GetName(),
+		Modifiers:  req.GetModifiers(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return modelFavoriteToProtoFavorite(favorite), nil
+}
+
+func (s *CustomerServiceServer) ListFavorites(ctx context.Context, req *emptypb.Empty) (*proto.ListFavoritesResponse, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := s.CustomerService.ListFavorites(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListFavoritesResponse{}
+	var protoFavorites []*proto.Favorite
+	for _, favorite := range favorites {
+		protoFavorites = append(protoFavorites, modelFavoriteToProtoFavorite(favorite))
+	}
+	resp.SetFavorites(protoFavorites)
+	return resp, nil
+}
+
+func (s *CustomerServiceServer) DeleteFavorite(ctx context.Context, req *proto.DeleteFavoriteRequest) (*emptypb.Empty, error) {
+	id, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	favoriteID, err := model.ParseFavoriteID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.CustomerService.DeleteFavorite(ctx, id, favoriteID); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// authenticatedCustomerID returns the ID of the customer authenticated by the token of the request
+func authenticatedCustomerID(ctx context.Context) (model.CustomerID, error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return model.CustomerID{}, errors.New("not authenticated")
+	}
+	if claims.Role != auth.CustomerRole {
+		return model.CustomerID{}, errors.New("not authorized")
+	}
+	return model.ParseCustomerID(claims.Subject)
+}
+
 func modelCustomerToProtoCustomer(customer *model.Customer) *proto.Customer {
 	pcust := &proto.Customer{}
 	pcust.SetId(customer.ID.String())
+	pcust.SetLoginId(customer.LoginID.String())
 	pcust.SetName(customer.Name)
 	pcust.SetEmail(customer.Email)
+	pcust.SetEmailVerified(customer.EmailVerified)
 	pcust.SetPhoneNumber(customer.PhoneNumber)
 	pcust.SetCreatedAt(timestamppb.New(customer.CreatedAt))
 	pcust.SetUpdatedAt(timestamppb.New(customer.UpdatedAt))
 	return pcust
 }
+
+func modelFavoriteToProtoFavorite(favorite model.Favorite) *proto.Favorite {
+	pfav := &proto.Favorite{}
+	pfav.SetId(favorite.ID.String())
+	pfav.SetMenuItemId(favorite.MenuItemID.String())
+	pfav.SetMenuItemName(favorite.MenuItemName)
+	pfav.SetName(favorite.Name)
+	pfav.SetModifiers(favorite.Modifiers)
+	pfav.SetOutdated(favorite.Outdated)
+	pfav.SetCreatedAt(timestamppb.New(favorite.CreatedAt))
+	pfav.SetUpdatedAt(timestamppb.New(favorite.UpdatedAt))
+	return pfav
+}
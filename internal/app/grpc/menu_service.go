@@ -1,16 +1,27 @@
 package grpcapp
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"time"
 
 	"restaurant-ordering-system/api/proto"
+	"restaurant-ordering-system/internal/pkg/menufile"
 	"restaurant-ordering-system/internal/pkg/model"
 	"restaurant-ordering-system/internal/pkg/service"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var (
+	ErrMissingMenuItemID    = status.Error(codes.InvalidArgument, "the first message must name the menu item")
+	ErrUnexpectedMenuItemID = status.Error(codes.InvalidArgument, "only the first message names the menu item")
+)
+
 type MenuServiceServer struct {
 	proto.UnimplementedMenuServiceServer
 	MenuService *service.MenuService
@@ -97,6 +108,171 @@ func (s *MenuServiceServer) DeleteMenuItem(ctx context.Context, req *proto.Delet
 	return &emptypb.Empty{}, nil
 }
 
+func (s *MenuServiceServer) UploadMenuItemPhoto(stream proto.MenuService_UploadMenuItemPhotoServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF || err == nil && !first.HasMenuItemId() {
+		return ErrMissingMenuItemID
+	}
+	if err != nil {
+		return err
+	}
+	id, err := model.ParseMenuItemID(first.GetMenuItemId())
+	if err != nil {
+		return err
+	}
+	photo, err := s.MenuService.UploadMenuItemPhoto(stream.Context(), id, &photoChunkReader{stream: stream})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(modelMenuItemPhotoToProto(photo))
+}
+
+// photoChunkReader reads the chunks of the messages of an upload stream, until the client closes it
+type photoChunkReader struct {
+	stream proto.MenuService_UploadMenuItemPhotoServer
+	chunk  []byte
+}
+
+func (r *photoChunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.HasMenuItemId() {
+			return 0, ErrUnexpectedMenuItemID
+		}
+		r.chunk = req.GetChunk()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (s *MenuServiceServer) ExportMenu(ctx context.Context, req *proto.ExportMenuRequest) (*proto.ExportMenuResponse, error) {
+	doc, err := s.MenuService.ExportMenu(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := menufile.Encode(&buf, req.GetFormat(), doc); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp := &proto.ExportMenuResponse{}
+	resp.SetData(buf.Bytes())
+	resp.SetContentType(menufile.ContentType(req.GetFormat()))
+	return resp, nil
+}
+
+func (s *MenuServiceServer) ImportMenu(ctx context.Context, req *proto.ImportMenuRequest) (*proto.ImportMenuResponse, error) {
+	doc, err := menufile.Decode(bytes.NewReader(req.GetData()), req.GetFormat())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.MenuService.ImportMenu(ctx, doc, req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*proto.MenuChange, len(result.Changes))
+	for i, change := range result.Changes {
+		changes[i] = &proto.MenuChange{}
+		changes[i].SetKind(string(change.Kind))
+		changes[i].SetKey(change.Key)
+		changes[i].SetAction(string(change.Action))
+		changes[i].SetFields(change.Fields)
+	}
+	resp := &proto.ImportMenuResponse{}
+	resp.SetChanges(changes)
+	resp.SetUnchanged(int32(result.Unchanged))
+	resp.SetApplied(result.Applied)
+	return resp, nil
+}
+
+func (s *MenuServiceServer) SetMenuItemAvailabilityOverride(ctx context.Context, req *proto.SetMenuItemAvailabilityOverrideRequest) (*proto.MenuItem, error) {
+	id, err := model.ParseMenuItemID(req.GetMenuItemId())
+	if err != nil {
+		return nil, err
+	}
+	var override *model.AvailabilityOverride
+	if req.HasOverride() {
+		override = &model.AvailabilityOverride{Available: req.GetOverride().GetAvailable()}
+		if req.GetOverride().HasUntil() {
+			until := req.GetOverride().GetUntil().AsTime()
+			override.Until = &until
+		}
+	}
+	item, err := s.MenuService.SetMenuItemAvailabilityOverride(ctx, id, override)
+	if err != nil {
+		return nil, err
+	}
+	return modelMenuItemToProtoMenuItem(item), nil
+}
+
+func (s *MenuServiceServer) CreateMenu(ctx context.Context, req *proto.CreateMenuRequest) (*proto.Menu, error) {
+	params, err := protoMenuToParams(req.GetMenu())
+	if err != nil {
+		return nil, err
+	}
+	menu, err := s.MenuService.CreateMenu(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return modelMenuToProto(menu), nil
+}
+
+func (s *MenuServiceServer) GetMenu(ctx context.Context, req *proto.GetMenuRequest) (*proto.Menu, error) {
+	id, err := model.ParseMenuID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	menu, err := s.MenuService.GetMenu(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return modelMenuToProto(menu), nil
+}
+
+func (s *MenuServiceServer) ListMenus(ctx context.Context, req *emptypb.Empty) (*proto.ListMenusResponse, error) {
+	menus, err := s.MenuService.ListMenus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protoMenus := make([]*proto.Menu, len(menus))
+	for i, menu := range menus {
+		protoMenus[i] = modelMenuToProto(menu)
+	}
+	resp := &proto.ListMenusResponse{}
+	resp.SetMenus(protoMenus)
+	return resp, nil
+}
+
+func (s *MenuServiceServer) UpdateMenu(ctx context.Context, req *proto.UpdateMenuRequest) (*proto.Menu, error) {
+	id, err := model.ParseMenuID(req.GetMenu().GetId())
+	if err != nil {
+		return nil, err
+	}
+	params, err := protoMenuToParams(req.GetMenu())
+	if err != nil {
+		return nil, err
+	}
+	menu, err := s.MenuService.UpdateMenu(ctx, id, model.UpdateMenuParams(params))
+	if err != nil {
+		return nil, err
+	}
+	return modelMenuToProto(menu), nil
+}
+
+func (s *MenuServiceServer) DeleteMenu(ctx context.Context, req *proto.DeleteMenuRequest) (*emptypb.Empty, error) {
+	id, err := model.ParseMenuID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.MenuService.DeleteMenu(ctx, id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func modelMenuItemToProtoMenuItem(item *model.MenuItem) *proto.MenuItem {
 	mi := &proto.MenuItem{}
 	mi.SetId(item.ID.String())
@@ -116,9 +292,128 @@ func modelMenuItemToProtoMenuItem(item *model.MenuItem) *proto.MenuItem {
 	if item.DeletedAt != nil {
 		mi.SetDeletedAt(timestamppb.New(*item.DeletedAt))
 	}
+	if item.Photo != nil {
+		mi.SetPhoto(modelMenuItemPhotoToProto(item.Photo))
+	}
+	menuIDs := make([]string, len(item.MenuIDs))
+	for i, id := range item.MenuIDs {
+		menuIDs[i] = id.String()
+	}
+	mi.SetMenuIds(menuIDs)
+	if item.AvailabilityOverride != nil {
+		override := &proto.AvailabilityOverride{}
+		override.SetAvailable(item.AvailabilityOverride.Available)
+		if item.AvailabilityOverride.Until != nil {
+			override.SetUntil(timestamppb.New(*item.AvailabilityOverride.Until))
+		}
+		mi.SetAvailabilityOverride(override)
+	}
+	mi.SetAvailableNow(item.AvailableNow)
 	return mi
 }
 
+func modelMenuToProto(menu *model.Menu) *proto.Menu {
+	m := &proto.Menu{}
+	m.SetId(menu.ID.String())
+	m.SetName(menu.Name)
+	m.SetDescription(menu.Description)
+	m.SetTimezone(menu.Timezone)
+	schedules := make([]*proto.MenuSchedule, len(menu.Schedules))
+	for i, schedule := range menu.Schedules {
+		schedules[i] = &proto.MenuSchedule{}
+		weekdays := make([]int32, len(schedule.Weekdays))
+		for j, weekday := range schedule.Weekdays {
+			weekdays[j] = int32(weekday)
+		}
+		schedules[i].SetWeekdays(weekdays)
+		schedules[i].SetStartTime(schedule.Start.String())
+		schedules[i].SetEndTime(schedule.End.String())
+		if schedule.StartDate != nil {
+			schedules[i].SetStartDate(schedule.StartDate.Format(time.DateOnly))
+		}
+		if schedule.EndDate != nil {
+			schedules[i].SetEndDate(schedule.EndDate.Format(time.DateOnly))
+		}
+	}
+	m.SetSchedules(schedules)
+	menuItemIDs := make([]string, len(menu.MenuItemIDs))
+	for i, id := range menu.MenuItemIDs {
+		menuItemIDs[i] = id.String()
+	}
+	m.SetMenuItemIds(menuItemIDs)
+	m.SetActive(menu.Active)
+	m.SetCreatedAt(timestamppb.New(menu.CreatedAt))
+	m.SetUpdatedAt(timestamppb.New(menu.UpdatedAt))
+	return m
+}
+
+func protoMenuToParams(menu *proto.Menu) (model.CreateMenuParams, error) {
+	params := model.CreateMenuParams{
+		Name:        menu.GetName(),
+		Description: menu.GetDescription(),
+		Timezone:    menu.GetTimezone(),
+		Schedules:   make([]model.MenuSchedule, len(menu.GetSchedules())),
+		MenuItemIDs: make([]model.MenuItemID, len(menu.GetMenuItemIds())),
+	}
+	for i, schedule := range menu.GetSchedules() {
+		weekdays := make([]time.Weekday, len(schedule.GetWeekdays()))
+		for j, weekday := range schedule.GetWeekdays() {
+			weekdays[j] = time.Weekday(weekday)
+		}
+		start, err := model.ParseTimeOfDay(schedule.GetStartTime())
+		if err != nil {
+			return model.CreateMenuParams{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		end, err := model.ParseTimeOfDay(schedule.GetEndTime())
+		if err != nil {
+			return model.CreateMenuParams{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		params.Schedules[i] = model.MenuSchedule{Weekdays: weekdays, Start: start, End: end}
+		if schedule.GetStartDate() != "" {
+			date, err := time.Parse(time.DateOnly, schedule.GetStartDate())
+			if err != nil {
+				return model.CreateMenuParams{}, status.Errorf(codes.InvalidArgument, "invalid start date %q, expected YYYY-MM-DD", schedule.GetStartDate())
+			}
+			params.Schedules[i].StartDate = &date
+		}
+		if schedule.GetEndDate() != "" {
+			date, err := time.Parse(time.DateOnly, schedule.GetEndDate())
+			if err != nil {
+				return model.CreateMenuParams{}, status.Errorf(codes.InvalidArgument, "invalid end date %q, expected YYYY-MM-DD", schedule.GetEndDate())
+			}
+			params.Schedules[i].EndDate = &date
+		}
+	}
+	for i, id := range menu.GetMenuItemIds() {
+		menuItemID, err := model.ParseMenuItemID(id)
+		if err != nil {
+			return model.CreateMenuParams{}, err
+		}
+		params.MenuItemIDs[i] = menuItemID
+	}
+	return params, nil
+}
+
+func modelMenuItemPhotoToProto(photo *model.MenuItemPhoto) *proto.MenuItemPhoto {
+	p := &proto.MenuItemPhoto{}
+	p.SetContentType(photo.ContentType)
+	p.SetWidth(int32(photo.Width))
+	p.SetHeight(int32(photo.Height))
+	p.SetBlurhash(photo.Blurhash)
+	p.SetOriginalUrl(photo.OriginalURL)
+	variants := make([]*proto.PhotoVariant, len(photo.Variants))
+	for i, v := range photo.Variants {
+		variants[i] = &proto.PhotoVariant{}
+		variants[i].SetName(v.Name)
+		variants[i].SetUrl(v.URL)
+		variants[i].SetWidth(int32(v.Width))
+		variants[i].SetHeight(int32(v.Height))
+	}
+	p.SetVariants(variants)
+	p.SetCreatedAt(timestamppb.New(photo.CreatedAt))
+	return p
+}
+
 func modelMenuTagToProtoMenuTag(tag *model.MenuTag) *proto.MenuTag {
 	mt := &proto.MenuTag{}
 	mt.SetId(tag.ID.String())
@@ -2,6 +2,7 @@ package grpcapp
 
 import (
 	"context"
+	"math"
 
 	"restaurant-ordering-system/api/proto"
 	"restaurant-ordering-system/internal/pkg/model"
@@ -47,7 +48,7 @@ func (s *OrderServiceServer) CreateOrderItem(ctx context.Context, req *proto.Cre
 	params := model.CreateOrderItemParams{
 		OrderID:          orderID,
 		MenuItemID:       menuItemID,
-		Quantity:         int16(req.GetQuantity()),
+		Quantity:         saturateQuantity(req.GetQuantity()),
 		Modifiers:        req.GetModifiers(),
 		GuestOwnerIDs:    guestOwnerIDs,
 		CustomerOwnerIDs: customerOwnerIDs,
@@ -89,7 +90,7 @@ func (s *OrderServiceServer) UpdateOrderItemQuantity(ctx context.Context, req *p
 	if err != nil {
 		return nil, err
 	}
-	if err := s.OrderService.UpdateOrderItemQuantity(ctx, id, int16(req.GetQuantity())); err != nil {
+	if err := s.OrderService.UpdateOrderItemQuantity(ctx, id, saturateQuantity(req.GetQuantity())); err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
@@ -165,3 +166,82 @@ func (s *OrderServiceServer) SendOrder(ctx context.Context, req *proto.SendOrder
 	}
 	return &emptypb.Empty{}, nil
 }
+
+// saturateQuantity converts the quantity of a request, saturating it rather than
+// wrapping it around so that out of range quantities are rejected by the service
+func saturateQuantity(quantity int32) int16 {
+	return int16(min(max(quantity, math.MinInt16), math.MaxInt16))
+}
+
+func (s *OrderServiceServer) AddFavoriteToOrder(ctx context.Context, req *proto.AddFavoriteToOrderRequest) (*proto.OrderItemID, error) {
+	customerID, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	favoriteID, err := model.ParseFavoriteID(req.GetFavoriteId())
+	if err != nil {
+		return nil, err
+	}
+	orderID, err := model.ParseOrderID(req.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.OrderService.AddFavoriteToOrder(ctx, customerID, favoriteID, orderID, saturateQuantity(req.GetQuantity()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.OrderItemID{}
+	resp.SetId(id.String())
+	return resp, nil
+}
+
+func (s *OrderServiceServer) Reorder(ctx context.Context, req *proto.ReorderRequest) (*proto.ReorderResponse, error) {
+	customerID, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orderID, err := model.ParseOrderID(req.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+	params := model.ReorderParams{
+		OrderID:   orderID,
+		OnlyOwned: req.GetOnlyOwned(),
+	}
+	switch req.WhichSource() {
+	case proto.ReorderRequest_SourceOrderId_case:
+		sourceOrderID, err := model.ParseOrderID(req.GetSourceOrderId())
+		if err != nil {
+			return nil, err
+		}
+		params.SourceOrderID = &sourceOrderID
+	case proto.ReorderRequest_SourceTabId_case:
+		sourceTabID, err := model.ParseTabID(req.GetSourceTabId())
+		if err != nil {
+			return nil, err
+		}
+		params.SourceTabID = &sourceTabID
+	}
+	result, err := s.OrderService.Reorder(ctx, customerID, params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ReorderResponse{}
+	var protoIDs []*proto.OrderItemID
+	for _, id := range result.OrderItemIDs {
+		pid := &proto.OrderItemID{}
+		pid.SetId(id.String())
+		protoIDs = append(protoIDs, pid)
+	}
+	resp.SetOrderItemIds(protoIDs)
+	var protoSkipped []*proto.SkippedOrderItem
+	for _, item := range result.SkippedItems {
+		pitem := &proto.SkippedOrderItem{}
+		pitem.SetMenuItemId(item.MenuItemID.String())
+		pitem.SetName(item.Name)
+		pitem.SetReason(string(item.Reason))
+		protoSkipped = append(protoSkipped, pitem)
+	}
+	resp.SetSkippedItems(protoSkipped)
+	return resp, nil
+}
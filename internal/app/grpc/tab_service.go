@@ -58,17 +58,18 @@ func (s *TabServiceServer) VisitTab(ctx context.Context, req *proto.VisitTabRequ
 	return &emptypb.Empty{}, nil
 }
 
-func (s *TabServiceServer) CreateGuest(ctx context.Context, req *proto.CreateGuestRequest) (*proto.GuestID, error) {
+func (s *TabServiceServer) CreateGuest(ctx context.Context, req *proto.CreateGuestRequest) (*proto.CreateGuestResponse, error) {
 	tabID, err := model.ParseTabID(req.GetTabId())
 	if err != nil {
 		return nil, err
 	}
-	guestID, err := s.TabService.CreateGuest(ctx, tabID)
+	guestID, claimToken, err := s.TabService.CreateGuest(ctx, tabID)
 	if err != nil {
 		return nil, err
 	}
-	resp := &proto.GuestID{}
+	resp := &proto.CreateGuestResponse{}
 	resp.SetId(guestID.String())
+	resp.SetClaimToken(claimToken)
 	return resp, nil
 }
 
@@ -83,6 +84,21 @@ func (s *TabServiceServer) UpdateGuestName(ctx context.Context, req *proto.Updat
 	return &emptypb.Empty{}, nil
 }
 
+func (s *TabServiceServer) ClaimGuest(ctx context.Context, req *proto.ClaimGuestRequest) (*emptypb.Empty, error) {
+	customerID, err := authenticatedCustomerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	guestID, err := model.ParseGuestID(req.GetGuestId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.TabService.ClaimGuest(ctx, guestID, customerID, req.GetClaimToken()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *TabServiceServer) GetOpenTab(ctx context.Context, req *proto.GetOpenTabRequest) (*proto.Tab, error) {
 	tabID, err := model.ParseTabID(req.GetTabId())
 	if err != nil {
@@ -110,34 +126,87 @@ func (s *TabServiceServer) CloseTab(ctx context.Context, req *proto.CloseTabRequ
 }
 
 func (s *TabServiceServer) GetVisitedTabs(ctx context.Context, req *proto.GetVisitedTabsRequest) (*proto.GetVisitedTabsResponse, error) {
-	claims, ok := auth.FromContext(ctx)
-	if !ok {
-		return nil, errors.New("not authenticated")
-	}
-	subjectID, err := model.ParseCustomerID(claims.Subject)
+	customerID, err := requestedCustomerID(ctx, req.GetCustomerId())
 	if err != nil {
 		return nil, err
 	}
-	customerID, err := model.ParseCustomerID(req.GetCustomerId())
-	if err != nil {
-		return nil, err
+	params := model.VisitedTabsParams{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+		Summary:   req.GetSummary(),
 	}
-	if subjectID != customerID {
-		return nil, errors.New("not authorized")
+	if req.HasSince() {
+		since := req.GetSince().AsTime()
+		params.Since = &since
+	}
+	if req.HasUntil() {
+		until := req.GetUntil().AsTime()
+		params.Until = &until
 	}
-	tabs, err := s.TabService.GetVisitedTabs(ctx, customerID)
+	if req.HasClosed() {
+		closed := req.GetClosed()
+		params.Closed = &closed
+	}
+	page, err := s.TabService.GetVisitedTabs(ctx, customerID, params)
 	if err != nil {
 		return nil, err
 	}
 	resp := &proto.GetVisitedTabsResponse{}
 	var protoTabs []*proto.Tab
-	for _, tab := range tabs {
+	for _, tab := range page.Tabs {
 		protoTabs = append(protoTabs, modelTabToProtoTab(tab))
 	}
 	resp.SetTabs(protoTabs)
+	resp.SetNextPageToken(page.NextPageToken)
+	return resp, nil
+}
+
+func (s *TabServiceServer) GetSpendSummary(ctx context.Context, req *proto.GetSpendSummaryRequest) (*proto.SpendSummary, error) {
+	customerID, err := requestedCustomerID(ctx, req.GetCustomerId())
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.TabService.GetSpendSummary(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.SpendSummary{}
+	resp.SetTotalSpend(summary.TotalSpend)
+	resp.SetVisitCount(summary.VisitCount)
+	var protoItems []*proto.FavoriteItem
+	for _, item := range summary.FavoriteItems {
+		pitem := &proto.FavoriteItem{}
+		pitem.SetMenuItemId(item.MenuItemID.String())
+		pitem.SetName(item.Name)
+		pitem.SetQuantity(item.Quantity)
+		pitem.SetTabCount(item.TabCount)
+		protoItems = append(protoItems, pitem)
+	}
+	resp.SetFavoriteItems(protoItems)
 	return resp, nil
 }
 
+// requestedCustomerID parses the customer ID of a request, which must be the
+// authenticated one
+func requestedCustomerID(ctx context.Context, rawCustomerID string) (model.CustomerID, error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return model.CustomerID{}, errors.New("not authenticated")
+	}
+	subjectID, err := model.ParseCustomerID(claims.Subject)
+	if err != nil {
+		return model.CustomerID{}, err
+	}
+	customerID, err := model.ParseCustomerID(rawCustomerID)
+	if err != nil {
+		return model.CustomerID{}, err
+	}
+	if subjectID != customerID {
+		return model.CustomerID{}, errors.New("not authorized")
+	}
+	return customerID, nil
+}
+
 func modelTabToProtoTab(tab *model.Tab) *proto.Tab {
 	ptab := &proto.Tab{}
 	ptab.SetId(tab.ID.String())
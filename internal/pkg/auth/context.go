@@ -2,11 +2,19 @@ package auth
 
 import (
 	"context"
+	"crypto/x509"
+	"net"
+
+	"google.golang.org/grpc/peer"
 )
 
 type contextKey string
 
-const claimsContextKey contextKey = "claims"
+const (
+	claimsContextKey         contextKey = "claims"
+	clientIdentityContextKey contextKey = "client_identity"
+	clientIPContextKey       contextKey = "client_ip"
+)
 
 func NewContext(ctx context.Context, claims *Claims) context.Context {
 	return context.WithValue(ctx, claimsContextKey, claims)
@@ -16,3 +24,56 @@ func FromContext(ctx context.Context) (*Claims, bool) {
 	claims, ok := ctx.Value(claimsContextKey).(*Claims)
 	return claims, ok
 }
+
+// ClientIdentity is the identity of a client authenticated by a TLS certificate
+type ClientIdentity struct {
+	CommonName   string
+	DNSNames     []string
+	URIs         []string
+	SerialNumber string
+}
+
+func NewClientIdentity(cert *x509.Certificate) *ClientIdentity {
+	uris := make([]string, len(cert.URIs))
+	for i, uri := range cert.URIs {
+		uris[i] = uri.String()
+	}
+	return &ClientIdentity{
+		CommonName:   cert.Subject.CommonName,
+		DNSNames:     cert.DNSNames,
+		URIs:         uris,
+		SerialNumber: cert.SerialNumber.String(),
+	}
+}
+
+func NewClientIdentityContext(ctx context.Context, identity *ClientIdentity) context.Context {
+	return context.WithValue(ctx, clientIdentityContextKey, identity)
+}
+
+func ClientIdentityFromContext(ctx context.Context) (*ClientIdentity, bool) {
+	identity, ok := ctx.Value(clientIdentityContextKey).(*ClientIdentity)
+	return identity, ok
+}
+
+// NewClientIPContext records the IP address of the client, when the peer calling is
+// a proxy that forwarded it
+func NewClientIPContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPContextKey, ip)
+}
+
+// ClientIPFromContext returns the IP address of the client recorded by NewClientIPContext,
+// else of the peer calling, or an empty string if unknown
+func ClientIPFromContext(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPContextKey).(string); ok {
+		return ip
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	return host
+}
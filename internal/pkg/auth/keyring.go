@@ -0,0 +1,284 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"restaurant-ordering-system/internal/pkg/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signing algorithms of tokens
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// Keyring signs tokens with its signing key and verifies them with the key named by
+// their kid header. Holding the keys of the previous signing keys lets tokens issued
+// before a rotation be verified until they expire. Only tokens signed with the
+// algorithm of the keyring are accepted.
+type Keyring struct {
+	method       jwt.SigningMethod
+	signingKeyID string
+	signingKey   any
+	// verificationKeys are []byte for HS256, *rsa.PublicKey for RS256 and
+	// ed25519.PublicKey for EdDSA
+	verificationKeys map[string]any
+}
+
+// NewHMACKeyring returns a keyring signing and verifying HS256 tokens with a shared
+// secret. Tokens have no kid header.
+func NewHMACKeyring(secret []byte) *Keyring {
+	return &Keyring{
+		method:           jwt.SigningMethodHS256,
+		signingKey:       secret,
+		verificationKeys: map[string]any{"": secret},
+	}
+}
+
+// minRSAKeyBits is the smallest RSA key size accepted, as recommended by NIST SP 800-131A
+const minRSAKeyBits = 2048
+
+// LoadKeyring returns the keyring of cfg. For RS256 and EdDSA, every <kid>.pem file of
+// cfg.KeysDir holds a public or private key, and the private key cfg.SigningKeyID signs tokens.
+// RSA keys must have at least 2048 bits. Keys are only read here, so changes of cfg.KeysDir
+// take effect on the next restart.
+func LoadKeyring(cfg config.JWTConfig) (*Keyring, error) {
+	var method jwt.SigningMethod
+	switch cfg.Algorithm {
+	case "", AlgorithmHS256:
+		return NewHMACKeyring([]byte(cfg.Secret)), nil
+	case AlgorithmRS256:
+		method = jwt.SigningMethodRS256
+	case AlgorithmEdDSA:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unknown JWT algorithm %q", cfg.Algorithm)
+	}
+
+	files, err := filepath.Glob(filepath.Join(cfg.KeysDir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	k := &Keyring{
+		method:           method,
+		signingKeyID:     cfg.SigningKeyID,
+		verificationKeys: make(map[string]any, len(files)),
+	}
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+		privateKey, publicKey, err := loadKey(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if !k.accepts(publicKey) {
+			return nil, fmt.Errorf("%s: not a %s key", file, cfg.Algorithm)
+		}
+		if rsaKey, ok := publicKey.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("%s: RSA keys must have at least %d bits, got %d", file, minRSAKeyBits, rsaKey.N.BitLen())
+		}
+		k.verificationKeys[kid] = publicKey
+		if kid == cfg.SigningKeyID {
+			if privateKey == nil {
+				return nil, fmt.Errorf("%s: the signing key must be a private key", file)
+			}
+			k.signingKey = privateKey
+		}
+	}
+	if k.signingKey == nil {
+		return nil, fmt.Errorf("signing key %s.pem not found in %q", cfg.SigningKeyID, cfg.KeysDir)
+	}
+	return k, nil
+}
+
+// loadKey reads a PEM encoded PKCS #8, PKCS #1 or PKIX key, the private key being nil for public keys
+func loadKey(file string) (crypto.Signer, crypto.PublicKey, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("no PEM block found")
+	}
+	switch block.Type {
+	case "PRIVATE KEY", "RSA PRIVATE KEY":
+		var key any
+		if block.Type == "PRIVATE KEY" {
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		} else {
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported private key %T", key)
+		}
+		return signer, signer.Public(), nil
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		return nil, key, err
+	default:
+		return nil, nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+// accepts reports whether the public key can verify the tokens of the keyring
+func (k *Keyring) accepts(publicKey crypto.PublicKey) bool {
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		return k.method == jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		return k.method == jwt.SigningMethodEdDSA
+	default:
+		return false
+	}
+}
+
+// Sign returns the token of the claims, signed with the signing key
+func (k *Keyring) Sign(claims Claims) (string, error) {
+	token := jwt.NewWithClaims(k.method, claims)
+	if k.signingKeyID != "" {
+		token.Header["kid"] = k.signingKeyID
+	}
+	return token.SignedString(k.signingKey)
+}
+
+// Parse verifies the token and returns its claims
+func (k *Keyring) Parse(tokenString string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := k.verificationKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key ID %q", kid)
+		}
+		return key, nil
+	}, jwt.WithValidMethods([]string{k.method.Alg()}))
+	if token != nil {
+		if claims, ok := token.Claims.(*Claims); ok {
+			return claims, err
+		}
+	}
+	return nil, err
+}
+
+// JSONWebKey is a public key of a JWKS document, see RFC 7517
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	// RSA keys
+	Modulus  string `json:"n,omitempty"`
+	Exponent string `json:"e,omitempty"`
+	// Ed25519 and elliptic curve keys
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+}
+
+// PublicKey decodes the RSA, P-256 or Ed25519 public key
+func (k JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	decode := func(values ...string) ([][]byte, error) {
+		decoded := make([][]byte, len(values))
+		for i, value := range values {
+			b, err := base64.RawURLEncoding.DecodeString(value)
+			if err != nil || len(b) == 0 {
+				return nil, fmt.Errorf("invalid %s key %q", k.KeyType, k.KeyID)
+			}
+			decoded[i] = b
+		}
+		return decoded, nil
+	}
+	switch {
+	case k.KeyType == "RSA":
+		b, err := decode(k.Modulus, k.Exponent)
+		if err != nil {
+			return nil, err
+		}
+		e := new(big.Int).SetBytes(b[1])
+		if !e.IsInt64() || e.Int64() > math.MaxInt32 {
+			return nil, fmt.Errorf("invalid RSA key %q", k.KeyID)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(b[0]), E: int(e.Int64())}, nil
+	case k.KeyType == "EC" && k.Curve == "P-256":
+		b, err := decode(k.X, k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if len(b[0]) > 32 || len(b[1]) > 32 {
+			return nil, fmt.Errorf("invalid EC key %q", k.KeyID)
+		}
+		// ecdh checks that the point is on the curve
+		point := make([]byte, 65)
+		point[0] = 4
+		copy(point[33-len(b[0]):33], b[0])
+		copy(point[65-len(b[1]):], b[1])
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, fmt.Errorf("invalid EC key %q: %w", k.KeyID, err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(b[0]),
+			Y:     new(big.Int).SetBytes(b[1]),
+		}, nil
+	case k.KeyType == "OKP" && k.Curve == "Ed25519":
+		b, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(b[0]) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid OKP key %q", k.KeyID)
+		}
+		return ed25519.PublicKey(b[0]), nil
+	default:
+		return nil, fmt.Errorf("unsupported %s key %q", k.KeyType, k.KeyID)
+	}
+}
+
+// JWKS returns the JWKS document of the verification keys, so that other services can
+// verify tokens. Shared HS256 secrets are never published.
+func (k *Keyring) JWKS() ([]byte, error) {
+	keys := []JSONWebKey{}
+	for _, kid := range slices.Sorted(maps.Keys(k.verificationKeys)) {
+		jwk := JSONWebKey{KeyID: kid, Use: "sig", Algorithm: k.method.Alg()}
+		switch key := k.verificationKeys[kid].(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.Modulus = base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+			jwk.Exponent = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(key)
+		default:
+			continue
+		}
+		keys = append(keys, jwk)
+	}
+	return json.Marshal(struct {
+		Keys []JSONWebKey `json:"keys"`
+	}{Keys: keys})
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/model"
+)
+
+func writeKey(t *testing.T, dir, kid string, key crypto.Signer, private bool) {
+	t.Helper()
+	var block *pem.Block
+	if private {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	} else {
+		der, err := x509.MarshalPKIXPublicKey(key.Public())
+		require.NoError(t, err)
+		block = &pem.Block{Type: "PUBLIC KEY", Bytes: der}
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), pem.EncodeToMemory(block), 0o600))
+}
+
+func TestKeyringRotation(t *testing.T) {
+	dir := t.TempDir()
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeKey(t, dir, "2025-01", oldKey, true)
+	writeKey(t, dir, "2025-02", newKey, true)
+
+	customerID := model.CustomerID(uuid.New())
+	oldKeyring, err := LoadKeyring(config.JWTConfig{Algorithm: AlgorithmRS256, KeysDir: dir, SigningKeyID: "2025-01"})
+	require.NoError(t, err)
+	oldToken, err := NewCustomerJWTGenerator(oldKeyring, time.Hour)(customerID, 0)
+	require.NoError(t, err)
+
+	// After the rotation, tokens signed with the previous key are still accepted
+	writeKey(t, dir, "2025-01", oldKey, false)
+	keyring, err := LoadKeyring(config.JWTConfig{Algorithm: AlgorithmRS256, KeysDir: dir, SigningKeyID: "2025-02"})
+	require.NoError(t, err)
+	newToken, err := NewCustomerJWTGenerator(keyring, time.Hour)(customerID, 0)
+	require.NoError(t, err)
+	for _, token := range []string{oldToken, newToken} {
+		claims, err := NewJWTParser(keyring)(token)
+		require.NoError(t, err)
+		require.Equal(t, customerID.String(), claims.Subject)
+	}
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &Claims{})
+	require.NoError(t, err)
+	require.Equal(t, "2025-02", parsed.Header["kid"])
+
+	// The signing key must be private
+	_, err = LoadKeyring(config.JWTConfig{Algorithm: AlgorithmRS256, KeysDir: dir, SigningKeyID: "2025-01"})
+	require.Error(t, err)
+
+	// Weak RSA keys are rejected, even to only verify tokens
+	weakDir := t.TempDir()
+	weakKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	writeKey(t, weakDir, "2025-02", newKey, true)
+	writeKey(t, weakDir, "weak", weakKey, false)
+	_, err = LoadKeyring(config.JWTConfig{Algorithm: AlgorithmRS256, KeysDir: weakDir, SigningKeyID: "2025-02"})
+	require.ErrorContains(t, err, "at least 2048 bits")
+
+	var jwks struct {
+		Keys []JSONWebKey `json:"keys"`
+	}
+	data, err := keyring.JWKS()
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &jwks))
+	require.Len(t, jwks.Keys, 2)
+	require.Equal(t, "2025-01", jwks.Keys[0].KeyID)
+	require.Equal(t, "RSA", jwks.Keys[0].KeyType)
+	require.Equal(t, "AQAB", jwks.Keys[0].Exponent)
+}
+
+func TestKeyringAlgorithmPinning(t *testing.T) {
+	dir := t.TempDir()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	writeKey(t, dir, "ed", key, true)
+	keyring, err := LoadKeyring(config.JWTConfig{Algorithm: AlgorithmEdDSA, KeysDir: dir, SigningKeyID: "ed"})
+	require.NoError(t, err)
+
+	token, err := NewAdminJWTGenerator(keyring, time.Hour)()
+	require.NoError(t, err)
+	claims, err := keyring.Parse(token)
+	require.NoError(t, err)
+	require.Equal(t, AdminRole, claims.Role)
+
+	// HS256 tokens are rejected, even when signed with the public key as secret
+	hmacToken, err := GenerateAdminJWT(key.Public().(ed25519.PublicKey), time.Hour)
+	require.NoError(t, err)
+	_, err = keyring.Parse(hmacToken)
+	require.Error(t, err)
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, adminClaims(time.Hour)).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	_, err = keyring.Parse(unsigned)
+	require.Error(t, err)
+
+	// Keys of another algorithm are not loaded
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeKey(t, dir, "rsa", rsaKey, false)
+	_, err = LoadKeyring(config.JWTConfig{Algorithm: AlgorithmEdDSA, KeysDir: dir, SigningKeyID: "ed"})
+	require.Error(t, err)
+
+	// HS256 keyrings do not publish their secret
+	_, err = ParseJWT(token, []byte("secret"))
+	require.Error(t, err)
+	data, err := NewHMACKeyring([]byte("secret")).JWKS()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"keys": []}`, string(data))
+}
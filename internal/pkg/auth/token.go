@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"restaurant-ordering-system/internal/pkg/model"
 	"time"
 
@@ -9,6 +10,9 @@ import (
 
 type Claims struct {
 	Role Role `json:"role"`
+	// SessionVersion is the version of the sessions of the customer the token was
+	// issued in, revoking them increments it
+	SessionVersion int64 `json:"sv,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -19,18 +23,24 @@ const (
 	CustomerRole Role = "customer"
 )
 
-type CustomerJWTGenerator func(customerID model.CustomerID) (string, error)
+type CustomerJWTGenerator func(customerID model.CustomerID, sessionVersion int64) (string, error)
 
-func NewCustomerJWTGenerator(key []byte, ttl time.Duration) CustomerJWTGenerator {
-	return func(customerID model.CustomerID) (string, error) {
-		return GenerateCustomerJWT(customerID, key, ttl)
+func NewCustomerJWTGenerator(keyring *Keyring, ttl time.Duration) CustomerJWTGenerator {
+	return func(customerID model.CustomerID, sessionVersion int64) (string, error) {
+		return keyring.Sign(customerClaims(customerID, sessionVersion, ttl))
 	}
 }
 
-func GenerateCustomerJWT(customerID model.CustomerID, key []byte, ttl time.Duration) (string, error) {
+// GenerateCustomerJWT returns a customer token signed with the HS256 secret key
+func GenerateCustomerJWT(customerID model.CustomerID, sessionVersion int64, key []byte, ttl time.Duration) (string, error) {
+	return NewHMACKeyring(key).Sign(customerClaims(customerID, sessionVersion, ttl))
+}
+
+func customerClaims(customerID model.CustomerID, sessionVersion int64, ttl time.Duration) Claims {
 	now := time.Now()
-	claims := Claims{
-		Role: CustomerRole,
+	return Claims{
+		Role:           CustomerRole,
+		SessionVersion: sessionVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   customerID.String(),
 			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
@@ -38,21 +48,24 @@ func GenerateCustomerJWT(customerID model.CustomerID, key []byte, ttl time.Durat
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(key))
 }
 
 type AdminJWTGenerator func() (string, error)
 
-func NewAdminJWTGenerator(key []byte, ttl time.Duration) AdminJWTGenerator {
+func NewAdminJWTGenerator(keyring *Keyring, ttl time.Duration) AdminJWTGenerator {
 	return func() (string, error) {
-		return GenerateAdminJWT(key, ttl)
+		return keyring.Sign(adminClaims(ttl))
 	}
 }
 
+// GenerateAdminJWT returns an admin token signed with the HS256 secret key
 func GenerateAdminJWT(key []byte, ttl time.Duration) (string, error) {
+	return NewHMACKeyring(key).Sign(adminClaims(ttl))
+}
+
+func adminClaims(ttl time.Duration) Claims {
 	now := time.Now()
-	claims := Claims{
+	return Claims{
 		Role: AdminRole,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
@@ -60,26 +73,18 @@ func GenerateAdminJWT(key []byte, ttl time.Duration) (string, error) {
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(key))
 }
 
 type JWTParser func(tokenString string) (*Claims, error)
 
-func NewJWTParser(key []byte) JWTParser {
-	return func(tokenString string) (*Claims, error) {
-		return ParseJWT(tokenString, key)
-	}
+// RevocationChecker reports whether the session of the parsed token was revoked
+type RevocationChecker func(ctx context.Context, claims *Claims) (bool, error)
+
+func NewJWTParser(keyring *Keyring) JWTParser {
+	return keyring.Parse
 }
 
+// ParseJWT verifies a token signed with the HS256 secret key and returns its claims
 func ParseJWT(tokenString string, key []byte) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (any, error) {
-		return key, nil
-	})
-	if token != nil {
-		if claims, ok := token.Claims.(*Claims); ok {
-			return claims, err
-		}
-	}
-	return nil, err
+	return NewHMACKeyring(key).Parse(tokenString)
 }
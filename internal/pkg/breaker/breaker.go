@@ -0,0 +1,141 @@
+// Package breaker provides a circuit breaker that stops calling a failing dependency
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned by Allow while the breaker is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the state of a Breaker
+type State int
+
+const (
+	// Closed lets every call through
+	Closed State = iota
+	// Open rejects every call until the open timeout has elapsed
+	Open
+	// HalfOpen lets a single probe call through to decide whether to close again
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker opens after a number of consecutive failures and closes again
+// once a probe call succeeds. It is safe for concurrent use.
+type Breaker struct {
+	failureThreshold int
+	openTimeout      time.Duration
+	onStateChange    func(from, to State)
+	now              func() time.Time
+
+	mu           sync.Mutex
+	state        State
+	failures     int
+	openedAt     time.Time
+	probeStarted time.Time
+}
+
+// New creates a closed breaker which opens after failureThreshold consecutive
+// failures and lets a probe call through once openTimeout has elapsed
+func New(failureThreshold int, openTimeout time.Duration) *Breaker {
+	return &Breaker{
+		failureThreshold: max(failureThreshold, 1),
+		openTimeout:      openTimeout,
+		now:              time.Now,
+	}
+}
+
+// OnStateChange sets fn to be called after every state transition.
+// fn is called with the breaker locked and must not call the breaker.
+func (b *Breaker) OnStateChange(fn func(from, to State)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.onStateChange = fn
+}
+
+// State returns the current state
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow reports whether a call may proceed. Every allowed call must be
+// followed by Success or Failure.
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	switch b.state {
+	case Open:
+		if now.Sub(b.openedAt) < b.openTimeout {
+			return ErrOpen
+		}
+		b.setState(HalfOpen)
+		b.probeStarted = now
+		return nil
+	case HalfOpen:
+		// Only one probe at a time, unless the last one never reported back
+		if now.Sub(b.probeStarted) < b.openTimeout {
+			return ErrOpen
+		}
+		b.probeStarted = now
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Success records a successful call
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	if b.state != Closed {
+		b.setState(Closed)
+	}
+}
+
+// Failure records a failed call
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Closed:
+		b.failures++
+		if b.failures < b.failureThreshold {
+			return
+		}
+	case Open:
+		return // A call allowed before the breaker opened
+	}
+	b.failures = 0
+	b.openedAt = b.now()
+	b.setState(Open)
+}
+
+func (b *Breaker) setState(state State) {
+	from := b.state
+	b.state = state
+	if b.onStateChange != nil {
+		b.onStateChange(from, state)
+	}
+}
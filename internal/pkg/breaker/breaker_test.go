@@ -0,0 +1,96 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func newTestBreaker(failureThreshold int, openTimeout time.Duration) (*Breaker, *clock) {
+	c := &clock{now: time.Unix(0, 0)}
+	b := New(failureThreshold, openTimeout)
+	b.now = c.Now
+	return b, c
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	b, _ := newTestBreaker(3, time.Second)
+
+	for range 2 {
+		require.NoError(t, b.Allow())
+		b.Failure()
+	}
+	require.NoError(t, b.Allow())
+	b.Success()
+	for range 2 {
+		require.NoError(t, b.Allow())
+		b.Failure()
+	}
+	require.Equal(t, Closed, b.State())
+
+	require.NoError(t, b.Allow())
+	b.Failure()
+	require.Equal(t, Open, b.State())
+	require.ErrorIs(t, b.Allow(), ErrOpen)
+}
+
+func TestBreakerClosesAfterSuccessfulProbe(t *testing.T) {
+	b, c := newTestBreaker(1, time.Second)
+	var transitions []State
+	b.OnStateChange(func(_, to State) {
+		transitions = append(transitions, to)
+	})
+
+	require.NoError(t, b.Allow())
+	b.Failure()
+	c.now = c.now.Add(999 * time.Millisecond)
+	require.ErrorIs(t, b.Allow(), ErrOpen)
+
+	c.now = c.now.Add(time.Millisecond)
+	require.NoError(t, b.Allow())
+	require.Equal(t, HalfOpen, b.State())
+	require.ErrorIs(t, b.Allow(), ErrOpen, "only one probe at a time")
+
+	b.Success()
+	require.Equal(t, Closed, b.State())
+	require.NoError(t, b.Allow())
+	require.Equal(t, []State{Open, HalfOpen, Closed}, transitions)
+}
+
+func TestBreakerReopensAfterFailedProbe(t *testing.T) {
+	b, c := newTestBreaker(1, time.Second)
+
+	require.NoError(t, b.Allow())
+	b.Failure()
+	c.now = c.now.Add(time.Second)
+	require.NoError(t, b.Allow())
+	b.Failure()
+	require.Equal(t, Open, b.State())
+
+	c.now = c.now.Add(time.Second / 2)
+	require.ErrorIs(t, b.Allow(), ErrOpen)
+	c.now = c.now.Add(time.Second / 2)
+	require.NoError(t, b.Allow())
+}
+
+func TestBreakerReplacesLostProbe(t *testing.T) {
+	b, c := newTestBreaker(1, time.Second)
+
+	require.NoError(t, b.Allow())
+	b.Failure()
+	c.now = c.now.Add(time.Second)
+	require.NoError(t, b.Allow())
+
+	c.now = c.now.Add(time.Second)
+	require.NoError(t, b.Allow())
+	require.Equal(t, HalfOpen, b.State())
+}
@@ -1,6 +1,14 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -8,46 +16,339 @@ import (
 
 // Config represents the application configuration
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
+	Server    ServerConfig    `mapstructure:"server"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	Redis     RedisConfig     `mapstructure:"redis"`
+	JWT       JWTConfig       `mapstructure:"jwt"`
+	Account   AccountConfig   `mapstructure:"account"`
+	Mailer    MailerConfig    `mapstructure:"mailer"`
+	Limits    LimitsConfig    `mapstructure:"limits"`
+	Storage   StorageConfig   `mapstructure:"storage"`
+	Photos    PhotosConfig    `mapstructure:"photos"`
+	Telemetry TelemetryConfig `mapstructure:"telemetry"`
 }
 
-// ServerConfig represents the server configuration
+// ServerConfig represents the server configuration.
+// TrustedProxies lists the addresses or CIDR prefixes of the reverse proxies whose
+// X-Forwarded-For and Forwarded headers tell the IP address of their client.
 type ServerConfig struct {
-	Host string `mapstructure:"host"`
-	Port int    `mapstructure:"port"`
+	Host           string            `mapstructure:"host"`
+	Port           int               `mapstructure:"port"`
+	Reflection     bool              `mapstructure:"reflection"`
+	TrustedProxies []string          `mapstructure:"trustedProxies"`
+	HealthCheck    HealthCheckConfig `mapstructure:"healthCheck"`
+	Gateway        GatewayConfig     `mapstructure:"gateway"`
+	TLS            TLSConfig         `mapstructure:"tls"`
 }
 
-// DatabaseConfig represents the database configuration
+// TrustedProxyPrefixes returns the trusted proxies, addresses being prefixes of
+// their full length. Invalid entries, rejected by Config.Validate, are left out.
+func (c ServerConfig) TrustedProxyPrefixes() []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(c.TrustedProxies))
+	for _, proxy := range c.TrustedProxies {
+		if prefix, err := parsePrefix(proxy); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// parsePrefix parses a CIDR prefix or a single address
+func parsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+}
+
+// TLSConfig represents the TLS configuration of the server.
+// Mode is one of "off", "tls" or "mtls". In mTLS mode, clients must present a
+// certificate signed by a CA of ClientCAFile. Certificates are reloaded when their
+// files change, checking every ReloadInterval unless it is zero.
+type TLSConfig struct {
+	Mode           string        `mapstructure:"mode"`
+	CertFile       string        `mapstructure:"certFile"`
+	KeyFile        string        `mapstructure:"keyFile"`
+	ClientCAFile   string        `mapstructure:"clientCAFile"`
+	ReloadInterval time.Duration `mapstructure:"reloadInterval"`
+}
+
+// GatewayConfig represents the HTTP/JSON and gRPC-Web gateway configuration.
+// A zero Port serves the gateway on the gRPC port.
+type GatewayConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Port            int           `mapstructure:"port"`
+	CORS            CORSConfig    `mapstructure:"cors"`
+	EventsHeartbeat time.Duration `mapstructure:"eventsHeartbeat"`
+}
+
+// CORSConfig represents which origins may call the gateway from a browser.
+// "*" allows every origin.
+type CORSConfig struct {
+	AllowedOrigins []string      `mapstructure:"allowedOrigins"`
+	MaxAge         time.Duration `mapstructure:"maxAge"`
+}
+
+// HealthCheckConfig represents how often the dependencies of the server are checked
+type HealthCheckConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// DatabaseConfig represents the database configuration.
+// StatementCacheMode is the pgx query execution mode: "cache_statement",
+// "cache_describe", "describe_exec", "exec" or "simple_protocol", the latter ones
+// being needed behind poolers such as PgBouncer in transaction mode.
+// A zero StatementTimeout leaves statements unbounded.
 type DatabaseConfig struct {
-	Host     string `mapstructure:"host"`
-	Port     int    `mapstructure:"port"`
-	User     string `mapstructure:"user"`
-	Password string `mapstructure:"password"`
-	Database string `mapstructure:"database"`
-	SSLMode  string `mapstructure:"sslMode"`
+	Host               string        `mapstructure:"host"`
+	Port               int           `mapstructure:"port"`
+	User               string        `mapstructure:"user"`
+	Password           string        `mapstructure:"password"`
+	Database           string        `mapstructure:"database"`
+	SSLMode            string        `mapstructure:"sslMode"`
+	StatementTimeout   time.Duration `mapstructure:"statementTimeout"`
+	StatementCacheMode string        `mapstructure:"statementCacheMode"`
+	Pool               PoolConfig    `mapstructure:"pool"`
+}
+
+// PoolConfig represents the database connection pool configuration
+type PoolConfig struct {
+	MaxConns          int32         `mapstructure:"maxConns"`
+	MinConns          int32         `mapstructure:"minConns"`
+	MaxConnLifetime   time.Duration `mapstructure:"maxConnLifetime"`
+	MaxConnIdleTime   time.Duration `mapstructure:"maxConnIdleTime"`
+	HealthCheckPeriod time.Duration `mapstructure:"healthCheckPeriod"`
 }
 
-// RedisConfig represents the redis configuration
+// RedisConfig represents the redis configuration.
+// Mode is one of "standalone", "sentinel" or "cluster". Addrs lists the sentinel
+// or cluster nodes and defaults to Host and Port. Username selects an ACL user,
+// and DB must be 0 in cluster mode. A zero PoolSize uses 10 connections per CPU.
+// EventsPoolSize bounds the separate pool of the blocking reads following tab events,
+// which hold a connection per connected client.
 type RedisConfig struct {
-	Host string `mapstructure:"host"`
-	Port int    `mapstructure:"port"`
+	Mode           string         `mapstructure:"mode"`
+	Host           string         `mapstructure:"host"`
+	Port           int            `mapstructure:"port"`
+	Addrs          []string       `mapstructure:"addrs"`
+	MasterName     string         `mapstructure:"masterName"`
+	Username       string         `mapstructure:"username"`
+	Password       string         `mapstructure:"password"`
+	DB             int            `mapstructure:"db"`
+	PoolSize       int            `mapstructure:"poolSize"`
+	EventsPoolSize int            `mapstructure:"eventsPoolSize"`
+	MinIdleConns   int            `mapstructure:"minIdleConns"`
+	DialTimeout    time.Duration  `mapstructure:"dialTimeout"`
+	ReadTimeout    time.Duration  `mapstructure:"readTimeout"`
+	WriteTimeout   time.Duration  `mapstructure:"writeTimeout"`
+	TLS            RedisTLSConfig `mapstructure:"tls"`
+	Breaker        BreakerConfig  `mapstructure:"breaker"`
+}
+
+// RedisTLSConfig represents the TLS configuration of the redis connections.
+// The server certificate is verified against CAFile, or the system roots if empty.
+type RedisTLSConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	CAFile     string `mapstructure:"caFile"`
+	ServerName string `mapstructure:"serverName"`
+}
+
+// BreakerConfig represents the circuit breaker guarding redis.
+// The breaker opens after FailureThreshold consecutive failures and lets a
+// probe command through once OpenTimeout has elapsed. A zero FailureThreshold
+// disables the breaker.
+type BreakerConfig struct {
+	FailureThreshold int           `mapstructure:"failureThreshold"`
+	OpenTimeout      time.Duration `mapstructure:"openTimeout"`
+}
+
+// LimitsConfig represents the limits protecting the server from abusive clients
+type LimitsConfig struct {
+	RateLimit RateLimitConfig `mapstructure:"rateLimit"`
+	Tab       TabLimitsConfig `mapstructure:"tab"`
+}
+
+// RateLimitConfig represents the token buckets, kept in Redis, limiting the calls of
+// every method. Each call takes a token from the bucket of its client IP, and calls
+// about a tab also from the bucket of the tab.
+type RateLimitConfig struct {
+	Enabled bool      `mapstructure:"enabled"`
+	PerIP   RateLimit `mapstructure:"perIP"`
+	PerTab  RateLimit `mapstructure:"perTab"`
 }
 
+// RateLimit represents a token bucket refilled with Rate tokens per second and
+// holding up to Burst tokens
+type RateLimit struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
+}
+
+// TabLimitsConfig represents the maximum number of guests of a tab, of items in
+// the order not sent yet, and the maximum quantity of an order item
+type TabLimitsConfig struct {
+	MaxGuests     int `mapstructure:"maxGuests"`
+	MaxDraftItems int `mapstructure:"maxDraftItems"`
+	MaxQuantity   int `mapstructure:"maxQuantity"`
+}
+
+// JWTConfig represents how tokens are signed. Algorithm is "HS256" to sign them with
+// the shared Secret, or "RS256" or "EdDSA" to sign them with the private key
+// SigningKeyID among the <kid>.pem keys of KeysDir, which all verify tokens.
 type JWTConfig struct {
-	Secret string        `mapstructure:"secret"`
-	Expiry time.Duration `mapstructure:"expiry"`
+	Algorithm    string        `mapstructure:"algorithm"`
+	Secret       string        `mapstructure:"secret"`
+	KeysDir      string        `mapstructure:"keysDir"`
+	SigningKeyID string        `mapstructure:"signingKeyID"`
+	Expiry       time.Duration `mapstructure:"expiry"`
+}
+
+// AccountConfig represents the email verification and password reset configuration.
+// The links sent to customers are the URLs with a token query parameter added.
+type AccountConfig struct {
+	EmailVerificationURL string        `mapstructure:"emailVerificationURL"`
+	EmailVerificationTTL time.Duration `mapstructure:"emailVerificationTTL"`
+	PasswordResetURL     string        `mapstructure:"passwordResetURL"`
+	PasswordResetTTL     time.Duration `mapstructure:"passwordResetTTL"`
+	Login                LoginConfig   `mapstructure:"login"`
+	OIDC                 OIDCConfig    `mapstructure:"oidc"`
+}
+
+// LoginConfig represents the protection of logins against brute force. Once a login ID
+// or a client IP reaches its maximum failed attempts within FailureWindow, it is locked
+// out for Lockout, doubled by every further failure up to MaxLockout.
+type LoginConfig struct {
+	MaxFailuresPerLogin int           `mapstructure:"maxFailuresPerLogin"`
+	MaxFailuresPerIP    int           `mapstructure:"maxFailuresPerIP"`
+	FailureWindow       time.Duration `mapstructure:"failureWindow"`
+	Lockout             time.Duration `mapstructure:"lockout"`
+	MaxLockout          time.Duration `mapstructure:"maxLockout"`
+}
+
+// OIDCConfig represents the OpenID Connect providers customers can log in with.
+// Providers redirect customers to RedirectURL with code and state query parameters,
+// which the frontend passes on to complete the login within StateTTL. Customers
+// without a password confirm sensitive changes with a login within ReauthWindow.
+type OIDCConfig struct {
+	RedirectURL  string               `mapstructure:"redirectURL"`
+	StateTTL     time.Duration        `mapstructure:"stateTTL"`
+	ReauthWindow time.Duration        `mapstructure:"reauthWindow"`
+	Providers    []OIDCProviderConfig `mapstructure:"providers"`
+}
+
+// OIDCProviderConfig represents an OpenID Connect provider, e.g. Google with the
+// https://accounts.google.com Issuer. Its endpoints are discovered from
+// <Issuer>/.well-known/openid-configuration, and Scopes are requested besides openid.
+type OIDCProviderConfig struct {
+	Name         string   `mapstructure:"name"`
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"clientID"`
+	ClientSecret string   `mapstructure:"clientSecret"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+// MailerConfig represents how emails are sent.
+// Driver is one of "smtp", "file" to append them to File, or "log".
+type MailerConfig struct {
+	Driver string     `mapstructure:"driver"`
+	From   string     `mapstructure:"from"`
+	File   string     `mapstructure:"file"`
+	SMTP   SMTPConfig `mapstructure:"smtp"`
+}
+
+// SMTPConfig represents the SMTP server sending emails.
+// Timeout bounds the whole exchange with the server of every message.
+type SMTPConfig struct {
+	Host     string        `mapstructure:"host"`
+	Port     int           `mapstructure:"port"`
+	Username string        `mapstructure:"username"`
+	Password string        `mapstructure:"password"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// StorageConfig represents where uploaded files, e.g. the photos of menu items, are stored.
+// Driver is "local" to write them under Local.Dir, or "s3" to put them in an
+// S3-compatible bucket. Files are served at BaseURL followed by their key, which
+// defaults to the URL of the bucket with the s3 driver.
+type StorageConfig struct {
+	Driver  string             `mapstructure:"driver"`
+	BaseURL string             `mapstructure:"baseURL"`
+	Local   LocalStorageConfig `mapstructure:"local"`
+	S3      S3StorageConfig    `mapstructure:"s3"`
+}
+
+// LocalStorageConfig represents the directory files are written to by the local
+// driver. The gateway serves it at /media/ when enabled.
+type LocalStorageConfig struct {
+	Dir string `mapstructure:"dir"`
+}
+
+// S3StorageConfig represents an S3-compatible bucket, e.g. on AWS with the
+// https://s3.eu-west-1.amazonaws.com Endpoint, or on MinIO. PathStyle addresses
+// the bucket in the path of the URLs rather than in their host name, as MinIO expects.
+// Timeout bounds every request to the bucket, including reading its response.
+type S3StorageConfig struct {
+	Endpoint        string        `mapstructure:"endpoint"`
+	Region          string        `mapstructure:"region"`
+	Bucket          string        `mapstructure:"bucket"`
+	AccessKeyID     string        `mapstructure:"accessKeyID"`
+	SecretAccessKey string        `mapstructure:"secretAccessKey"`
+	PathStyle       bool          `mapstructure:"pathStyle"`
+	Timeout         time.Duration `mapstructure:"timeout"`
+}
+
+// PhotosConfig represents the photos uploaded for menu items. Uploads larger than
+// MaxSize bytes or MaxPixels pixels are rejected. The original is stored along with
+// a JPEG variant of every width of VariantWidths narrower than it.
+type PhotosConfig struct {
+	MaxSize       int64          `mapstructure:"maxSize"`
+	MaxPixels     int            `mapstructure:"maxPixels"`
+	JPEGQuality   int            `mapstructure:"jpegQuality"`
+	VariantWidths map[string]int `mapstructure:"variantWidths"`
+}
+
+// TelemetryConfig represents the observability configuration
+type TelemetryConfig struct {
+	Metrics MetricsConfig `mapstructure:"metrics"`
+}
+
+// MetricsConfig represents the metrics exporter configuration.
+// Exporter is one of "none", "stdout" or "otlp".
+type MetricsConfig struct {
+	Exporter string        `mapstructure:"exporter"`
+	Endpoint string        `mapstructure:"endpoint"`
+	Insecure bool          `mapstructure:"insecure"`
+	Interval time.Duration `mapstructure:"interval"`
 }
 
-// LoadConfig loads the configuration using Viper
+// envPrefix prefixes the environment variables overriding the configuration,
+// e.g. ROS_DATABASE_HOST overrides database.host
+const envPrefix = "ROS"
+
+// LoadConfig loads the configuration using Viper, from the defaults, the JSON file
+// at path if not empty, and the environment, which takes precedence. The value of
+// any key can also be read from a file named by the same variable suffixed with
+// _FILE, e.g. ROS_JWT_SECRET_FILE, to pass secrets without exposing them in the environment.
 func LoadConfig(path string) (*Config, error) {
 	v := viper.New()
-	v.SetConfigFile(path)
+	setDefaults(v)
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 
-	if err := v.ReadInConfig(); err != nil {
+	if path != "" {
+		v.SetConfigFile(path)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, err
+		}
+	}
+	if err := readSecretFiles(v); err != nil {
 		return nil, err
 	}
 
@@ -55,6 +356,330 @@ func LoadConfig(path string) (*Config, error) {
 	if err := v.Unmarshal(&config); err != nil {
 		return nil, err
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 
 	return &config, nil
 }
+
+// setDefaults registers a default for every key, which also lets the
+// environment override keys missing from the configuration file
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("server.host", "0.0.0.0")
+	v.SetDefault("server.port", 50051)
+	v.SetDefault("server.reflection", false)
+	v.SetDefault("server.trustedProxies", []string{})
+	v.SetDefault("server.healthCheck.interval", 5*time.Second)
+	v.SetDefault("server.healthCheck.timeout", time.Second)
+	v.SetDefault("server.gateway.enabled", false)
+	v.SetDefault("server.gateway.port", 0)
+	v.SetDefault("server.gateway.cors.allowedOrigins", []string{})
+	v.SetDefault("server.gateway.cors.maxAge", 10*time.Minute)
+	v.SetDefault("server.gateway.eventsHeartbeat", 15*time.Second)
+	v.SetDefault("server.tls.mode", "tls")
+	v.SetDefault("server.tls.certFile", "certs/server_cert.pem")
+	v.SetDefault("server.tls.keyFile", "certs/server_key.pem")
+	v.SetDefault("server.tls.clientCAFile", "")
+	v.SetDefault("server.tls.reloadInterval", time.Minute)
+
+	v.SetDefault("database.host", "localhost")
+	v.SetDefault("database.port", 5432)
+	v.SetDefault("database.user", "postgres")
+	v.SetDefault("database.password", "")
+	v.SetDefault("database.database", "restaurant")
+	v.SetDefault("database.sslMode", "disable")
+	v.SetDefault("database.statementTimeout", 30*time.Second)
+	v.SetDefault("database.statementCacheMode", "cache_statement")
+	v.SetDefault("database.pool.maxConns", 10)
+	v.SetDefault("database.pool.minConns", 0)
+	v.SetDefault("database.pool.maxConnLifetime", time.Hour)
+	v.SetDefault("database.pool.maxConnIdleTime", 30*time.Minute)
+	v.SetDefault("database.pool.healthCheckPeriod", time.Minute)
+
+	v.SetDefault("redis.mode", "standalone")
+	v.SetDefault("redis.host", "localhost")
+	v.SetDefault("redis.port", 6379)
+	v.SetDefault("redis.addrs", []string{})
+	v.SetDefault("redis.masterName", "")
+	v.SetDefault("redis.username", "")
+	v.SetDefault("redis.password", "")
+	v.SetDefault("redis.db", 0)
+	v.SetDefault("redis.poolSize", 0)
+	v.SetDefault("redis.eventsPoolSize", 100)
+	v.SetDefault("redis.minIdleConns", 0)
+	v.SetDefault("redis.dialTimeout", 5*time.Second)
+	v.SetDefault("redis.readTimeout", 3*time.Second)
+	v.SetDefault("redis.writeTimeout", 3*time.Second)
+	v.SetDefault("redis.tls.enabled", false)
+	v.SetDefault("redis.tls.caFile", "")
+	v.SetDefault("redis.tls.serverName", "")
+	v.SetDefault("redis.breaker.failureThreshold", 5)
+	v.SetDefault("redis.breaker.openTimeout", 10*time.Second)
+
+	v.SetDefault("jwt.algorithm", "HS256")
+	v.SetDefault("jwt.secret", "")
+	v.SetDefault("jwt.keysDir", "keys")
+	v.SetDefault("jwt.signingKeyID", "")
+	v.SetDefault("jwt.expiry", 3*time.Hour)
+
+	v.SetDefault("account.emailVerificationURL", "http://localhost:3000/verify-email")
+	v.SetDefault("account.emailVerificationTTL", 24*time.Hour)
+	v.SetDefault("account.passwordResetURL", "http://localhost:3000/reset-password")
+	v.SetDefault("account.passwordResetTTL", time.Hour)
+	v.SetDefault("account.login.maxFailuresPerLogin", 5)
+	v.SetDefault("account.login.maxFailuresPerIP", 50)
+	v.SetDefault("account.login.failureWindow", 15*time.Minute)
+	v.SetDefault("account.login.lockout", time.Minute)
+	v.SetDefault("account.login.maxLockout", time.Hour)
+	v.SetDefault("account.oidc.redirectURL", "http://localhost:3000/oidc/callback")
+	v.SetDefault("account.oidc.stateTTL", 10*time.Minute)
+	v.SetDefault("account.oidc.reauthWindow", 5*time.Minute)
+	v.SetDefault("account.oidc.providers", []OIDCProviderConfig{})
+
+	v.SetDefault("limits.rateLimit.enabled", true)
+	v.SetDefault("limits.rateLimit.perIP.rate", 20)
+	v.SetDefault("limits.rateLimit.perIP.burst", 40)
+	v.SetDefault("limits.rateLimit.perTab.rate", 10)
+	v.SetDefault("limits.rateLimit.perTab.burst", 30)
+	v.SetDefault("limits.tab.maxGuests", 50)
+	v.SetDefault("limits.tab.maxDraftItems", 200)
+	v.SetDefault("limits.tab.maxQuantity", 99)
+
+	v.SetDefault("mailer.driver", "log")
+	v.SetDefault("mailer.from", "Restaurant <no-reply@localhost>")
+	v.SetDefault("mailer.file", "mail.log")
+	v.SetDefault("mailer.smtp.host", "localhost")
+	v.SetDefault("mailer.smtp.port", 587)
+	v.SetDefault("mailer.smtp.username", "")
+	v.SetDefault("mailer.smtp.password", "")
+	v.SetDefault("mailer.smtp.timeout", 30*time.Second)
+
+	v.SetDefault("storage.driver", "local")
+	v.SetDefault("storage.baseURL", "http://localhost:50051/media")
+	v.SetDefault("storage.local.dir", "media")
+	v.SetDefault("storage.s3.endpoint", "")
+	v.SetDefault("storage.s3.region", "us-east-1")
+	v.SetDefault("storage.s3.bucket", "")
+	v.SetDefault("storage.s3.accessKeyID", "")
+	v.SetDefault("storage.s3.secretAccessKey", "")
+	v.SetDefault("storage.s3.pathStyle", false)
+	v.SetDefault("storage.s3.timeout", 30*time.Second)
+
+	v.SetDefault("photos.maxSize", 10<<20)
+	v.SetDefault("photos.maxPixels", 40_000_000)
+	v.SetDefault("photos.jpegQuality", 85)
+	v.SetDefault("photos.variantWidths", map[string]int{"thumbnail": 160, "medium": 640, "large": 1280})
+
+	v.SetDefault("telemetry.metrics.exporter", "none")
+	v.SetDefault("telemetry.metrics.endpoint", "localhost:4317")
+	v.SetDefault("telemetry.metrics.insecure", true)
+	v.SetDefault("telemetry.metrics.interval", 30*time.Second)
+}
+
+// readSecretFiles sets every key whose _FILE environment variable is set
+// to the content of the file it names
+func readSecretFiles(v *viper.Viper) error {
+	replacer := strings.NewReplacer(".", "_")
+	for _, key := range v.AllKeys() {
+		env := envPrefix + "_" + strings.ToUpper(replacer.Replace(key)) + "_FILE"
+		file, ok := os.LookupEnv(env)
+		if !ok {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("%s: %w", env, err)
+		}
+		v.Set(key, strings.TrimRight(string(data), "\r\n"))
+	}
+	return nil
+}
+
+// Validate reports every invalid setting of the configuration
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	validPort := func(port int) bool { return port > 0 && port <= 65535 }
+
+	check(validPort(c.Server.Port), "server.port must be between 1 and 65535, got %d", c.Server.Port)
+	for _, proxy := range c.Server.TrustedProxies {
+		_, err := parsePrefix(proxy)
+		check(err == nil, "server.trustedProxies must list IP addresses or CIDR prefixes, got %q", proxy)
+	}
+	check(c.Server.Gateway.Port >= 0 && c.Server.Gateway.Port <= 65535,
+		"server.gateway.port must be between 0 and 65535, got %d", c.Server.Gateway.Port)
+	check(c.Server.Gateway.Port == 0 || c.Server.Gateway.Port != c.Server.Port,
+		"server.gateway.port must differ from server.port, use 0 to share it")
+	switch c.Server.TLS.Mode {
+	case "off":
+	case "tls", "mtls":
+		check(c.Server.TLS.CertFile != "" && c.Server.TLS.KeyFile != "",
+			"server.tls.certFile and server.tls.keyFile are required in %s mode", c.Server.TLS.Mode)
+		check(c.Server.TLS.Mode != "mtls" || c.Server.TLS.ClientCAFile != "",
+			"server.tls.clientCAFile is required in mtls mode")
+	case "":
+		errs = append(errs, fmt.Errorf("server.tls.mode is required, use off to disable TLS"))
+	default:
+		errs = append(errs, fmt.Errorf("server.tls.mode must be off, tls or mtls, got %q", c.Server.TLS.Mode))
+	}
+
+	check(c.Database.Host != "", "database.host is required")
+	check(validPort(c.Database.Port), "database.port must be between 1 and 65535, got %d", c.Database.Port)
+	check(c.Database.Database != "", "database.database is required")
+	check(c.Database.StatementTimeout >= 0, "database.statementTimeout must not be negative")
+	switch c.Database.StatementCacheMode {
+	case "cache_statement", "cache_describe", "describe_exec", "exec", "simple_protocol":
+	default:
+		errs = append(errs, fmt.Errorf("database.statementCacheMode must be cache_statement, cache_describe, "+
+			"describe_exec, exec or simple_protocol, got %q", c.Database.StatementCacheMode))
+	}
+	check(c.Database.Pool.MaxConns > 0, "database.pool.maxConns must be positive, got %d", c.Database.Pool.MaxConns)
+	check(c.Database.Pool.MinConns >= 0 && c.Database.Pool.MinConns <= c.Database.Pool.MaxConns,
+		"database.pool.minConns must be between 0 and database.pool.maxConns, got %d", c.Database.Pool.MinConns)
+	check(c.Database.Pool.MaxConnLifetime > 0, "database.pool.maxConnLifetime must be positive")
+	check(c.Database.Pool.MaxConnIdleTime > 0, "database.pool.maxConnIdleTime must be positive")
+	check(c.Database.Pool.HealthCheckPeriod > 0, "database.pool.healthCheckPeriod must be positive")
+
+	switch c.Redis.Mode {
+	case "standalone":
+		check(len(c.Redis.Addrs) > 0 || validPort(c.Redis.Port),
+			"redis.port must be between 1 and 65535, got %d", c.Redis.Port)
+	case "sentinel":
+		check(len(c.Redis.Addrs) > 0, "redis.addrs is required in sentinel mode")
+		check(c.Redis.MasterName != "", "redis.masterName is required in sentinel mode")
+	case "cluster":
+		check(len(c.Redis.Addrs) > 0, "redis.addrs is required in cluster mode")
+		check(c.Redis.DB == 0, "redis.db must be 0 in cluster mode")
+	default:
+		errs = append(errs, fmt.Errorf("redis.mode must be standalone, sentinel or cluster, got %q", c.Redis.Mode))
+	}
+	check(c.Redis.DB >= 0, "redis.db must not be negative")
+	check(c.Redis.PoolSize >= 0, "redis.poolSize must not be negative")
+	check(c.Redis.EventsPoolSize > 0, "redis.eventsPoolSize must be positive")
+	check(c.Redis.MinIdleConns >= 0, "redis.minIdleConns must not be negative")
+	check(c.Redis.Breaker.FailureThreshold >= 0, "redis.breaker.failureThreshold must not be negative")
+	check(c.Redis.Breaker.FailureThreshold == 0 || c.Redis.Breaker.OpenTimeout > 0,
+		"redis.breaker.openTimeout must be positive")
+
+	switch c.JWT.Algorithm {
+	case "HS256":
+		check(c.JWT.Secret != "", "jwt.secret is required, e.g. with ROS_JWT_SECRET or ROS_JWT_SECRET_FILE")
+	case "RS256", "EdDSA":
+		check(c.JWT.KeysDir != "", "jwt.keysDir is required with the %s algorithm", c.JWT.Algorithm)
+		check(c.JWT.SigningKeyID != "", "jwt.signingKeyID is required with the %s algorithm", c.JWT.Algorithm)
+	default:
+		errs = append(errs, fmt.Errorf("jwt.algorithm must be HS256, RS256 or EdDSA, got %q", c.JWT.Algorithm))
+	}
+	check(c.JWT.Expiry > 0, "jwt.expiry must be positive, got %s", c.JWT.Expiry)
+
+	for _, link := range []struct{ key, url string }{
+		{"account.emailVerificationURL", c.Account.EmailVerificationURL},
+		{"account.passwordResetURL", c.Account.PasswordResetURL},
+		{"account.oidc.redirectURL", c.Account.OIDC.RedirectURL},
+	} {
+		u, err := url.Parse(link.url)
+		check(err == nil && u.IsAbs(), "%s must be an absolute URL, got %q", link.key, link.url)
+	}
+	check(c.Account.EmailVerificationTTL > 0, "account.emailVerificationTTL must be positive")
+	check(c.Account.PasswordResetTTL > 0, "account.passwordResetTTL must be positive")
+	check(c.Account.Login.MaxFailuresPerLogin > 0, "account.login.maxFailuresPerLogin must be positive")
+	check(c.Account.Login.MaxFailuresPerIP > 0, "account.login.maxFailuresPerIP must be positive")
+	check(c.Account.Login.FailureWindow > 0, "account.login.failureWindow must be positive")
+	check(c.Account.Login.Lockout > 0, "account.login.lockout must be positive")
+	check(c.Account.Login.MaxLockout >= c.Account.Login.Lockout, "account.login.maxLockout must not be shorter than account.login.lockout")
+	check(c.Account.OIDC.StateTTL > 0, "account.oidc.stateTTL must be positive")
+	check(c.Account.OIDC.ReauthWindow > 0, "account.oidc.reauthWindow must be positive")
+	providerNames := make(map[string]bool, len(c.Account.OIDC.Providers))
+	for i, provider := range c.Account.OIDC.Providers {
+		check(provider.Name != "" && !providerNames[provider.Name],
+			"account.oidc.providers[%d].name must be unique and not empty, got %q", i, provider.Name)
+		providerNames[provider.Name] = true
+		u, err := url.Parse(provider.Issuer)
+		check(err == nil && u.IsAbs(), "account.oidc.providers[%d].issuer must be an absolute URL, got %q", i, provider.Issuer)
+		check(provider.ClientID != "", "account.oidc.providers[%d].clientID is required", i)
+	}
+
+	_, err := mail.ParseAddress(c.Mailer.From)
+	check(err == nil, "mailer.from must be an email address, got %q", c.Mailer.From)
+	switch c.Mailer.Driver {
+	case "log":
+	case "file":
+		check(c.Mailer.File != "", "mailer.file is required with the file driver")
+	case "smtp":
+		check(c.Mailer.SMTP.Host != "", "mailer.smtp.host is required with the smtp driver")
+		check(validPort(c.Mailer.SMTP.Port), "mailer.smtp.port must be between 1 and 65535, got %d", c.Mailer.SMTP.Port)
+		check(c.Mailer.SMTP.Timeout > 0, "mailer.smtp.timeout must be positive")
+	default:
+		errs = append(errs, fmt.Errorf("mailer.driver must be smtp, file or log, got %q", c.Mailer.Driver))
+	}
+
+	if c.Limits.RateLimit.Enabled {
+		for _, limit := range []struct {
+			key   string
+			limit RateLimit
+		}{
+			{"limits.rateLimit.perIP", c.Limits.RateLimit.PerIP},
+			{"limits.rateLimit.perTab", c.Limits.RateLimit.PerTab},
+		} {
+			check(limit.limit.Rate > 0, "%s.rate must be positive", limit.key)
+			check(limit.limit.Burst > 0, "%s.burst must be positive", limit.key)
+		}
+	}
+	for _, limit := range []struct {
+		key   string
+		value int
+	}{
+		{"limits.tab.maxGuests", c.Limits.Tab.MaxGuests},
+		{"limits.tab.maxDraftItems", c.Limits.Tab.MaxDraftItems},
+		{"limits.tab.maxQuantity", c.Limits.Tab.MaxQuantity},
+	} {
+		check(limit.value > 0 && limit.value <= math.MaxInt16, "%s must be between 1 and %d, got %d", limit.key, math.MaxInt16, limit.value)
+	}
+
+	switch c.Storage.Driver {
+	case "local":
+		check(c.Storage.Local.Dir != "", "storage.local.dir is required with the local driver")
+		check(c.Storage.BaseURL != "", "storage.baseURL is required with the local driver")
+	case "s3":
+		u, err := url.Parse(c.Storage.S3.Endpoint)
+		check(err == nil && u.IsAbs(), "storage.s3.endpoint must be an absolute URL, got %q", c.Storage.S3.Endpoint)
+		check(c.Storage.S3.Region != "", "storage.s3.region is required with the s3 driver")
+		check(c.Storage.S3.Bucket != "", "storage.s3.bucket is required with the s3 driver")
+		check(c.Storage.S3.AccessKeyID != "" && c.Storage.S3.SecretAccessKey != "",
+			"storage.s3.accessKeyID and storage.s3.secretAccessKey are required with the s3 driver")
+		check(c.Storage.S3.Timeout > 0, "storage.s3.timeout must be positive")
+	default:
+		errs = append(errs, fmt.Errorf("storage.driver must be local or s3, got %q", c.Storage.Driver))
+	}
+	if c.Storage.BaseURL != "" {
+		_, err := url.Parse(c.Storage.BaseURL)
+		check(err == nil, "storage.baseURL must be a URL, got %q", c.Storage.BaseURL)
+	}
+
+	check(c.Photos.MaxSize > 0, "photos.maxSize must be positive, got %d", c.Photos.MaxSize)
+	check(c.Photos.MaxPixels > 0, "photos.maxPixels must be positive, got %d", c.Photos.MaxPixels)
+	check(c.Photos.JPEGQuality >= 1 && c.Photos.JPEGQuality <= 100,
+		"photos.jpegQuality must be between 1 and 100, got %d", c.Photos.JPEGQuality)
+	for name, width := range c.Photos.VariantWidths {
+		check(name != "original" && name != "" && !strings.ContainsAny(name, "/."),
+			"photos.variantWidths names must not be empty, original or contain / or ., got %q", name)
+		check(width > 0, "photos.variantWidths.%s must be positive, got %d", name, width)
+	}
+
+	switch c.Telemetry.Metrics.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		errs = append(errs, fmt.Errorf("telemetry.metrics.exporter must be none, stdout or otlp, got %q",
+			c.Telemetry.Metrics.Exporter))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"database": {"host": "db", "password": "from-file"},
+		"jwt": {"secret": "secret"},
+		"account": {"oidc": {"providers": [{"name": "google", "issuer": "https://accounts.google.com", "clientID": "client"}]}}
+	}`), 0o600))
+	secretFile := filepath.Join(dir, "jwt_secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("from-secret-file\n"), 0o600))
+
+	t.Setenv("ROS_DATABASE_HOST", "postgres.internal")
+	t.Setenv("ROS_SERVER_HEALTHCHECK_INTERVAL", "10s")
+	t.Setenv("ROS_SERVER_GATEWAY_CORS_ALLOWEDORIGINS", "https://a.example,https://b.example")
+	t.Setenv("ROS_JWT_SECRET_FILE", secretFile)
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, "postgres.internal", cfg.Database.Host)
+	require.Equal(t, "from-file", cfg.Database.Password)
+	require.Equal(t, 10*time.Second, cfg.Server.HealthCheck.Interval)
+	require.Equal(t, []string{"https://a.example", "https://b.example"}, cfg.Server.Gateway.CORS.AllowedOrigins)
+	require.Equal(t, "from-secret-file", cfg.JWT.Secret)
+	require.Equal(t, []OIDCProviderConfig{{Name: "google", Issuer: "https://accounts.google.com", ClientID: "client"}}, cfg.Account.OIDC.Providers)
+	// Defaults
+	require.Equal(t, 50051, cfg.Server.Port)
+	require.Equal(t, 3*time.Hour, cfg.JWT.Expiry)
+	require.Equal(t, "standalone", cfg.Redis.Mode)
+}
+
+func TestLoadConfigWithoutFile(t *testing.T) {
+	t.Setenv("ROS_JWT_SECRET", "secret")
+	t.Setenv("ROS_REDIS_PORT", "6380")
+
+	cfg, err := LoadConfig("")
+	require.NoError(t, err)
+	require.Equal(t, 6380, cfg.Redis.Port)
+	require.Equal(t, "tls", cfg.Server.TLS.Mode)
+}
+
+func TestValidateEmptyTLSMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"jwt": {"secret": "secret"}, "server": {"tls": {"mode": ""}}}`), 0o600))
+
+	_, err := LoadConfig(path)
+	require.ErrorContains(t, err, "server.tls.mode is required")
+}
+
+func TestValidate(t *testing.T) {
+	_, err := LoadConfig("")
+	require.ErrorContains(t, err, "jwt.secret is required")
+
+	t.Setenv("ROS_JWT_SECRET", "secret")
+	t.Setenv("ROS_JWT_EXPIRY", "0s")
+	t.Setenv("ROS_SERVER_PORT", "70000")
+	t.Setenv("ROS_SERVER_TLS_MODE", "mtls")
+	t.Setenv("ROS_STORAGE_DRIVER", "s3")
+	t.Setenv("ROS_SERVER_TRUSTEDPROXIES", "proxy.example.com")
+	_, err = LoadConfig("")
+	require.ErrorContains(t, err, "jwt.expiry must be positive")
+	require.ErrorContains(t, err, "server.port must be between 1 and 65535, got 70000")
+	require.ErrorContains(t, err, "server.tls.clientCAFile is required in mtls mode")
+	require.ErrorContains(t, err, "storage.s3.bucket is required with the s3 driver")
+	require.ErrorContains(t, err, `server.trustedProxies must list IP addresses or CIDR prefixes, got "proxy.example.com"`)
+	require.NotContains(t, err.Error(), "jwt.secret")
+}
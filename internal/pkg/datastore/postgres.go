@@ -0,0 +1,64 @@
+package datastore
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+
+	"restaurant-ordering-system/internal/pkg/config"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NewPostgresPoolConfig builds the connection pool configuration from cfg
+func NewPostgresPoolConfig(cfg config.DatabaseConfig) (*pgxpool.Config, error) {
+	query := url.Values{}
+	if cfg.SSLMode != "" {
+		query.Set("sslmode", cfg.SSLMode)
+	}
+	if cfg.StatementCacheMode != "" {
+		query.Set("default_query_exec_mode", cfg.StatementCacheMode)
+	}
+	// Credentials and names are escaped by url.URL, unlike in a keyword/value DSN
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Path:     "/" + cfg.Database,
+		RawQuery: query.Encode(),
+	}
+	poolConfig, err := pgxpool.ParseConfig(dsn.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+	poolConfig.MaxConns = cfg.Pool.MaxConns
+	poolConfig.MinConns = cfg.Pool.MinConns
+	poolConfig.MaxConnLifetime = cfg.Pool.MaxConnLifetime
+	poolConfig.MaxConnIdleTime = cfg.Pool.MaxConnIdleTime
+	poolConfig.HealthCheckPeriod = cfg.Pool.HealthCheckPeriod
+	return poolConfig, nil
+}
+
+// NewPostgresPool creates a connection pool, connecting lazily
+func NewPostgresPool(ctx context.Context, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	poolConfig, err := NewPostgresPoolConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return pgxpool.NewWithConfig(ctx, poolConfig)
+}
+
+// ConnectPostgres opens a single connection, for one-off commands
+func ConnectPostgres(ctx context.Context, cfg config.DatabaseConfig) (*pgx.Conn, error) {
+	poolConfig, err := NewPostgresPoolConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.ConnectConfig(ctx, poolConfig.ConnConfig)
+}
@@ -0,0 +1,44 @@
+package datastore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+func TestNewPostgresPoolConfig(t *testing.T) {
+	poolConfig, err := NewPostgresPoolConfig(config.DatabaseConfig{
+		Host:               "db",
+		Port:               5433,
+		User:               "restaurant",
+		Password:           "p@ss word/=",
+		Database:           "restaurant",
+		SSLMode:            "disable",
+		StatementTimeout:   5 * time.Second,
+		StatementCacheMode: "simple_protocol",
+		Pool: config.PoolConfig{
+			MaxConns:          20,
+			MinConns:          2,
+			MaxConnLifetime:   time.Hour,
+			MaxConnIdleTime:   time.Minute,
+			HealthCheckPeriod: 30 * time.Second,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "db", poolConfig.ConnConfig.Host)
+	require.Equal(t, uint16(5433), poolConfig.ConnConfig.Port)
+	require.Equal(t, "p@ss word/=", poolConfig.ConnConfig.Password)
+	require.Equal(t, "restaurant", poolConfig.ConnConfig.Database)
+	require.Equal(t, "5000", poolConfig.ConnConfig.RuntimeParams["statement_timeout"])
+	require.Equal(t, pgx.QueryExecModeSimpleProtocol, poolConfig.ConnConfig.DefaultQueryExecMode)
+	require.Equal(t, int32(20), poolConfig.MaxConns)
+	require.Equal(t, int32(2), poolConfig.MinConns)
+	require.Equal(t, 30*time.Second, poolConfig.HealthCheckPeriod)
+
+	_, err = NewPostgresPoolConfig(config.DatabaseConfig{Host: "db", Port: 5432, StatementCacheMode: "always"})
+	require.Error(t, err)
+}
@@ -0,0 +1,121 @@
+// Package health keeps the gRPC health statuses of the services in sync with their dependencies
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check reports whether a dependency is reachable
+type Check func(ctx context.Context) error
+
+// Checker runs the checks of the dependencies on an interval and marks every
+// service SERVING only while all the dependencies it needs are healthy
+type Checker struct {
+	server   *health.Server
+	logger   *slog.Logger
+	interval time.Duration
+	timeout  time.Duration
+
+	checks   map[string]Check
+	services map[string][]string
+	healthy  map[string]bool
+}
+
+// DefaultInterval is used when no positive interval is given
+const DefaultInterval = 5 * time.Second
+
+// NewChecker creates a checker updating server every interval.
+// Each check is given at most timeout to complete, or the interval if timeout is not positive.
+func NewChecker(server *health.Server, logger *slog.Logger, interval, timeout time.Duration) *Checker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	if timeout <= 0 {
+		timeout = interval
+	}
+	return &Checker{
+		server:   server,
+		logger:   logger,
+		interval: interval,
+		timeout:  timeout,
+		checks:   make(map[string]Check),
+		services: make(map[string][]string),
+		healthy:  make(map[string]bool),
+	}
+}
+
+// AddDependency registers the check of the dependency called name
+func (c *Checker) AddDependency(name string, check Check) {
+	c.checks[name] = check
+}
+
+// AddService registers service as needing dependencies.
+// The empty service name stands for the overall health of the server.
+func (c *Checker) AddService(service string, dependencies ...string) {
+	c.services[service] = dependencies
+	c.server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// Run checks the dependencies until ctx is done.
+// Dependencies and services must not be added once Run is called.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) check(ctx context.Context) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		healthy = make(map[string]bool, len(c.checks))
+	)
+	for name, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			err := check(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			healthy[name] = err == nil
+			if was, ok := c.healthy[name]; err != nil && (was || !ok) {
+				c.logger.Warn("Dependency is unhealthy", "dependency", name, "error", err)
+			} else if err == nil && ok && !was {
+				c.logger.Info("Dependency is healthy again", "dependency", name)
+			}
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return // Checks were interrupted, their results mean nothing
+	}
+	c.healthy = healthy
+
+	for service, dependencies := range c.services {
+		status := healthpb.HealthCheckResponse_SERVING
+		for _, dependency := range dependencies {
+			if !healthy[dependency] {
+				status = healthpb.HealthCheckResponse_NOT_SERVING
+				break
+			}
+		}
+		c.server.SetServingStatus(service, status)
+	}
+}
@@ -0,0 +1,51 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatus(t *testing.T, server *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := server.Check(t.Context(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.GetStatus()
+}
+
+func TestCheckerTracksDependencies(t *testing.T) {
+	server := health.NewServer()
+	checker := NewChecker(server, slog.New(slog.NewTextHandler(io.Discard, nil)), time.Hour, time.Second)
+
+	var cacheErr error
+	checker.AddDependency("postgres", func(context.Context) error { return nil })
+	checker.AddDependency("redis", func(context.Context) error { return cacheErr })
+	checker.AddService("", "postgres")
+	checker.AddService("restaurant.MenuService", "postgres")
+	checker.AddService("restaurant.OrderService", "postgres", "redis")
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+
+	checker.check(t.Context())
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, "restaurant.OrderService"))
+
+	cacheErr = errors.New("connection refused")
+	checker.check(t.Context())
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, "restaurant.MenuService"))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "restaurant.OrderService"))
+
+	// Statuses no longer change once the server is shutting down
+	server.Shutdown()
+	cacheErr = nil
+	checker.check(t.Context())
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "restaurant.OrderService"))
+}
@@ -0,0 +1,56 @@
+// Package mailer sends the emails of the server, e.g. to verify email addresses
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverFile = "file"
+	DriverLog  = "log"
+)
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends messages
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New creates the mailer selected by cfg.Driver
+func New(cfg config.MailerConfig, logger *slog.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(cfg.From, cfg.SMTP), nil
+	case DriverFile:
+		return NewFileMailer(cfg.From, cfg.File), nil
+	case "", DriverLog:
+		return NewLogMailer(logger), nil
+	default:
+		return nil, fmt.Errorf("unknown mailer driver %q", cfg.Driver)
+	}
+}
+
+// LogMailer logs messages instead of sending them, for local development
+type LogMailer struct {
+	logger *slog.Logger
+}
+
+func NewLogMailer(logger *slog.Logger) *LogMailer {
+	return &LogMailer{logger: logger}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	m.logger.InfoContext(ctx, "Email", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
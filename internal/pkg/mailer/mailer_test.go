@@ -0,0 +1,27 @@
+package mailer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileMailer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.log")
+	m := NewFileMailer("Restaurant <no-reply@example.com>", path)
+
+	require.NoError(t, m.Send(t.Context(), Message{To: "alice@example.com", Subject: "Vérification", Body: "Hello\nAlice"}))
+	require.NoError(t, m.Send(t.Context(), Message{To: "bob@example.com", Subject: "Reset", Body: "Hello Bob"}))
+	require.Error(t, m.Send(t.Context(), Message{To: "eve@example.com\r\nBcc: mallory@example.com", Subject: "Reset"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	mail := string(data)
+	require.Contains(t, mail, "From: Restaurant <no-reply@example.com>\r\nTo: alice@example.com\r\n")
+	require.Contains(t, mail, "Subject: =?utf-8?q?V=C3=A9rification?=\r\n")
+	require.Contains(t, mail, "\r\n\r\nHello\r\nAlice\r\n")
+	require.Contains(t, mail, "To: bob@example.com\r\n")
+	require.NotContains(t, mail, "mallory")
+}
@@ -0,0 +1,92 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+// serveSMTP answers the SMTP commands of a single client on l, sending the
+// message data it received to messages
+func serveSMTP(l net.Listener, messages chan<- string) {
+	conn, err := l.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		switch command, _, _ := strings.Cut(strings.TrimSpace(line), " "); strings.ToUpper(command) {
+		case "EHLO":
+			reply("250 localhost")
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			messages <- data.String()
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func newTestSMTPMailer(t *testing.T, l net.Listener, timeout time.Duration) *SMTPMailer {
+	host, port, err := net.SplitHostPort(l.Addr().String())
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return NewSMTPMailer("Restaurant <no-reply@example.com>", config.SMTPConfig{Host: host, Port: portNumber, Timeout: timeout})
+}
+
+func TestSMTPMailer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	messages := make(chan string, 1)
+	go serveSMTP(l, messages)
+
+	m := newTestSMTPMailer(t, l, time.Second)
+	require.NoError(t, m.Send(t.Context(), Message{To: "alice@example.com", Subject: "Welcome", Body: "Hello Alice"}))
+	require.Contains(t, <-messages, "To: alice@example.com\r\n")
+}
+
+func TestSMTPMailerTimeout(t *testing.T) {
+	// The server accepts connections but never greets its clients
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	m := newTestSMTPMailer(t, l, 50*time.Millisecond)
+	start := time.Now()
+	err = m.Send(t.Context(), Message{To: "alice@example.com", Subject: "Welcome"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
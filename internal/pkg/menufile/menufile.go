@@ -0,0 +1,255 @@
+// Package menufile reads and writes menu documents, the format of menu imports and
+// exports, as JSON or CSV.
+//
+// The JSON format is model.MenuDocument. The CSV format has a header row and a row per
+// dimension, tag and item, whose type column is dimension, tag or item. The name column
+// holds the value of dimensions and tags, the dimension column the key of the dimension
+// of a tag, and the prerequisites and tags columns lists of tag keys separated by |.
+// The modifiers_config column holds the JSON modifiers config of an item.
+package menufile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"restaurant-ordering-system/internal/pkg/model"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// ListSeparator separates the keys of the lists of the CSV format
+const ListSeparator = "|"
+
+// csvColumns are the columns of the CSV format, in the order they are written
+var csvColumns = []string{
+	"type", "key", "name", "description", "dimension", "prerequisites", "tags",
+	"photo_pathinfo", "price", "portion_size", "available", "modifiers_config",
+}
+
+// FormatFromPath returns the format of the file at path from its extension, JSON by default
+func FormatFromPath(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return FormatCSV
+	}
+	return FormatJSON
+}
+
+// ContentType returns the media type of format
+func ContentType(format string) string {
+	if format == FormatCSV {
+		return "text/csv"
+	}
+	return "application/json"
+}
+
+// Decode reads a menu document in format from r
+func Decode(r io.Reader, format string) (*model.MenuDocument, error) {
+	switch format {
+	case "", FormatJSON:
+		var doc model.MenuDocument
+		dec := json.NewDecoder(r)
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("invalid JSON menu: %w", err)
+		}
+		return &doc, nil
+	case FormatCSV:
+		return decodeCSV(r)
+	default:
+		return nil, fmt.Errorf("unknown menu format %q", format)
+	}
+}
+
+// Encode writes doc in format to w
+func Encode(w io.Writer, format string, doc *model.MenuDocument) error {
+	switch format {
+	case "", FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(doc)
+	case FormatCSV:
+		return encodeCSV(w, doc)
+	default:
+		return fmt.Errorf("unknown menu format %q", format)
+	}
+}
+
+func encodeCSV(w io.Writer, doc *model.MenuDocument) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+	row := func(values map[string]string) error {
+		record := make([]string, len(csvColumns))
+		for i, column := range csvColumns {
+			record[i] = values[column]
+		}
+		return cw.Write(record)
+	}
+	for _, d := range doc.Dimensions {
+		if err := row(map[string]string{"type": string(model.MenuEntityDimension), "key": d.Key, "name": d.Value, "description": d.Description}); err != nil {
+			return err
+		}
+	}
+	for _, t := range doc.Tags {
+		if err := row(map[string]string{
+			"type":          string(model.MenuEntityTag),
+			"key":           t.Key,
+			"name":          t.Value,
+			"description":   t.Description,
+			"dimension":     t.Dimension,
+			"prerequisites": strings.Join(t.Prerequisites, ListSeparator),
+		}); err != nil {
+			return err
+		}
+	}
+	for _, item := range doc.Items {
+		if err := row(map[string]string{
+			"type":             string(model.MenuEntityItem),
+			"key":              item.Key,
+			"name":             item.Name,
+			"description":      item.Description,
+			"tags":             strings.Join(item.Tags, ListSeparator),
+			"photo_pathinfo":   item.PhotoPathinfo,
+			"price":            strconv.FormatInt(int64(item.Price), 10),
+			"portion_size":     strconv.FormatInt(int64(item.PortionSize), 10),
+			"available":        strconv.FormatBool(item.Available),
+			"modifiers_config": string(item.ModifiersConfig),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func decodeCSV(r io.Reader) (*model.MenuDocument, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV menu: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !isCSVColumn(name) {
+			return nil, fmt.Errorf("invalid CSV menu: unknown column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"type", "key", "name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("invalid CSV menu: missing column %q", required)
+		}
+	}
+
+	doc := &model.MenuDocument{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return doc, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV menu: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if err := decodeCSVRecord(doc, value); err != nil {
+			return nil, fmt.Errorf("invalid CSV menu: line %d: %w", line, err)
+		}
+	}
+}
+
+func decodeCSVRecord(doc *model.MenuDocument, value func(column string) string) error {
+	switch model.MenuEntityKind(value("type")) {
+	case model.MenuEntityDimension:
+		doc.Dimensions = append(doc.Dimensions, model.MenuDocumentDimension{
+			Key:         value("key"),
+			Value:       value("name"),
+			Description: value("description"),
+		})
+	case model.MenuEntityTag:
+		doc.Tags = append(doc.Tags, model.MenuDocumentTag{
+			Key:           value("key"),
+			Value:         value("name"),
+			Description:   value("description"),
+			Dimension:     value("dimension"),
+			Prerequisites: splitList(value("prerequisites")),
+		})
+	case model.MenuEntityItem:
+		item := model.MenuDocumentItem{
+			Key:           value("key"),
+			Name:          value("name"),
+			Description:   value("description"),
+			PhotoPathinfo: value("photo_pathinfo"),
+			Tags:          splitList(value("tags")),
+		}
+		if v := value("price"); v != "" {
+			price, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid price %q", v)
+			}
+			item.Price = int32(price)
+		}
+		if v := value("portion_size"); v != "" {
+			portionSize, err := strconv.ParseInt(v, 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid portion_size %q", v)
+			}
+			item.PortionSize = int16(portionSize)
+		}
+		if v := value("available"); v != "" {
+			available, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("invalid available %q", v)
+			}
+			item.Available = available
+		}
+		if v := value("modifiers_config"); v != "" {
+			if !json.Valid([]byte(v)) {
+				return fmt.Errorf("modifiers_config is not valid JSON")
+			}
+			item.ModifiersConfig = json.RawMessage(v)
+		}
+		doc.Items = append(doc.Items, item)
+	default:
+		return fmt.Errorf("type must be dimension, tag or item, got %q", value("type"))
+	}
+	return nil
+}
+
+func isCSVColumn(name string) bool {
+	for _, column := range csvColumns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+// splitList splits a list of keys, empty if s is
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	keys := strings.Split(s, ListSeparator)
+	for i := range keys {
+		keys[i] = strings.TrimSpace(keys[i])
+	}
+	return keys
+}
@@ -0,0 +1,79 @@
+package menufile
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testDocument = &model.MenuDocument{
+	Dimensions: []model.MenuDocumentDimension{{Key: "diet", Value: "Dietary", Description: "Dietary restrictions"}},
+	Tags: []model.MenuDocumentTag{
+		{Key: "vegetarian", Value: "Vegetarian", Dimension: "diet"},
+		{Key: "vegan", Value: "Vegan", Description: "No animal products", Dimension: "diet", Prerequisites: []string{"vegetarian"}},
+	},
+	Items: []model.MenuDocumentItem{
+		{
+			Key:             "curry",
+			Name:            "Curry, \"extra\" spicy",
+			Price:           1000,
+			PortionSize:     1,
+			Available:       true,
+			ModifiersConfig: json.RawMessage(`{"spice":["mild","hot"]}`),
+			Tags:            []string{"vegan", "vegetarian"},
+		},
+		{Key: "water", Name: "Water", PortionSize: 2},
+	},
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatCSV} {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, format, testDocument), format)
+		doc, err := Decode(&buf, format)
+		require.NoError(t, err, format)
+		// Modifiers configs are indented with the rest of the JSON document
+		for i, item := range doc.Items {
+			if item.ModifiersConfig != nil {
+				var compact bytes.Buffer
+				require.NoError(t, json.Compact(&compact, item.ModifiersConfig))
+				doc.Items[i].ModifiersConfig = compact.Bytes()
+			}
+		}
+		require.Equal(t, testDocument, doc, format)
+	}
+}
+
+func TestDecodeCSV(t *testing.T) {
+	// Columns in any order, optional ones omitted
+	doc, err := Decode(strings.NewReader("key,type,name,price,tags\n"+
+		"soup,item,Soup,450,hot | vegan\n"+
+		"hot,tag,Hot,,\n"), FormatCSV)
+	require.NoError(t, err)
+	require.Equal(t, &model.MenuDocument{
+		Tags:  []model.MenuDocumentTag{{Key: "hot", Value: "Hot"}},
+		Items: []model.MenuDocumentItem{{Key: "soup", Name: "Soup", Price: 450, Tags: []string{"hot", "vegan"}}},
+	}, doc)
+
+	_, err = Decode(strings.NewReader("type,key,name,colour\n"), FormatCSV)
+	require.ErrorContains(t, err, `unknown column "colour"`)
+	_, err = Decode(strings.NewReader("type,key\n"), FormatCSV)
+	require.ErrorContains(t, err, `missing column "name"`)
+	_, err = Decode(strings.NewReader("type,key,name,price\nitem,soup,Soup,cheap\n"), FormatCSV)
+	require.ErrorContains(t, err, `line 2: invalid price "cheap"`)
+	_, err = Decode(strings.NewReader("type,key,name\ndish,soup,Soup\n"), FormatCSV)
+	require.ErrorContains(t, err, "type must be dimension, tag or item")
+	_, err = Decode(strings.NewReader(`{"items": [], "sections": []}`), FormatJSON)
+	require.ErrorContains(t, err, "sections")
+}
+
+func TestFormatFromPath(t *testing.T) {
+	require.Equal(t, FormatCSV, FormatFromPath("menu.CSV"))
+	require.Equal(t, FormatJSON, FormatFromPath("menu.json"))
+	require.Equal(t, FormatJSON, FormatFromPath("-"))
+}
@@ -8,15 +8,24 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
 var openMethods = map[string]bool{
 	"/restaurant.CustomerService/CreateCustomer":            true,
 	"/restaurant.AuthService/GenerateToken":                 true,
+	"/restaurant.AuthService/VerifyEmail":                   true,
+	"/restaurant.AuthService/RequestPasswordReset":          true,
+	"/restaurant.AuthService/ResetPassword":                 true,
+	"/restaurant.AuthService/StartOIDCLogin":                true,
+	"/restaurant.AuthService/CompleteOIDCLogin":             true,
 	"/restaurant.MenuService/GetMenuItem":                   true,
 	"/restaurant.MenuService/ListMenuItems":                 true,
+	"/restaurant.MenuService/GetMenu":                       true,
+	"/restaurant.MenuService/ListMenus":                     true,
 	"/restaurant.OrderService/CreateOrderItem":              true,
 	"/restaurant.OrderService/DeleteOrderItem":              true,
 	"/restaurant.OrderService/UpdateOrderItemModifiers":     true,
@@ -30,48 +39,104 @@ var openMethods = map[string]bool{
 	"/restaurant.TabService/UpdateGuestName":                true,
 	"/restaurant.TabService/GetOpenTab":                     true,
 	"/restaurant.TabService/CloseTab":                       true,
+	"/grpc.health.v1.Health/Check":                          true,
+	"/grpc.health.v1.Health/List":                           true,
+	"/grpc.health.v1.Health/Watch":                          true,
+	// Reflection is only registered with server.reflection
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      true,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
 }
 
 var adminMethods = map[string]bool{
-	"/restaurant.MenuService/CreateMenuItem": true,
-	"/restaurant.MenuService/UpdateMenuItem": true,
-	"/restaurant.MenuService/DeleteMenuItem": true,
-	"/restaurant.TabService/CreateTab":       true,
+	"/restaurant.MenuService/CreateMenuItem":                  true,
+	"/restaurant.MenuService/UpdateMenuItem":                  true,
+	"/restaurant.MenuService/DeleteMenuItem":                  true,
+	"/restaurant.MenuService/UploadMenuItemPhoto":             true,
+	"/restaurant.MenuService/ExportMenu":                      true,
+	"/restaurant.MenuService/ImportMenu":                      true,
+	"/restaurant.MenuService/SetMenuItemAvailabilityOverride": true,
+	"/restaurant.MenuService/CreateMenu":                      true,
+	"/restaurant.MenuService/UpdateMenu":                      true,
+	"/restaurant.MenuService/DeleteMenu":                      true,
+	"/restaurant.TabService/CreateTab":                        true,
 }
 
-func NewJWTUnaryInterceptor(parse auth.JWTParser) grpc.UnaryServerInterceptor {
+// NewJWTUnaryInterceptor authenticates the requests of every method but the open ones
+// with their bearer token, rejecting the tokens of revoked sessions
+func NewJWTUnaryInterceptor(parse auth.JWTParser, isRevoked auth.RevocationChecker) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req any,
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		if openMethods[info.FullMethod] {
-			return handler(ctx, req)
+		ctx, err := authenticate(ctx, info.FullMethod, parse, isRevoked)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+// NewJWTStreamInterceptor authenticates streams like NewJWTUnaryInterceptor does requests
+func NewJWTStreamInterceptor(parse auth.JWTParser, isRevoked auth.RevocationChecker) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), info.FullMethod, parse, isRevoked)
+		if err != nil {
+			return err
 		}
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		authorization := md["authorization"]
-		if len(authorization) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization metadata missing")
-		}
+// authenticate returns ctx with the claims of the bearer token of the call of fullMethod,
+// unless the method is open
+func authenticate(ctx context.Context, fullMethod string, parse auth.JWTParser, isRevoked auth.RevocationChecker) (context.Context, error) {
+	ctx = withClientIdentity(ctx)
 
-		tokenString := strings.TrimPrefix(authorization[0], "Bearer ")
-		claims, err := parse(tokenString)
-		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid token")
-		}
+	if openMethods[fullMethod] {
+		return ctx, nil
+	}
 
-		if adminMethods[info.FullMethod] && claims.Role != auth.AdminRole {
-			return nil, status.Error(codes.PermissionDenied, "not authorized")
-		}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
 
-		ctx = auth.NewContext(ctx, claims)
+	authorization := md["authorization"]
+	if len(authorization) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata missing")
+	}
 
-		return handler(ctx, req)
+	tokenString := strings.TrimPrefix(authorization[0], "Bearer ")
+	claims, err := parse(tokenString)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	revoked, err := isRevoked(ctx, claims)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "unable to verify token")
+	}
+	if revoked {
+		return nil, status.Error(codes.Unauthenticated, "token revoked")
+	}
+
+	if adminMethods[fullMethod] && claims.Role != auth.AdminRole {
+		return nil, status.Error(codes.PermissionDenied, "not authorized")
+	}
+
+	return auth.NewContext(ctx, claims), nil
+}
+
+// withClientIdentity adds the identity of the client certificate verified during the TLS handshake, if any
+func withClientIdentity(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return ctx
 	}
+	return auth.NewClientIdentityContext(ctx, auth.NewClientIdentity(tlsInfo.State.PeerCertificates[0]))
 }
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+)
+
+// testServerStream is a server stream which only has a context
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestJWTStreamInterceptor(t *testing.T) {
+	parse := func(tokenString string) (*auth.Claims, error) {
+		switch tokenString {
+		case "admin":
+			return &auth.Claims{Role: auth.AdminRole}, nil
+		case "customer":
+			return &auth.Claims{Role: auth.CustomerRole}, nil
+		}
+		return nil, errors.New("invalid")
+	}
+	isRevoked := func(ctx context.Context, claims *auth.Claims) (bool, error) { return false, nil }
+	interceptor := NewJWTStreamInterceptor(parse, isRevoked)
+	call := func(method, token string) (*auth.Claims, error) {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		var claims *auth.Claims
+		err := interceptor(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method},
+			func(srv any, ss grpc.ServerStream) error {
+				claims, _ = auth.FromContext(ss.Context())
+				return nil
+			})
+		return claims, err
+	}
+
+	// Photos are uploaded over a stream, by admins only
+	const upload = "/restaurant.MenuService/UploadMenuItemPhoto"
+	claims, err := call(upload, "admin")
+	require.NoError(t, err)
+	require.Equal(t, auth.AdminRole, claims.Role)
+	_, err = call(upload, "customer")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = call(upload, "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = call(upload, "forged")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Health watches and reflection, the other streams, stay open to probes and tools
+	for _, method := range []string{
+		"/grpc.health.v1.Health/Watch",
+		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+	} {
+		claims, err := call(method, "")
+		require.NoError(t, err, method)
+		require.Nil(t, claims, method)
+	}
+}
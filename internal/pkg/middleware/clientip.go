@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// NewClientIPUnaryInterceptor records the IP address of the client of calls made
+// through the trusted proxies, taken from the X-Forwarded-For or Forwarded headers
+// they set, see auth.ClientIPFromContext. The headers of other peers are ignored,
+// as clients could set them to anything.
+func NewClientIPUnaryInterceptor(trustedProxies []netip.Prefix) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		return handler(withClientIP(ctx, trustedProxies), req)
+	}
+}
+
+// NewClientIPStreamInterceptor records the IP address of the client of streams like
+// NewClientIPUnaryInterceptor does of calls
+func NewClientIPStreamInterceptor(trustedProxies []netip.Prefix) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: withClientIP(ss.Context(), trustedProxies)})
+	}
+}
+
+// NewClientIPHandler records the IP address of the client of HTTP requests like
+// NewClientIPUnaryInterceptor does of calls
+func NewClientIPHandler(trustedProxies []netip.Prefix, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remote, err := netip.ParseAddrPort(r.RemoteAddr)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ip := clientIP(remote.Addr(), r.Header.Values("X-Forwarded-For"), r.Header.Values("Forwarded"), trustedProxies)
+		next.ServeHTTP(w, r.WithContext(auth.NewClientIPContext(r.Context(), ip)))
+	})
+}
+
+// withClientIP returns ctx with the IP address of the client when the peer is a trusted proxy
+func withClientIP(ctx context.Context, trustedProxies []netip.Prefix) context.Context {
+	if len(trustedProxies) == 0 {
+		return ctx
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ctx
+	}
+	remote, err := netip.ParseAddrPort(p.Addr.String())
+	if err != nil {
+		return ctx
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	ip := clientIP(remote.Addr(), md.Get("x-forwarded-for"), md.Get("forwarded"), trustedProxies)
+	return auth.NewClientIPContext(ctx, ip)
+}
+
+// clientIP returns the address of the client of a request from remote. The proxies
+// append the address of their own client to the headers, so they are read from the
+// last hop to the first one, up to the first address which is not a trusted proxy.
+// X-Forwarded-For is preferred to Forwarded when both are set.
+func clientIP(remote netip.Addr, xForwardedFor, forwarded []string, trustedProxies []netip.Prefix) string {
+	client := remote.Unmap()
+	if !isTrustedProxy(client, trustedProxies) {
+		return client.String()
+	}
+	var hops []string
+	if len(xForwardedFor) > 0 {
+		for _, value := range xForwardedFor {
+			hops = append(hops, strings.Split(value, ",")...)
+		}
+	} else {
+		for _, value := range forwarded {
+			for _, element := range strings.Split(value, ",") {
+				hops = append(hops, forwardedFor(element))
+			}
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, ok := parseHop(hops[i])
+		if !ok {
+			// Obfuscated or unknown, the last trusted proxy is the best we know of
+			break
+		}
+		client = addr
+		if !isTrustedProxy(client, trustedProxies) {
+			break
+		}
+	}
+	return client.String()
+}
+
+// forwardedFor returns the for parameter of an element of a Forwarded header
+func forwardedFor(element string) string {
+	for _, pair := range strings.Split(element, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(pair), "=")
+		if strings.EqualFold(key, "for") {
+			return strings.Trim(value, `"`)
+		}
+	}
+	return ""
+}
+
+// parseHop parses an address of a forwarding header, optionally with a port,
+// IPv6 addresses being bracketed then
+func parseHop(hop string) (netip.Addr, bool) {
+	hop = strings.TrimSpace(hop)
+	if addr, err := netip.ParseAddr(hop); err == nil {
+		return addr.Unmap(), true
+	}
+	host, _, err := net.SplitHostPort(hop)
+	if err != nil {
+		host = strings.TrimSuffix(strings.TrimPrefix(hop, "["), "]")
+	}
+	addr, err := netip.ParseAddr(host)
+	return addr.Unmap(), err == nil
+}
+
+func isTrustedProxy(addr netip.Addr, trustedProxies []netip.Prefix) bool {
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
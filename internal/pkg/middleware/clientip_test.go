@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+)
+
+func TestClientIPUnaryInterceptor(t *testing.T) {
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("2001:db8::1/128")}
+	interceptor := NewClientIPUnaryInterceptor(trustedProxies)
+	call := func(remote string, headers ...string) string {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: net.TCPAddrFromAddrPort(netip.MustParseAddrPort(remote))})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(headers...))
+		var ip string
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/restaurant.TabService/CreateGuest"},
+			func(ctx context.Context, req any) (any, error) {
+				ip = auth.ClientIPFromContext(ctx)
+				return nil, nil
+			})
+		require.NoError(t, err)
+		return ip
+	}
+
+	// Clients can not forge their address
+	require.Equal(t, "192.0.2.1", call("192.0.2.1:1234", "x-forwarded-for", "198.51.100.1"))
+	require.Equal(t, "192.0.2.1", call("[::ffff:192.0.2.1]:1234"))
+
+	// Trusted proxies tell the address of their client, the first hop not being trusted
+	require.Equal(t, "198.51.100.1", call("10.0.0.1:1234", "x-forwarded-for", "198.51.100.1"))
+	require.Equal(t, "198.51.100.1", call("10.0.0.1:1234", "x-forwarded-for", "203.0.113.7, 198.51.100.1, 10.0.0.2"))
+	require.Equal(t, "198.51.100.1", call("10.0.0.1:1234", "x-forwarded-for", "203.0.113.7", "x-forwarded-for", "198.51.100.1:5678"))
+	require.Equal(t, "10.0.0.3", call("10.0.0.1:1234", "x-forwarded-for", "10.0.0.3, 10.0.0.2"))
+	require.Equal(t, "10.0.0.1", call("10.0.0.1:1234"))
+	require.Equal(t, "10.0.0.1", call("10.0.0.1:1234", "x-forwarded-for", "unknown"))
+
+	// Forwarded is read when X-Forwarded-For is not set
+	require.Equal(t, "198.51.100.1", call("[2001:db8::1]:1234", "forwarded", `for=203.0.113.7, for="198.51.100.1:5678";proto=https`))
+	require.Equal(t, "2001:db8::2", call("10.0.0.1:1234", "forwarded", `For="[2001:db8::2]:4711"`))
+	require.Equal(t, "10.0.0.1", call("10.0.0.1:1234", "forwarded", "for=_hidden"))
+}
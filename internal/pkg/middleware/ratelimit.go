@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/model"
+	"restaurant-ordering-system/internal/pkg/repository/cache"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimiter takes a token from the bucket of the method for the subject, returning
+// how long to wait for a token when the bucket is empty, see cache.RedisQueries.TakeRateLimitToken
+type RateLimiter func(ctx context.Context, subject, method string, rate float64, burst int) (time.Duration, error)
+
+// NewRateLimitUnaryInterceptor rejects calls with RESOURCE_EXHAUSTED once the bucket of
+// the method for their client IP, or for the tab they are about, is empty.
+// Calls are let through while the buckets are unreachable, and health checks are never limited.
+func NewRateLimitUnaryInterceptor(take RateLimiter, cfg config.RateLimitConfig) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := checkRateLimit(ctx, take, cfg, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewRateLimitStreamInterceptor limits streams like NewRateLimitUnaryInterceptor does
+// calls when they are opened. Their messages are not read yet, so only the bucket of
+// the client IP applies.
+func NewRateLimitStreamInterceptor(take RateLimiter, cfg config.RateLimitConfig) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkRateLimit(ss.Context(), take, cfg, info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// NewRateLimitHTTPChecker checks the requests of an HTTP handler like
+// NewRateLimitUnaryInterceptor does calls, as calls of method about the tab
+// identified by the {id} path wildcard, if any
+func NewRateLimitHTTPChecker(take RateLimiter, cfg config.RateLimitConfig, method string) func(r *http.Request) error {
+	return func(r *http.Request) error {
+		return checkRateLimit(r.Context(), take, cfg, method, tabIDRequest(r.PathValue("id")))
+	}
+}
+
+// tabIDRequest is a request about the tab of its ID, see requestTabID
+type tabIDRequest string
+
+func (r tabIDRequest) GetTabId() string {
+	return string(r)
+}
+
+// checkRateLimit takes a token from the buckets of the call of fullMethod with req,
+// a nil req being about no tab
+func checkRateLimit(ctx context.Context, take RateLimiter, cfg config.RateLimitConfig, fullMethod string, req any) error {
+	if !cfg.Enabled || strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/") {
+		return nil
+	}
+
+	type bucket struct {
+		subject string
+		limit   config.RateLimit
+	}
+	var buckets []bucket
+	if ip := auth.ClientIPFromContext(ctx); ip != "" {
+		buckets = append(buckets, bucket{cache.ClientIPSubject(ip), cfg.PerIP})
+	}
+	if tabID, ok := requestTabID(req); ok {
+		buckets = append(buckets, bucket{cache.TabSubject(tabID), cfg.PerTab})
+	}
+	for _, b := range buckets {
+		wait, err := take(ctx, b.subject, fullMethod, b.limit.Rate, b.limit.Burst)
+		if err != nil {
+			continue
+		}
+		if wait > 0 {
+			return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry in %s", wait.Round(time.Millisecond))
+		}
+	}
+	return nil
+}
+
+// requestTabID returns the tab that the request is about, if any, from the ID
+// fields of the request messages
+func requestTabID(req any) (model.TabID, bool) {
+	switch r := req.(type) {
+	case interface{ GetTabId() string }:
+		id, err := model.ParseTabID(r.GetTabId())
+		return id, err == nil
+	case interface{ GetOrderId() string }:
+		id, err := model.ParseOrderID(r.GetOrderId())
+		return id.TabID, err == nil
+	case interface{ GetOrderItemId() string }:
+		id, err := model.ParseOrderItemID(r.GetOrderItemId())
+		return id.OrderID.TabID, err == nil
+	case interface{ GetGuestId() string }:
+		id, err := model.ParseGuestID(r.GetGuestId())
+		return id.TabID, err == nil
+	case interface{ GetId() string }:
+		// Only order item IDs identify a tab among the IDs of requests
+		id, err := model.ParseOrderItemID(r.GetId())
+		return id.OrderID.TabID, err == nil
+	}
+	return model.TabID{}, false
+}
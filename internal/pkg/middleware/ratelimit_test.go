@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"restaurant-ordering-system/api/proto"
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+func TestRateLimitUnaryInterceptor(t *testing.T) {
+	const tabID = "0b7c8f5e-7d4c-4c55-9c36-0f5b2f4a7c11"
+	taken := map[string]int{}
+	take := func(ctx context.Context, subject, method string, rate float64, burst int) (time.Duration, error) {
+		if subject == "ip:192.0.2.99" {
+			return 0, errors.New("unreachable")
+		}
+		taken[subject+" "+method]++
+		if taken[subject+" "+method] > burst {
+			return time.Second, nil
+		}
+		return 0, nil
+	}
+	interceptor := NewRateLimitUnaryInterceptor(take, config.RateLimitConfig{
+		Enabled: true,
+		PerIP:   config.RateLimit{Rate: 1, Burst: 3},
+		PerTab:  config.RateLimit{Rate: 1, Burst: 2},
+	})
+	call := func(ip string, req any) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+		info := &grpc.UnaryServerInfo{FullMethod: "/restaurant.TabService/CreateGuest"}
+		_, err := interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) { return nil, nil })
+		return err
+	}
+
+	// The tab bucket is shared by every client
+	req := proto.CreateGuestRequest_builder{TabId: protobuf.String(tabID)}.Build()
+	require.NoError(t, call("192.0.2.1", req))
+	require.NoError(t, call("192.0.2.2", req))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call("192.0.2.3", req)))
+	require.Equal(t, 3, taken["tab:"+tabID+" /restaurant.TabService/CreateGuest"])
+
+	// The IP bucket is shared by every tab
+	other := proto.CreateGuestRequest_builder{TabId: protobuf.String("invalid")}.Build()
+	require.NoError(t, call("192.0.2.1", other))
+	require.NoError(t, call("192.0.2.1", other))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call("192.0.2.1", other)))
+
+	// Unreachable buckets let calls through
+	require.NoError(t, call("192.0.2.99", other))
+}
+
+func TestRateLimitStreamInterceptor(t *testing.T) {
+	taken := map[string]int{}
+	take := func(ctx context.Context, subject, method string, rate float64, burst int) (time.Duration, error) {
+		taken[subject+" "+method]++
+		if taken[subject+" "+method] > burst {
+			return time.Second, nil
+		}
+		return 0, nil
+	}
+	interceptor := NewRateLimitStreamInterceptor(take, config.RateLimitConfig{
+		Enabled: true,
+		PerIP:   config.RateLimit{Rate: 1, Burst: 2},
+	})
+	call := func(method string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}})
+		info := &grpc.StreamServerInfo{FullMethod: method}
+		return interceptor(nil, &testServerStream{ctx: ctx}, info, func(srv any, ss grpc.ServerStream) error { return nil })
+	}
+
+	const upload = "/restaurant.MenuService/UploadMenuItemPhoto"
+	require.NoError(t, call(upload))
+	require.NoError(t, call(upload))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call(upload)))
+	require.Equal(t, 3, taken["ip:192.0.2.1 "+upload])
+
+	// Health watches are never limited
+	for range 3 {
+		require.NoError(t, call("/grpc.health.v1.Health/Watch"))
+	}
+}
+
+func TestRateLimitHTTPChecker(t *testing.T) {
+	const tabID = "0b7c8f5e-7d4c-4c55-9c36-0f5b2f4a7c11"
+	taken := map[string]int{}
+	take := func(ctx context.Context, subject, method string, rate float64, burst int) (time.Duration, error) {
+		taken[subject+" "+method]++
+		if taken[subject+" "+method] > burst {
+			return time.Second, nil
+		}
+		return 0, nil
+	}
+	const pattern = "GET /tabs/{id}/events"
+	check := NewRateLimitHTTPChecker(take, config.RateLimitConfig{
+		Enabled: true,
+		PerIP:   config.RateLimit{Rate: 1, Burst: 3},
+		PerTab:  config.RateLimit{Rate: 1, Burst: 2},
+	}, pattern)
+	var err error
+	mux := http.NewServeMux()
+	mux.Handle(pattern, NewClientIPHandler(nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err = check(r)
+	})))
+	call := func(ip string) error {
+		r := httptest.NewRequest(http.MethodGet, "/tabs/"+tabID+"/events", nil)
+		r.RemoteAddr = ip + ":1234"
+		mux.ServeHTTP(httptest.NewRecorder(), r)
+		return err
+	}
+
+	// Streams of a tab are limited per tab and per client IP
+	require.NoError(t, call("192.0.2.1"))
+	require.NoError(t, call("192.0.2.2"))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call("192.0.2.1")))
+	require.Equal(t, 3, taken["tab:"+tabID+" "+pattern])
+	require.Equal(t, 2, taken["ip:192.0.2.1 "+pattern])
+}
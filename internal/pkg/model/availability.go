@@ -0,0 +1,99 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// TimeOfDay is a time of day in minutes since midnight
+type TimeOfDay int
+
+// ParseTimeOfDay parses a time of day formatted as HH:MM, from 00:00 to 23:59
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return TimeOfDay(t.Hour()*60 + t.Minute()), nil
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t/60, t%60)
+}
+
+// Active reports whether the override still applies at t
+func (o *AvailabilityOverride) Active(t time.Time) bool {
+	return o != nil && (o.Until == nil || t.Before(*o.Until))
+}
+
+// AvailableAt reports whether the menu item can be ordered at t. Deleted items never
+// can, and otherwise an active override decides alone. Without one, the item must be
+// available and, when it belongs to any menu, one of them must be active at t as
+// reported by menuActive.
+func (mi MenuItem) AvailableAt(t time.Time, menuActive func(MenuID) bool) bool {
+	if mi.DeletedAt != nil {
+		return false
+	}
+	if mi.AvailabilityOverride.Active(t) {
+		return mi.AvailabilityOverride.Available
+	}
+	if !mi.Available {
+		return false
+	}
+	return len(mi.MenuIDs) == 0 || slices.ContainsFunc(mi.MenuIDs, menuActive)
+}
+
+// ActiveAt reports whether the menu is active at t, during any of its schedules in
+// its timezone, or always without any. A menu whose timezone is unknown is never active.
+func (m Menu) ActiveAt(t time.Time) bool {
+	if len(m.Schedules) == 0 {
+		return true
+	}
+	loc, err := time.LoadLocation(m.Timezone)
+	if err != nil {
+		return false
+	}
+	for _, schedule := range m.Schedules {
+		if schedule.Contains(t, loc) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains reports whether the window of the schedule is open at t, on the wall clock of loc
+func (s MenuSchedule) Contains(t time.Time, loc *time.Location) bool {
+	local := t.In(loc)
+	minute := TimeOfDay(local.Hour()*60 + local.Minute())
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	if s.Start < s.End {
+		return minute >= s.Start && minute < s.End && s.startsOn(day)
+	}
+	// The window ends the next day, so it is also open after midnight when it started the day before
+	return minute >= s.Start && s.startsOn(day) || minute < s.End && s.startsOn(day.AddDate(0, 0, -1))
+}
+
+// startsOn reports whether the window opens on day, a date at midnight UTC
+func (s MenuSchedule) startsOn(day time.Time) bool {
+	if len(s.Weekdays) > 0 && !slices.Contains(s.Weekdays, day.Weekday()) {
+		return false
+	}
+	return (s.StartDate == nil || !day.Before(*s.StartDate)) && (s.EndDate == nil || !day.After(*s.EndDate))
+}
+
+// Validate reports the first invalid field of the schedule
+func (s MenuSchedule) Validate() error {
+	for _, weekday := range s.Weekdays {
+		if weekday < time.Sunday || weekday > time.Saturday {
+			return fmt.Errorf("weekday %d must be from 0 (Sunday) to 6 (Saturday)", weekday)
+		}
+	}
+	if s.Start < 0 || s.Start >= 24*60 || s.End < 0 || s.End >= 24*60 {
+		return fmt.Errorf("times of day must be from 00:00 to 23:59")
+	}
+	if s.StartDate != nil && s.EndDate != nil && s.EndDate.Before(*s.StartDate) {
+		return fmt.Errorf("end date must not be before the start date")
+	}
+	return nil
+}
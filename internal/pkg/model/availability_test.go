@@ -0,0 +1,103 @@
+package model
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	got, err := ParseTimeOfDay("07:30")
+	if err != nil || got != 7*60+30 || got.String() != "07:30" {
+		t.Errorf("ParseTimeOfDay(07:30) = %v, %v", got, err)
+	}
+	for _, s := range []string{"24:00", "7:30pm", ""} {
+		if _, err := ParseTimeOfDay(s); err == nil {
+			t.Errorf("ParseTimeOfDay(%q) should fail", s)
+		}
+	}
+}
+
+func TestMenuScheduleContains(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	date := func(s string) *time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &d
+	}
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	lunch := MenuSchedule{Weekdays: weekdays, Start: 11*60 + 30, End: 14*60 + 30}
+	lateNight := MenuSchedule{Weekdays: []time.Weekday{time.Friday}, Start: 22 * 60, End: 2 * 60}
+	december := MenuSchedule{StartDate: date("2026-12-01"), EndDate: date("2026-12-31")}
+
+	for _, tt := range []struct {
+		name     string
+		schedule MenuSchedule
+		at       string
+		want     bool
+	}{
+		{"lunch on Monday", lunch, "2026-10-19T12:00:00+02:00", true},
+		{"after lunch", lunch, "2026-10-19T14:30:00+02:00", false},
+		{"lunch on Saturday", lunch, "2026-10-24T12:00:00+02:00", false},
+		{"lunch in another timezone", lunch, "2026-10-19T10:00:00Z", true},
+		{"before lunch after the end of summer time", lunch, "2026-10-26T10:00:00Z", false},
+		{"lunch after the end of summer time", lunch, "2026-10-26T10:30:00Z", true},
+		{"Friday night", lateNight, "2026-10-23T23:00:00+02:00", true},
+		{"after midnight on Friday night", lateNight, "2026-10-24T01:59:00+02:00", true},
+		{"Saturday night", lateNight, "2026-10-24T23:00:00+02:00", false},
+		{"after midnight on Thursday night", lateNight, "2026-10-23T01:00:00+02:00", false},
+		{"last day of December", december, "2026-12-31T23:59:00+01:00", true},
+		{"after December", december, "2027-01-01T00:00:00+01:00", false},
+		{"before December", december, "2026-11-30T23:59:00+01:00", false},
+	} {
+		at, err := time.Parse(time.RFC3339, tt.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tt.schedule.Contains(at, paris); got != tt.want {
+			t.Errorf("%s: Contains(%s) = %v, want %v", tt.name, tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestMenuItemAvailableAt(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	breakfast := Menu{ID: 1, Timezone: "UTC", Schedules: []MenuSchedule{{Start: 7 * 60, End: 11 * 60}}}
+	dinner := Menu{ID: 2, Timezone: "UTC", Schedules: []MenuSchedule{{Start: 18 * 60, End: 23 * 60}}}
+	menus := map[MenuID]Menu{1: breakfast, 2: dinner}
+	menuActive := func(id MenuID) bool { return menus[id].ActiveAt(now) }
+
+	for _, tt := range []struct {
+		name string
+		item MenuItem
+		want bool
+	}{
+		{"in no menu", MenuItem{Available: true}, true},
+		{"not available", MenuItem{MenuIDs: []MenuID{1}}, false},
+		{"in an active menu", MenuItem{Available: true, MenuIDs: []MenuID{2, 1}}, true},
+		{"in an inactive menu", MenuItem{Available: true, MenuIDs: []MenuID{2}}, false},
+		{"overridden available", MenuItem{MenuIDs: []MenuID{2}, AvailabilityOverride: &AvailabilityOverride{Available: true, Until: &later}}, true},
+		{"overridden unavailable", MenuItem{Available: true, AvailabilityOverride: &AvailabilityOverride{}}, false},
+		{"deleted", MenuItem{DeletedAt: &now, AvailabilityOverride: &AvailabilityOverride{Available: true}}, false},
+		{"expired override", MenuItem{Available: true, AvailabilityOverride: &AvailabilityOverride{Until: &now}}, true},
+	} {
+		if got := tt.item.AvailableAt(now, menuActive); got != tt.want {
+			t.Errorf("%s: AvailableAt = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if !(Menu{Timezone: "Nowhere/Unknown"}).ActiveAt(now) {
+		t.Error("a menu without schedules should always be active")
+	}
+	dinner.Timezone = "Nowhere/Unknown"
+	dinner.Schedules[0].Start = 0
+	if dinner.ActiveAt(now) {
+		t.Error("a menu with an unknown timezone should never be active")
+	}
+}
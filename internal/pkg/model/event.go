@@ -0,0 +1,28 @@
+package model
+
+// TabEventType is the kind of change described by a TabEvent
+type TabEventType string
+
+const (
+	OrderItemCreated TabEventType = "order_item_created"
+	OrderItemUpdated TabEventType = "order_item_updated"
+	OrderItemDeleted TabEventType = "order_item_deleted"
+	OrderSent        TabEventType = "order_sent"
+	GuestCreated     TabEventType = "guest_created"
+	GuestUpdated     TabEventType = "guest_updated"
+	GuestClaimed     TabEventType = "guest_claimed"
+	TabVisited       TabEventType = "tab_visited"
+	TabClosed        TabEventType = "tab_closed"
+	// TabResync is sent to followers which missed events, they have to get the whole tab again
+	TabResync TabEventType = "resync"
+)
+
+// TabEvent describes a change of a tab to the clients following it.
+// Only the IDs relevant to Type are set.
+type TabEvent struct {
+	ID          string       `json:"-"`
+	Type        TabEventType `json:"type"`
+	OrderID     *OrderID     `json:"order_id,omitempty"`
+	OrderItemID *OrderItemID `json:"order_item_id,omitempty"`
+	GuestID     *GuestID     `json:"guest_id,omitempty"`
+}
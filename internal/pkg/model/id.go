@@ -205,6 +205,48 @@ func ParseMenuItemID(s string) (MenuItemID, error) {
 	return MenuItemID(val), nil
 }
 
+type MenuID int16
+
+func (id MenuID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+func (id MenuID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+func ParseMenuID(s string) (MenuID, error) {
+	val, err := strconv.ParseInt(s, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	if val <= 0 {
+		return 0, strconv.ErrSyntax
+	}
+	return MenuID(val), nil
+}
+
+type FavoriteID int64
+
+func (id FavoriteID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+func (id FavoriteID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+func ParseFavoriteID(s string) (FavoriteID, error) {
+	val, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if val <= 0 {
+		return 0, strconv.ErrSyntax
+	}
+	return FavoriteID(val), nil
+}
+
 type MenuTagID int16
 
 func (id MenuTagID) String() string {
@@ -182,12 +182,14 @@ func (g *Guest) UnmarshalJSON(data []byte) error {
 
 // Customer represents a registered person dining in the restaurant
 type Customer struct {
-	ID          CustomerID `json:"id"`
-	Name        string     `json:"name"`
-	Email       string     `json:"email"`
-	PhoneNumber string     `json:"phone_number"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID            CustomerID `json:"id"`
+	LoginID       LoginID    `json:"login_id"`
+	Name          string     `json:"name"`
+	Email         string     `json:"email"`
+	EmailVerified bool       `json:"email_verified"`
+	PhoneNumber   string     `json:"phone_number"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
 }
 
 func (c Customer) MarshalJSON() ([]byte, error) {
@@ -222,19 +224,139 @@ func (c *Customer) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// CustomerIdentity is an identity of an OpenID Connect provider linked to a customer
+type CustomerIdentity struct {
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CustomerDataExport is the personal data of a customer. Tabs are shared with other
+// diners, so only their metadata is exported, and ordered items only list the
+// customer among their owners.
+type CustomerDataExport struct {
+	ExportedAt   time.Time          `json:"exported_at"`
+	Profile      Customer           `json:"profile"`
+	Identities   []CustomerIdentity `json:"identities"`
+	Favorites    []Favorite         `json:"favorites"`
+	VisitedTabs  []VisitedTab       `json:"visited_tabs"`
+	OrderedItems []*OrderItem       `json:"ordered_items"`
+}
+
+// VisitedTab is the metadata of a tab visited by a customer
+type VisitedTab struct {
+	ID         TabID      `json:"id"`
+	TotalPrice int32      `json:"total_price"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ClosedAt   *time.Time `json:"closed_at,omitempty"`
+}
+
+// SpendSummary is what a customer spent over the items they own in sent orders,
+// items shared with other guests or customers being split evenly
+type SpendSummary struct {
+	TotalSpend    int64          `json:"total_spend"`
+	VisitCount    int64          `json:"visit_count"`
+	FavoriteItems []FavoriteItem `json:"favorite_items"`
+}
+
+// FavoriteItem is a menu item a customer ordered, with the quantity they ordered
+// and the number of tabs they ordered it in
+type FavoriteItem struct {
+	MenuItemID MenuItemID `json:"menu_item_id"`
+	Name       string     `json:"name"`
+	Quantity   int64      `json:"quantity"`
+	TabCount   int64      `json:"tab_count"`
+}
+
+// Favorite is a menu item saved by a customer with their modifiers. Outdated
+// favorites cannot be ordered anymore, as their menu item was deleted or its
+// modifiers config no longer accepts the modifiers.
+type Favorite struct {
+	ID           FavoriteID `json:"id"`
+	MenuItemID   MenuItemID `json:"menu_item_id"`
+	MenuItemName string     `json:"menu_item_name"`
+	Name         string     `json:"name"`
+	Modifiers    []byte     `json:"modifiers"`
+	Outdated     bool       `json:"outdated"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
 // MenuItem represents a food or drink item available for ordering
 type MenuItem struct {
-	ID              MenuItemID `json:"id"`
-	Name            string     `json:"name"`
-	Description     string     `json:"description"`
-	PhotoPathinfo   string     `json:"photo_pathinfo"`
-	Price           int32      `json:"price"`
-	PortionSize     int16      `json:"portion_size"`
-	Available       bool       `json:"available"`
-	ModifiersConfig []byte     `json:"modifiers_config"`
-	MenuTags        []MenuTag  `json:"menu_tags"`
-	CreatedAt       time.Time  `json:"created_at"`
-	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+	ID              MenuItemID     `json:"id"`
+	Name            string         `json:"name"`
+	Description     string         `json:"description"`
+	PhotoPathinfo   string         `json:"photo_pathinfo"`
+	Price           int32          `json:"price"`
+	PortionSize     int16          `json:"portion_size"`
+	Available       bool           `json:"available"`
+	ModifiersConfig []byte         `json:"modifiers_config"`
+	MenuTags        []MenuTag      `json:"menu_tags"`
+	Photo           *MenuItemPhoto `json:"photo,omitempty"`
+	// MenuIDs are the menus the item belongs to
+	MenuIDs              []MenuID              `json:"menu_ids,omitempty"`
+	AvailabilityOverride *AvailabilityOverride `json:"availability_override,omitempty"`
+	// AvailableNow is whether the item could be ordered when it was read, see AvailableAt
+	AvailableNow bool       `json:"available_now"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+}
+
+// AvailabilityOverride forces a menu item available or unavailable, regardless of
+// the schedules of its menus, until Until or forever if nil
+type AvailabilityOverride struct {
+	Available bool       `json:"available"`
+	Until     *time.Time `json:"until,omitempty"`
+}
+
+// Menu groups the menu items served during a period, such as breakfast or dinner.
+// It is active during any of its Schedules in its Timezone, or always without any.
+type Menu struct {
+	ID          MenuID         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Timezone    string         `json:"timezone"`
+	Schedules   []MenuSchedule `json:"schedules"`
+	MenuItemIDs []MenuItemID   `json:"menu_item_ids"`
+	// Active is whether the menu was active when it was read, see ActiveAt
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// MenuSchedule is a weekly time window of a menu, from Start until End, ending the
+// next day when End is not after Start, so that Start equal to End is the whole day.
+// Empty Weekdays mean every day. StartDate and EndDate, dates at midnight UTC, bound
+// the days the window starts on when not nil.
+type MenuSchedule struct {
+	Weekdays  []time.Weekday `json:"weekdays"`
+	Start     TimeOfDay      `json:"start"`
+	End       TimeOfDay      `json:"end"`
+	StartDate *time.Time     `json:"start_date,omitempty"`
+	EndDate   *time.Time     `json:"end_date,omitempty"`
+}
+
+// MenuItemPhoto is the photo uploaded for a menu item, served at OriginalURL as
+// uploaded and resized in Variants, sorted by width. Blurhash is a compact
+// placeholder to show while a variant loads.
+type MenuItemPhoto struct {
+	ContentType string         `json:"content_type"`
+	Width       int            `json:"width"`
+	Height      int            `json:"height"`
+	Blurhash    string         `json:"blurhash"`
+	OriginalURL string         `json:"original_url"`
+	Variants    []PhotoVariant `json:"variants"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// PhotoVariant is a JPEG of a photo resized to Width
+type PhotoVariant struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
 }
 
 func (mt MenuItem) MarshalJSON() ([]byte, error) {
@@ -269,6 +391,74 @@ func (mt *MenuItem) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MenuDocument is a whole menu in the format of menu imports and exports, where
+// dimensions, tags and items reference each other by their stable Key
+type MenuDocument struct {
+	Dimensions []MenuDocumentDimension `json:"dimensions"`
+	Tags       []MenuDocumentTag       `json:"tags"`
+	Items      []MenuDocumentItem      `json:"items"`
+}
+
+type MenuDocumentDimension struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+type MenuDocumentTag struct {
+	Key           string   `json:"key"`
+	Value         string   `json:"value"`
+	Description   string   `json:"description,omitempty"`
+	Dimension     string   `json:"dimension,omitempty"`
+	Prerequisites []string `json:"prerequisites,omitempty"`
+}
+
+type MenuDocumentItem struct {
+	Key             string          `json:"key"`
+	Name            string          `json:"name"`
+	Description     string          `json:"description,omitempty"`
+	PhotoPathinfo   string          `json:"photo_pathinfo,omitempty"`
+	Price           int32           `json:"price"`
+	PortionSize     int16           `json:"portion_size"`
+	Available       bool            `json:"available"`
+	ModifiersConfig json.RawMessage `json:"modifiers_config,omitempty"`
+	Tags            []string        `json:"tags,omitempty"`
+}
+
+// MenuEntityKind is the kind of an entry of a menu document
+type MenuEntityKind string
+
+const (
+	MenuEntityDimension MenuEntityKind = "dimension"
+	MenuEntityTag       MenuEntityKind = "tag"
+	MenuEntityItem      MenuEntityKind = "item"
+)
+
+// MenuChangeAction is what an import does to an entry of the menu
+type MenuChangeAction string
+
+const (
+	MenuChangeCreate MenuChangeAction = "create"
+	MenuChangeUpdate MenuChangeAction = "update"
+)
+
+// MenuChange is an entry of a menu document created or updated by an import,
+// Fields listing the fields an update changes
+type MenuChange struct {
+	Kind   MenuEntityKind   `json:"kind"`
+	Key    string           `json:"key"`
+	Action MenuChangeAction `json:"action"`
+	Fields []string         `json:"fields,omitempty"`
+}
+
+// MenuImportResult is the diff between a menu document and the menu, applied unless
+// the import was a dry run
+type MenuImportResult struct {
+	Changes   []MenuChange `json:"changes"`
+	Unchanged int          `json:"unchanged"`
+	Applied   bool         `json:"applied"`
+}
+
 // MenuTag represents a label for categorizing menu items
 type MenuTag struct {
 	ID            MenuTagID        `json:"id"`
@@ -361,6 +551,19 @@ type CreateCustomerParams struct {
 	PhoneNumber string  `json:"phone_number"`
 }
 
+// UpdateCustomerProfileParams only updates the fields which are not nil
+type UpdateCustomerProfileParams struct {
+	Name        *string `json:"name"`
+	PhoneNumber *string `json:"phone_number"`
+}
+
+// SaveFavoriteParams replaces the favorite of the customer with the same name, if any
+type SaveFavoriteParams struct {
+	MenuItemID MenuItemID `json:"menu_item_id"`
+	Name       string     `json:"name"`
+	Modifiers  []byte     `json:"modifiers"`
+}
+
 type CreateMenuItemParams struct {
 	Name            string `json:"name"`
 	Description     string `json:"description"`
@@ -373,6 +576,19 @@ type CreateMenuItemParams struct {
 
 type UpdateMenuItemParams CreateMenuItemParams
 
+// CreateMenuParams creates a menu with its schedules and menu items, UTC being the
+// default Timezone
+type CreateMenuParams struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Timezone    string         `json:"timezone"`
+	Schedules   []MenuSchedule `json:"schedules"`
+	MenuItemIDs []MenuItemID   `json:"menu_item_ids"`
+}
+
+// UpdateMenuParams replaces every field of a menu, including its schedules and menu items
+type UpdateMenuParams CreateMenuParams
+
 type CreateOrderItemParams struct {
 	OrderID          OrderID      `json:"order_id"`
 	MenuItemID       MenuItemID   `json:"menu_item_id"`
@@ -381,3 +597,53 @@ type CreateOrderItemParams struct {
 	GuestOwnerIDs    []GuestID    `json:"guest_owner_ids"`
 	CustomerOwnerIDs []CustomerID `json:"customer_owner_ids"`
 }
+
+// VisitedTabsParams filters the tabs visited by a customer, listed newest first.
+// Since and Until bound the creation time of the tabs, and Closed selects open or
+// closed tabs when not nil.
+type VisitedTabsParams struct {
+	PageSize  int        `json:"page_size"`
+	PageToken string     `json:"page_token"`
+	Since     *time.Time `json:"since"`
+	Until     *time.Time `json:"until"`
+	Closed    *bool      `json:"closed"`
+	// Summary leaves out the orders of the tabs
+	Summary bool `json:"summary"`
+}
+
+// VisitedTabsPage is a page of visited tabs, NextPageToken being empty on the last page
+type VisitedTabsPage struct {
+	Tabs          []*Tab `json:"tabs"`
+	NextPageToken string `json:"next_page_token"`
+}
+
+// ReorderParams selects the past order, or the past tab, whose sent items are cloned
+// into the order not sent yet OrderID. OnlyOwned only clones the items the customer owns.
+type ReorderParams struct {
+	OrderID       OrderID  `json:"order_id"`
+	SourceOrderID *OrderID `json:"source_order_id"`
+	SourceTabID   *TabID   `json:"source_tab_id"`
+	OnlyOwned     bool     `json:"only_owned"`
+}
+
+// ReorderResult lists the items created by a reorder and the ones skipped
+type ReorderResult struct {
+	OrderItemIDs []OrderItemID      `json:"order_item_ids"`
+	SkippedItems []SkippedOrderItem `json:"skipped_items"`
+}
+
+// SkipReason tells why an item was not reordered
+type SkipReason string
+
+const (
+	SkipReasonUnavailable           SkipReason = "unavailable"
+	SkipReasonDeleted               SkipReason = "deleted"
+	SkipReasonIncompatibleModifiers SkipReason = "incompatible_modifiers"
+)
+
+// SkippedOrderItem is an item which could not be reordered
+type SkippedOrderItem struct {
+	MenuItemID MenuItemID `json:"menu_item_id"`
+	Name       string     `json:"name"`
+	Reason     SkipReason `json:"reason"`
+}
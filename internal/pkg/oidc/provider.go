@@ -0,0 +1,242 @@
+// Package oidc logs customers in with OpenID Connect providers, using the
+// authorization code flow with PKCE
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+	"restaurant-ordering-system/internal/pkg/config"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// ErrLoginFailed wraps the errors caused by the code or the ID token of a login,
+// rather than by the provider being unreachable
+var ErrLoginFailed = errors.New("OIDC login failed")
+
+// keysRefreshInterval is how often the keys of a provider may be fetched again when
+// an ID token is signed with an unknown key
+const keysRefreshInterval = time.Minute
+
+// Identity is the customer authenticated by a provider
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Provider is an OpenID Connect provider. Its configuration and keys are fetched
+// when first needed, so that providers being unreachable does not prevent starting.
+type Provider struct {
+	issuer string
+	oauth2 oauth2.Config
+	client *http.Client
+
+	mu            sync.Mutex
+	discovered    bool
+	jwksURI       string
+	keys          map[string]any
+	keysFetchedAt time.Time
+}
+
+// NewProviders returns the providers of cfg by name, making requests with client
+func NewProviders(cfg config.OIDCConfig, client *http.Client) map[string]*Provider {
+	providers := make(map[string]*Provider, len(cfg.Providers))
+	for _, provider := range cfg.Providers {
+		providers[provider.Name] = NewProvider(provider, cfg.RedirectURL, client)
+	}
+	return providers
+}
+
+// NewProvider returns the provider of cfg, redirecting customers to redirectURL
+func NewProvider(cfg config.OIDCProviderConfig, redirectURL string, client *http.Client) *Provider {
+	return &Provider{
+		issuer: strings.TrimSuffix(cfg.Issuer, "/"),
+		oauth2: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       append([]string{"openid", "email", "profile"}, cfg.Scopes...),
+		},
+		client: client,
+	}
+}
+
+// AuthCodeURL returns the URL where the customer logs in. The provider redirects them
+// back with state and a code, which Exchange trades for their identity with verifier.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	if err := p.discover(ctx); err != nil {
+		return "", err
+	}
+	return p.oauth2.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier), oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+// Exchange trades the code for the identity of the customer, verifying that the ID
+// token was signed by the provider for this client and login
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error) {
+	if err := p.discover(ctx); err != nil {
+		return nil, err
+	}
+	token, err := p.oauth2.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) {
+			return nil, fmt.Errorf("%w: %w", ErrLoginFailed, err)
+		}
+		return nil, err
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, fmt.Errorf("%w: no ID token returned", ErrLoginFailed)
+	}
+	return p.verifyIDToken(ctx, rawIDToken, nonce)
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+func (p *Provider) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (*Identity, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(p.oauth2.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoginFailed, err)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrLoginFailed)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrLoginFailed)
+	}
+	return &Identity{
+		Issuer:        p.issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// discover fetches the configuration of the provider, once it succeeds
+func (p *Provider) discover(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovered {
+		return nil
+	}
+
+	var metadata struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	if err := p.getJSON(ctx, p.issuer+"/.well-known/openid-configuration", &metadata); err != nil {
+		return err
+	}
+	// The issuer must match exactly, see OpenID Connect Discovery 4.3
+	if strings.TrimSuffix(metadata.Issuer, "/") != p.issuer {
+		return fmt.Errorf("OIDC provider %s claims to be issuer %q", p.issuer, metadata.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return fmt.Errorf("OIDC provider %s does not publish its endpoints", p.issuer)
+	}
+
+	p.oauth2.Endpoint = oauth2.Endpoint{
+		AuthURL:  metadata.AuthorizationEndpoint,
+		TokenURL: metadata.TokenEndpoint,
+	}
+	p.jwksURI = metadata.JWKSURI
+	p.discovered = true
+	return nil
+}
+
+// key returns the key of the provider with the given ID, fetching the keys again
+// if it is unknown, as providers rotate their keys. Tokens without key ID are
+// accepted from providers with a single key.
+func (p *Provider) key(ctx context.Context, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.keysFetchedAt) < keysRefreshInterval {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+
+	var jwks struct {
+		Keys []auth.JSONWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, p.jwksURI, &jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]any, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue // Keys of unsupported types are not needed
+		}
+		keys[jwk.KeyID] = key
+	}
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key ID %q", kid)
+}
+
+func (p *Provider) lookupKey(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,97 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"restaurant-ordering-system/internal/pkg/oidc/oidctest"
+)
+
+func TestProviderLogin(t *testing.T) {
+	ctx := context.Background()
+	server := oidctest.NewServer()
+	defer server.Close()
+	provider := NewProvider(server.Config("fake"), "http://localhost:3000/oidc/callback", http.DefaultClient)
+
+	login := func(t *testing.T, verifier string) string {
+		t.Helper()
+		authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", verifier)
+		require.NoError(t, err)
+		code, state, err := server.Authorize(authURL)
+		require.NoError(t, err)
+		require.Equal(t, "state", state)
+		return code
+	}
+
+	server.SetUser(oidctest.User{Subject: "alice", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+	verifier := oauth2.GenerateVerifier()
+	code := login(t, verifier)
+	identity, err := provider.Exchange(ctx, code, verifier, "nonce")
+	require.NoError(t, err)
+	require.Equal(t, &Identity{
+		Issuer:        server.URL,
+		Subject:       "alice",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Name:          "Alice",
+	}, identity)
+
+	// Codes are single-use
+	_, err = provider.Exchange(ctx, code, verifier, "nonce")
+	require.ErrorIs(t, err, ErrLoginFailed)
+
+	// The code is bound to the PKCE verifier
+	code = login(t, verifier)
+	_, err = provider.Exchange(ctx, code, oauth2.GenerateVerifier(), "nonce")
+	require.ErrorIs(t, err, ErrLoginFailed)
+
+	// The ID token is bound to the nonce of the login
+	code = login(t, verifier)
+	_, err = provider.Exchange(ctx, code, verifier, "other-nonce")
+	require.ErrorIs(t, err, ErrLoginFailed)
+}
+
+func TestProviderChecksAudienceAndIssuer(t *testing.T) {
+	ctx := context.Background()
+	server := oidctest.NewServer()
+	defer server.Close()
+	provider := NewProvider(server.Config("fake"), "http://localhost:3000/oidc/callback", http.DefaultClient)
+	require.NoError(t, provider.discover(ctx))
+
+	// An ID token issued to another client
+	provider.oauth2.ClientID = "other-client"
+	_, err := provider.verifyIDToken(ctx, issueIDToken(t, server), "nonce")
+	require.ErrorIs(t, err, ErrLoginFailed)
+	provider.oauth2.ClientID = oidctest.ClientID
+	_, err = provider.verifyIDToken(ctx, issueIDToken(t, server), "nonce")
+	require.NoError(t, err)
+
+	// The issuer advertised by discovery must be the configured one
+	cfg := server.Config("fake")
+	cfg.Issuer = server.URL + "/"
+	require.NoError(t, NewProvider(cfg, "http://localhost:3000/oidc/callback", http.DefaultClient).discover(ctx))
+	cfg.Issuer = strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	require.ErrorContains(t, NewProvider(cfg, "http://localhost:3000/oidc/callback", http.DefaultClient).discover(ctx), "claims to be issuer")
+}
+
+// issueIDToken returns an ID token of the current user of the server with the "nonce" nonce
+func issueIDToken(t *testing.T, server *oidctest.Server) string {
+	t.Helper()
+	config := oauth2.Config{
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  "http://localhost:3000/oidc/callback",
+		Endpoint:     oauth2.Endpoint{AuthURL: server.URL + "/authorize", TokenURL: server.URL + "/token"},
+	}
+	verifier := oauth2.GenerateVerifier()
+	code, _, err := server.Authorize(config.AuthCodeURL("state", oauth2.S256ChallengeOption(verifier), oauth2.SetAuthURLParam("nonce", "nonce")))
+	require.NoError(t, err)
+	token, err := config.Exchange(context.Background(), code, oauth2.VerifierOption(verifier))
+	require.NoError(t, err)
+	return token.Extra("id_token").(string)
+}
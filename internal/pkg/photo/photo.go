@@ -0,0 +1,116 @@
+// Package photo turns uploaded photos into resized JPEG variants and a blurhash
+// placeholder, to be shown while the variants load
+package photo
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+	"sort"
+
+	"restaurant-ordering-system/internal/pkg/config"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// Content types of the photos which can be uploaded, as sniffed from their content
+var contentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+var (
+	ErrUnsupportedFormat = errors.New("photo must be a JPEG, PNG or WebP image")
+	ErrTooManyPixels     = errors.New("photo has too many pixels")
+)
+
+// blurhashWidth is the width of the thumbnail the blurhash is computed from,
+// which only holds a few colors anyway
+const blurhashWidth = 32
+
+// Photo is a processed photo
+type Photo struct {
+	ContentType string
+	Width       int
+	Height      int
+	Blurhash    string
+	Variants    []Variant
+}
+
+// Variant is a JPEG of the photo resized to Width
+type Variant struct {
+	Name   string
+	Width  int
+	Height int
+	Data   []byte
+}
+
+// Process decodes data and resizes it to every width of cfg.VariantWidths, never
+// enlarging it. JPEGs are first rotated as their EXIF orientation says. Transparent
+// areas become white, and variants are sorted by width.
+func Process(data []byte, cfg config.PhotosConfig) (*Photo, error) {
+	contentType := http.DetectContentType(data)
+	if !contentTypes[contentType] {
+		return nil, ErrUnsupportedFormat
+	}
+	// Check the dimensions before decoding, which allocates the pixels
+	imgCfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if imgCfg.Width <= 0 || imgCfg.Height <= 0 || imgCfg.Width > cfg.MaxPixels/imgCfg.Height {
+		return nil, ErrTooManyPixels
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if contentType == "image/jpeg" {
+		src = orient(src, jpegOrientation(data))
+	}
+	bounds := src.Bounds()
+
+	p := &Photo{
+		ContentType: contentType,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}
+	p.Blurhash = Blurhash(resize(src, min(blurhashWidth, p.Width)), 4, 3)
+
+	for name, width := range cfg.VariantWidths {
+		img := resize(src, min(width, p.Width))
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: cfg.JPEGQuality}); err != nil {
+			return nil, err
+		}
+		p.Variants = append(p.Variants, Variant{
+			Name:   name,
+			Width:  img.Bounds().Dx(),
+			Height: img.Bounds().Dy(),
+			Data:   buf.Bytes(),
+		})
+	}
+	sort.Slice(p.Variants, func(i, j int) bool {
+		if p.Variants[i].Width != p.Variants[j].Width {
+			return p.Variants[i].Width < p.Variants[j].Width
+		}
+		return p.Variants[i].Name < p.Variants[j].Name
+	})
+	return p, nil
+}
+
+// resize scales src to width, keeping its aspect ratio, over a white background
+func resize(src image.Image, width int) *image.RGBA {
+	bounds := src.Bounds()
+	height := max(1, (bounds.Dy()*width+bounds.Dx()/2)/bounds.Dx())
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+	return dst
+}
@@ -0,0 +1,133 @@
+package photo
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"restaurant-ordering-system/internal/pkg/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcess(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 200, 100))
+	for y := range 100 {
+		for x := range 200 {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	cfg := config.PhotosConfig{
+		MaxPixels:     200 * 100,
+		JPEGQuality:   80,
+		VariantWidths: map[string]int{"large": 400, "thumbnail": 50},
+	}
+
+	p, err := Process(buf.Bytes(), cfg)
+	require.NoError(t, err)
+	require.Equal(t, "image/png", p.ContentType)
+	require.Equal(t, 200, p.Width)
+	require.Equal(t, 100, p.Height)
+	require.Len(t, p.Blurhash, 28)
+	require.Len(t, p.Variants, 2)
+	// Variants are sorted by width and never enlarged
+	for i, want := range []struct {
+		name          string
+		width, height int
+	}{{"thumbnail", 50, 25}, {"large", 200, 100}} {
+		variant := p.Variants[i]
+		require.Equal(t, want.name, variant.Name)
+		require.Equal(t, want.width, variant.Width)
+		require.Equal(t, want.height, variant.Height)
+		decoded, err := jpeg.Decode(bytes.NewReader(variant.Data))
+		require.NoError(t, err)
+		require.Equal(t, image.Rect(0, 0, want.width, want.height), decoded.Bounds())
+	}
+
+	cfg.MaxPixels = 200*100 - 1
+	_, err = Process(buf.Bytes(), cfg)
+	require.ErrorIs(t, err, ErrTooManyPixels)
+	_, err = Process([]byte("GIF89a"+strings.Repeat("\x00", 20)), cfg)
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+	// Sniffed as a JPEG, but truncated
+	_, err = Process([]byte("\xff\xd8\xff\xe0"), cfg)
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+// withOrientation inserts an EXIF segment with orientation into a JPEG
+func withOrientation(data []byte, orientation byte) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08" +
+		"\x00\x01" + // One entry: orientation, a SHORT
+		"\x01\x12\x00\x03\x00\x00\x00\x01\x00" + string(orientation) + "\x00\x00" +
+		"\x00\x00\x00\x00")
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := append([]byte{0xff, 0xe1, 0, byte(len(segment) + 2)}, segment...)
+	return append(append(append([]byte{}, data[:2]...), app1...), data[2:]...)
+}
+
+func TestProcessOrientation(t *testing.T) {
+	// Red on the left, blue on the right
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for y := range 20 {
+		for x := range 40 {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 20 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	cfg := config.PhotosConfig{
+		MaxPixels:     40 * 20,
+		JPEGQuality:   100,
+		VariantWidths: map[string]int{"large": 40},
+	}
+	require.Equal(t, 1, jpegOrientation(buf.Bytes()))
+
+	for _, tt := range []struct {
+		orientation   byte
+		width, height int
+		// Whether red ends up at the top, or else at the bottom, of a rotated photo
+		top bool
+	}{{6, 20, 40, true}, {8, 20, 40, false}} {
+		data := withOrientation(buf.Bytes(), tt.orientation)
+		require.Equal(t, int(tt.orientation), jpegOrientation(data))
+		p, err := Process(data, cfg)
+		require.NoError(t, err)
+		require.Equal(t, tt.width, p.Width)
+		require.Equal(t, tt.height, p.Height)
+		decoded, err := jpeg.Decode(bytes.NewReader(p.Variants[0].Data))
+		require.NoError(t, err)
+		require.Equal(t, image.Rect(0, 0, tt.width, tt.height), decoded.Bounds())
+		topRed, _, _, _ := decoded.At(10, 5).RGBA()
+		bottomRed, _, _, _ := decoded.At(10, 35).RGBA()
+		require.Equal(t, tt.top, topRed > bottomRed, "orientation %d", tt.orientation)
+	}
+
+	// Orientations only apply to JPEGs, and unknown ones are ignored
+	require.Equal(t, 1, jpegOrientation(withOrientation(buf.Bytes(), 9)))
+	require.Equal(t, 1, jpegOrientation([]byte("\x89PNG")))
+}
+
+func TestBlurhash(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 6))
+	for y := range 6 {
+		for x := range 8 {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	// The size flag, the maximum AC value, the average color and 2 characters per AC component
+	hash := Blurhash(img, 4, 3)
+	require.Len(t, hash, 1+1+4+2*11)
+	require.Equal(t, "L", hash[:1])
+	require.Equal(t, "TI:j", hash[2:6], "average color #FF0000")
+	require.Equal(t, "00TI:j", Blurhash(img, 1, 1))
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"errors"
+
+	"restaurant-ordering-system/internal/pkg/breaker"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NewBreakerHook returns a hook which stops sending commands to Redis while b is open.
+// Commands rejected by the hook fail with breaker.ErrOpen.
+func NewBreakerHook(b *breaker.Breaker) redis.Hook {
+	return breakerHook{b: b}
+}
+
+type breakerHook struct {
+	b *breaker.Breaker
+}
+
+func (h breakerHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h breakerHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.b.Allow(); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		err := next(ctx, cmd)
+		h.record(err)
+		return err
+	}
+}
+
+func (h breakerHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := h.b.Allow(); err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+		err := next(ctx, cmds)
+		h.record(err)
+		return err
+	}
+}
+
+func (h breakerHook) record(err error) {
+	switch {
+	case errors.Is(err, context.Canceled):
+		// Canceled calls tell nothing about the health of Redis
+	case isFailure(err):
+		h.b.Failure()
+	default:
+		h.b.Success()
+	}
+}
+
+// IsUnavailable reports whether err means that Redis could not be reached,
+// as opposed to Redis replying with an error such as redis.Nil
+func IsUnavailable(err error) bool {
+	return errors.Is(err, breaker.ErrOpen) || isFailure(err)
+}
+
+// isFailure reports whether err is caused by Redis being unhealthy.
+// Error replies prove that Redis is reachable.
+func isFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var redisErr redis.Error
+	if errors.As(err, &redisErr) {
+		return false
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, breaker.ErrOpen)
+}
@@ -0,0 +1,172 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrInvalidStreamID is returned for event IDs that are not stream entry IDs
+var ErrInvalidStreamID = errors.New("invalid stream ID")
+
+// AddTabEvent appends event to the event log of tabID, dropping the oldest events
+// beyond tabEventsMaxLen
+func (q *RedisQueries) AddTabEvent(ctx context.Context, tabID model.TabID, event *model.TabEvent) error {
+	values := []any{"type", string(event.Type)}
+	if event.OrderID != nil {
+		values = append(values, "order_id", event.OrderID.String())
+	}
+	if event.OrderItemID != nil {
+		values = append(values, "order_item_id", event.OrderItemID.String())
+	}
+	if event.GuestID != nil {
+		values = append(values, "guest_id", event.GuestID.String())
+	}
+
+	key := tabEventsKey(tabID)
+	if err := q.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: tabEventsMaxLen,
+		Approx: true,
+		Values: values,
+	}).Err(); err != nil {
+		return err
+	}
+	return q.rdb.Expire(ctx, key, tabEventsTTL).Err()
+}
+
+// GetTabEventsCursor returns the ID after which the events of tabID are read.
+// Without lastEventID, only the events added from now on are read. missed reports
+// whether events following lastEventID may have been dropped from the log, in which
+// case reading also starts from now on. ErrInvalidStreamID is returned if lastEventID
+// is not a stream entry ID.
+func (q *RedisQueries) GetTabEventsCursor(ctx context.Context, tabID model.TabID, lastEventID string) (cursor string, missed bool, err error) {
+	key := tabEventsKey(tabID)
+	if lastEventID != "" {
+		if !isStreamID(lastEventID) {
+			return "", false, ErrInvalidStreamID
+		}
+		oldest, err := q.rdb.XRangeN(ctx, key, "-", "+", 1).Result()
+		if err != nil {
+			return "", false, err
+		}
+		// The event log expired, or lost its oldest events
+		missed = len(oldest) == 0 || compareStreamIDs(oldest[0].ID, lastEventID) > 0
+		if !missed {
+			return lastEventID, false, nil
+		}
+	}
+
+	latest, err := q.rdb.XRevRangeN(ctx, key, "+", "-", 1).Result()
+	if err != nil {
+		return "", false, err
+	}
+	if len(latest) == 0 {
+		return "0-0", missed, nil
+	}
+	return latest[0].ID, missed, nil
+}
+
+// WaitTabEvents returns the events of tabID added after cursor, waiting up to
+// block for the first one. No events are returned if none were added in time.
+func (q *RedisQueries) WaitTabEvents(ctx context.Context, tabID model.TabID, cursor string, block time.Duration) ([]*model.TabEvent, error) {
+	streams, err := q.rdb.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{tabEventsKey(tabID), cursor},
+		Count:   100,
+		Block:   block,
+	}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var events []*model.TabEvent
+	for _, stream := range streams {
+		for _, message := range stream.Messages {
+			event, err := tabEventFromMessage(message)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func tabEventFromMessage(message redis.XMessage) (*model.TabEvent, error) {
+	event := &model.TabEvent{ID: message.ID}
+	if v, ok := message.Values["type"].(string); ok {
+		event.Type = model.TabEventType(v)
+	}
+	if v, ok := message.Values["order_id"].(string); ok {
+		id, err := model.ParseOrderID(v)
+		if err != nil {
+			return nil, err
+		}
+		event.OrderID = &id
+	}
+	if v, ok := message.Values["order_item_id"].(string); ok {
+		id, err := model.ParseOrderItemID(v)
+		if err != nil {
+			return nil, err
+		}
+		event.OrderItemID = &id
+	}
+	if v, ok := message.Values["guest_id"].(string); ok {
+		id, err := model.ParseGuestID(v)
+		if err != nil {
+			return nil, err
+		}
+		event.GuestID = &id
+	}
+	return event, nil
+}
+
+// isStreamID reports whether id is a complete stream entry ID, e.g. "1700000000000-0"
+func isStreamID(id string) bool {
+	_, _, ok := parseStreamID(id)
+	return ok
+}
+
+func parseStreamID(id string) (ms, seq uint64, ok bool) {
+	msStr, seqStr, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseUint(msStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err = strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return ms, seq, true
+}
+
+// compareStreamIDs compares two valid stream entry IDs like strings.Compare
+func compareStreamIDs(a, b string) int {
+	aMs, aSeq, _ := parseStreamID(a)
+	bMs, bSeq, _ := parseStreamID(b)
+	switch {
+	case aMs != bMs:
+		if aMs < bMs {
+			return -1
+		}
+		return 1
+	case aSeq < bSeq:
+		return -1
+	case aSeq > bSeq:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTabEvents(t *testing.T) {
+	ctx := t.Context()
+	q := New(newTestRedis(t))
+	tabID := model.TabID(uuid.New())
+	guestID := model.GuestID{TabID: tabID, Scoped: 1}
+
+	// Following a tab without events
+	cursor, missed, err := q.GetTabEventsCursor(ctx, tabID, "")
+	require.NoError(t, err)
+	require.False(t, missed)
+	events, err := q.WaitTabEvents(ctx, tabID, cursor, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	require.NoError(t, q.AddTabEvent(ctx, tabID, &model.TabEvent{Type: model.GuestCreated, GuestID: &guestID}))
+	require.NoError(t, q.AddTabEvent(ctx, tabID, &model.TabEvent{Type: model.TabClosed}))
+	events, err = q.WaitTabEvents(ctx, tabID, cursor, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, model.GuestCreated, events[0].Type)
+	require.Equal(t, &guestID, events[0].GuestID)
+	require.Equal(t, model.TabClosed, events[1].Type)
+
+	// Resuming after the first event
+	cursor, missed, err = q.GetTabEventsCursor(ctx, tabID, events[0].ID)
+	require.NoError(t, err)
+	require.False(t, missed)
+	require.Equal(t, events[0].ID, cursor)
+	resumed, err := q.WaitTabEvents(ctx, tabID, cursor, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, events[1:], resumed)
+
+	// Resuming after dropped events
+	cursor, missed, err = q.GetTabEventsCursor(ctx, tabID, "1-0")
+	require.NoError(t, err)
+	require.True(t, missed)
+	require.Equal(t, events[1].ID, cursor)
+	_, _, err = q.GetTabEventsCursor(ctx, tabID, "not-an-id")
+	require.ErrorIs(t, err, ErrInvalidStreamID)
+}
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/model"
+)
+
+// LoginLockoutPolicy describes when the logins of a subject are locked out
+type LoginLockoutPolicy struct {
+	// MaxFailures is the number of failures within Window locking the subject out
+	MaxFailures int
+	Window      time.Duration
+	// Lockout is doubled by every failure past MaxFailures, up to MaxLockout
+	Lockout    time.Duration
+	MaxLockout time.Duration
+}
+
+// LoginIDSubject is the subject counting the failed logins with a login ID
+func LoginIDSubject(loginID model.LoginID) string {
+	return "login_id:" + loginID.String()
+}
+
+// ClientIPSubject is the subject counting the failed logins from a client IP
+func ClientIPSubject(ip string) string {
+	return "ip:" + ip
+}
+
+// GetLoginLockout returns how long the logins of the subjects are still locked out, or 0
+func (q *RedisQueries) GetLoginLockout(ctx context.Context, subjects ...string) (time.Duration, error) {
+	var lockout time.Duration
+	for _, subject := range subjects {
+		ttl, err := q.rdb.PTTL(ctx, loginLockoutKey(subject)).Result()
+		if err != nil {
+			return 0, err
+		}
+		// Negative TTLs mean that the key does not exist
+		lockout = max(lockout, ttl)
+	}
+	return lockout, nil
+}
+
+// RecordLoginFailure counts a failed login of the subject, returning the lockout it caused or 0
+func (q *RedisQueries) RecordLoginFailure(ctx context.Context, subject string, policy LoginLockoutPolicy) (time.Duration, error) {
+	lockout, err := q.runScript(ctx, recordLoginFailureScript,
+		[]string{loginFailuresKey(subject), loginLockoutKey(subject)},
+		policy.MaxFailures, policy.Window.Milliseconds(), policy.Lockout.Milliseconds(), policy.MaxLockout.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(lockout) * time.Millisecond, nil
+}
+
+// ResetLoginFailures forgets the failed logins of the subject and lifts its lockout
+func (q *RedisQueries) ResetLoginFailures(ctx context.Context, subject string) error {
+	return q.rdb.Del(ctx, loginFailuresKey(subject), loginLockoutKey(subject)).Err()
+}
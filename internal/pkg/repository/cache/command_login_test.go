@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginLockout(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := t.Context()
+	q := New(rdb)
+
+	policy := LoginLockoutPolicy{MaxFailures: 3, Window: time.Minute, Lockout: time.Second, MaxLockout: 3 * time.Second}
+	subject := LoginIDSubject("alice")
+	other := ClientIPSubject("192.0.2.1")
+
+	for range 2 {
+		lockout, err := q.RecordLoginFailure(ctx, subject, policy)
+		require.NoError(t, err)
+		require.Zero(t, lockout)
+	}
+	lockout, err := q.GetLoginLockout(ctx, subject, other)
+	require.NoError(t, err)
+	require.Zero(t, lockout)
+
+	// The lockout doubles with every further failure, up to the maximum
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second} {
+		lockout, err := q.RecordLoginFailure(ctx, subject, policy)
+		require.NoError(t, err)
+		require.Equal(t, want, lockout)
+	}
+	lockout, err = q.GetLoginLockout(ctx, other, subject)
+	require.NoError(t, err)
+	require.Greater(t, lockout, 2*time.Second)
+
+	require.NoError(t, q.ResetLoginFailures(ctx, subject))
+	lockout, err = q.GetLoginLockout(ctx, subject)
+	require.NoError(t, err)
+	require.Zero(t, lockout)
+	lockout, err = q.RecordLoginFailure(ctx, subject, policy)
+	require.NoError(t, err)
+	require.Zero(t, lockout)
+}
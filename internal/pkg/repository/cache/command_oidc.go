@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// OIDCLogin is a login started with an OpenID Connect provider, kept until the
+// customer is redirected back with its state
+type OIDCLogin struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+	// BindingHash is the hash of the secret kept by the browser which started the login
+	BindingHash []byte `json:"binding_hash"`
+}
+
+// SaveOIDCLogin keeps the login for ttl under its state
+func (q *RedisQueries) SaveOIDCLogin(ctx context.Context, state string, login OIDCLogin, ttl time.Duration) error {
+	data, err := json.Marshal(login)
+	if err != nil {
+		return err
+	}
+	return q.rdb.Set(ctx, oidcLoginKey(state), data, ttl).Err()
+}
+
+// TakeOIDCLogin returns and deletes the login of the state, so that every state is
+// used once. It returns nil if the state is unknown or expired.
+func (q *RedisQueries) TakeOIDCLogin(ctx context.Context, state string) (*OIDCLogin, error) {
+	data, err := q.rdb.GetDel(ctx, oidcLoginKey(state)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var login OIDCLogin
+	if err := json.Unmarshal(data, &login); err != nil {
+		return nil, err
+	}
+	return &login, nil
+}
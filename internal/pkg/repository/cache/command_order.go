@@ -2,9 +2,11 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"math"
 	"slices"
+	"strconv"
 
 	"restaurant-ordering-system/internal/pkg/model"
 
@@ -22,8 +24,53 @@ func (q *RedisQueries) GetNextOrderItemID(ctx context.Context, id model.OrderID)
 	return model.ScopedOrderItemID(nextItemID), nil
 }
 
-func (q *RedisQueries) CreateOrderItem(ctx context.Context, item *model.OrderItem) {
-	q.cacheOrderItem(ctx, item)
+// orderItemScriptArgs is the argument of createOrderItemScript
+type orderItemScriptArgs struct {
+	ScopedID       string   `json:"scoped_id"`
+	Fields         []string `json:"fields"`
+	GuestOwners    []string `json:"guest_owners"`
+	CustomerOwners []string `json:"customer_owners"`
+}
+
+func newOrderItemScriptArgs(item *model.OrderItem) orderItemScriptArgs {
+	args := orderItemScriptArgs{
+		ScopedID: strconv.Itoa(int(item.ID.Scoped)),
+		Fields: []string{
+			"scoped_id", strconv.Itoa(int(item.ID.Scoped)),
+			"menu_item_id", item.MenuItemID.String(),
+			"name", item.Name,
+			"description", item.Description,
+			"photo_pathinfo", item.PhotoPathinfo,
+			"price", strconv.Itoa(int(item.Price)),
+			"portion_size", strconv.Itoa(int(item.PortionSize)),
+			"modifiers_config", string(item.ModifiersConfig),
+			"modifiers", string(item.Modifiers),
+			"quantity", strconv.Itoa(int(item.Quantity)),
+		},
+		GuestOwners:    make([]string, len(item.GuestOwnerIDs)),
+		CustomerOwners: make([]string, len(item.CustomerOwnerIDs)),
+	}
+	for i, id := range item.GuestOwnerIDs {
+		args.GuestOwners[i] = id.Scoped.String()
+	}
+	for i, id := range item.CustomerOwnerIDs {
+		args.CustomerOwners[i] = id.String()
+	}
+	return args
+}
+
+// CountNotSentOrderItems returns the number of items of the order not sent yet
+func (q *RedisQueries) CountNotSentOrderItems(ctx context.Context, tabID model.TabID) (int64, error) {
+	return q.rdb.ZCard(ctx, orderItemsListKey(tabID)).Result()
+}
+
+func (q *RedisQueries) CreateOrderItem(ctx context.Context, item *model.OrderItem) error {
+	argsJSON, err := json.Marshal(newOrderItemScriptArgs(item))
+	if err != nil {
+		return err
+	}
+	keys := append([]string{orderItemsListKey(item.ID.OrderID.TabID)}, orderItemKeys(item.ID)...)
+	return q.runScript(ctx, createOrderItemScript, keys, string(argsJSON)).Err()
 }
 
 func (q *RedisQueries) UpdateOrderItemModifiers(ctx context.Context, id model.OrderItemID, modifiers []byte) {
@@ -51,10 +98,8 @@ func (q *RedisQueries) RemoveOrderItemCustomerOwner(ctx context.Context, orderIt
 }
 
 func (q *RedisQueries) DeleteOrderItem(ctx context.Context, id model.OrderItemID) {
-	q.rdb.ZRem(ctx, orderItemsListKey(id.OrderID.TabID), id.Scoped)
-	q.rdb.Del(ctx, orderItemKey(id))
-	q.rdb.Del(ctx, orderItemGuestOwnersListKey(id))
-	q.rdb.Del(ctx, orderItemCustomerOwnersListKey(id))
+	keys := append([]string{orderItemsListKey(id.OrderID.TabID)}, orderItemKeys(id)...)
+	q.runScript(ctx, deleteOrderItemScript, keys, id.Scoped.String())
 }
 
 func WatchAndCheckOrderNotSent(ctx context.Context, tx *redis.Tx, orderID model.OrderID) (bool, error) {
@@ -72,6 +117,23 @@ func WatchAndCheckOrderNotSent(ctx context.Context, tx *redis.Tx, orderID model.
 	return model.ScopedOrderID(notSentOrderID) == orderID.Scoped, nil
 }
 
+// WatchAndGetOrderItemModifiersConfig returns the modifiers config the order item
+// was created with, or redis.Nil when the order item does not exist
+func WatchAndGetOrderItemModifiersConfig(ctx context.Context, tx *redis.Tx, id model.OrderItemID) ([]byte, error) {
+	if err := tx.Watch(ctx, orderItemKey(id)).Err(); err != nil {
+		return nil, err
+	}
+	values, err := tx.HMGet(ctx, orderItemKey(id), "scoped_id", "modifiers_config").Result()
+	if err != nil {
+		return nil, err
+	}
+	if values[0] == nil {
+		return nil, redis.Nil
+	}
+	modifiersConfig, _ := values[1].(string)
+	return []byte(modifiersConfig), nil
+}
+
 func WatchAndGetNotSentOrderIDAndItemIDs(ctx context.Context, tx *redis.Tx, tabID model.TabID) (model.OrderID, []model.OrderItemID, error) {
 	tx.Watch(ctx, tabNotSentOrderIDKey(tabID), orderItemsListKey(tabID))
 
@@ -149,6 +211,7 @@ func WatchAndGetOrderItems(ctx context.Context, tx *redis.Tx, orderItemIDs []mod
 		if orderItems[i], err = OrderItemFromCmds(orderItemIDs[i].OrderID, cmds[0], cmds[1], cmds[2]); err != nil {
 			return nil, err
 		}
+		i++
 	}
 
 	return orderItems, nil
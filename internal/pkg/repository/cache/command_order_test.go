@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTab caches an open tab whose order not sent yet has items, of quantities
+// 1, 2 and so on
+func newTestTab(t *testing.T, q *RedisQueries, items int) *model.Tab {
+	t.Helper()
+	tabID := model.TabID(uuid.New())
+	order := &model.Order{ID: model.OrderID{TabID: tabID, Scoped: 1}}
+	for i := range items {
+		order.Items = append(order.Items, &model.OrderItem{
+			ID:         model.OrderItemID{OrderID: order.ID, Scoped: model.ScopedOrderItemID(i + 1)},
+			Quantity:   int16(i + 1),
+			MenuItemID: 1,
+			Name:       "Tea",
+		})
+	}
+	tab := &model.Tab{ID: tabID, CreatedAt: time.Now(), Orders: []*model.Order{order}}
+	require.NoError(t, q.CacheTab(t.Context(), tab))
+	return tab
+}
+
+func TestWatchAndGetOrderItems(t *testing.T) {
+	ctx := t.Context()
+	rdb := newTestRedis(t)
+	tab := newTestTab(t, New(rdb), 3)
+	ids := make([]model.OrderItemID, 3)
+	for i, item := range tab.Orders[0].Items {
+		ids[i] = item.ID
+	}
+
+	// Every item is returned in place, not only the first one
+	var items []*model.OrderItem
+	require.NoError(t, rdb.Watch(ctx, func(tx *redis.Tx) error {
+		var err error
+		items, err = WatchAndGetOrderItems(ctx, tx, ids)
+		return err
+	}))
+	require.Len(t, items, 3)
+	for i, item := range items {
+		require.NotNil(t, item, "item %d", i)
+		require.Equal(t, ids[i], item.ID)
+		require.Equal(t, int16(i+1), item.Quantity)
+	}
+
+	require.NoError(t, rdb.Watch(ctx, func(tx *redis.Tx) error {
+		var err error
+		items, err = WatchAndGetOrderItems(ctx, tx, nil)
+		return err
+	}))
+	require.Empty(t, items)
+}
@@ -0,0 +1,24 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/model"
+)
+
+// TabSubject is the subject limiting the calls about a tab
+func TabSubject(tabID model.TabID) string {
+	return "tab:" + tabID.String()
+}
+
+// TakeRateLimitToken takes a token from the bucket of the method for the subject,
+// holding up to burst tokens and refilled with rate tokens per second.
+// It returns 0, or how long to wait for a token when the bucket is empty.
+func (q *RedisQueries) TakeRateLimitToken(ctx context.Context, subject, method string, rate float64, burst int) (time.Duration, error) {
+	wait, err := q.runScript(ctx, takeRateLimitTokenScript, []string{rateLimitKey(subject, method)}, rate, burst).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(wait) * time.Millisecond, nil
+}
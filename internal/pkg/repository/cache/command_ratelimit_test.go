@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTakeRateLimitToken(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := t.Context()
+	q := New(rdb)
+
+	const method = "/restaurant.TabService/CreateGuest"
+	subject := ClientIPSubject("192.0.2.1")
+	for range 3 {
+		wait, err := q.TakeRateLimitToken(ctx, subject, method, 2, 3)
+		require.NoError(t, err)
+		require.Zero(t, wait)
+	}
+	wait, err := q.TakeRateLimitToken(ctx, subject, method, 2, 3)
+	require.NoError(t, err)
+	require.Greater(t, wait, time.Duration(0))
+	require.LessOrEqual(t, wait, 500*time.Millisecond)
+
+	// Buckets are separate per subject and per method
+	wait, err = q.TakeRateLimitToken(ctx, ClientIPSubject("192.0.2.2"), method, 2, 3)
+	require.NoError(t, err)
+	require.Zero(t, wait)
+	wait, err = q.TakeRateLimitToken(ctx, subject, "/restaurant.TabService/GetOpenTab", 2, 3)
+	require.NoError(t, err)
+	require.Zero(t, wait)
+
+	// The bucket is refilled over time
+	time.Sleep(600 * time.Millisecond)
+	wait, err = q.TakeRateLimitToken(ctx, subject, method, 2, 3)
+	require.NoError(t, err)
+	require.Zero(t, wait)
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RevokeCustomerSessions revokes the tokens of the customer issued so far by
+// incrementing the version of their sessions, and returns the new version. The
+// version is kept for ttl, after which the tokens issued with it have expired anyway.
+func (q *RedisQueries) RevokeCustomerSessions(ctx context.Context, customerID model.CustomerID, ttl time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	if _, err := q.rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		incr = p.Incr(ctx, customerSessionVersionKey(customerID))
+		p.Expire(ctx, customerSessionVersionKey(customerID), ttl)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// KeepCustomerSessionVersion returns the version of the sessions of the customer
+// for a new token, keeping it for the ttl of the token
+func (q *RedisQueries) KeepCustomerSessionVersion(ctx context.Context, customerID model.CustomerID, ttl time.Duration) (int64, error) {
+	version, err := q.rdb.GetEx(ctx, customerSessionVersionKey(customerID), ttl).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return version, err
+}
+
+// GetCustomerSessionVersion returns the version of the sessions of the customer,
+// or 0 if they were never revoked
+func (q *RedisQueries) GetCustomerSessionVersion(ctx context.Context, customerID model.CustomerID) (int64, error) {
+	version, err := q.rdb.Get(ctx, customerSessionVersionKey(customerID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return version, err
+}
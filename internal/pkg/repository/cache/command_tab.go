@@ -27,134 +27,85 @@ func (q *RedisQueries) CreateTab(ctx context.Context, tabID model.TabID, created
 	})
 }
 
+// tabScriptArgs is the argument of cacheTabScript
+type tabScriptArgs struct {
+	Fields       []string                `json:"fields"`
+	TTL          int64                   `json:"ttl"`
+	GuestNames   []string                `json:"guest_names"`
+	SentOrders   []string                `json:"sent_orders"`
+	NotSentOrder *notSentOrderScriptArgs `json:"not_sent_order"`
+}
+
+type notSentOrderScriptArgs struct {
+	ID        string                `json:"id"`
+	MaxItemID string                `json:"max_item_id"`
+	Items     []orderItemScriptArgs `json:"items"`
+}
+
 func (q *RedisQueries) CacheTab(ctx context.Context, tab *model.Tab) error {
-	tabValues := []any{
-		"id", tab.ID,
-		"total_price", tab.TotalPrice,
-		"created_at", tab.CreatedAt.Format(time.RFC3339Nano),
+	args := tabScriptArgs{
+		Fields: []string{
+			"id", tab.ID.String(),
+			"total_price", strconv.Itoa(int(tab.TotalPrice)),
+			"created_at", tab.CreatedAt.Format(time.RFC3339Nano),
+		},
+		GuestNames: make([]string, 0, 2*len(tab.CustomGuestNames)),
+		SentOrders: make([]string, 0, len(tab.Orders)),
 	}
 	if tab.ClosedAt != nil {
-		tabValues = append(tabValues, "closed_at", tab.ClosedAt.Format(time.RFC3339Nano))
+		args.Fields = append(args.Fields, "closed_at", tab.ClosedAt.Format(time.RFC3339Nano))
+		args.TTL = int64(tabCacheTTL / time.Second)
 	}
-	q.rdb.HSet(ctx, tabKey(tab.ID), tabValues...)
-	if tab.ClosedAt != nil {
-		q.rdb.Expire(ctx, tabKey(tab.ID), tabCacheTTL)
+	for guestID, name := range tab.CustomGuestNames {
+		args.GuestNames = append(args.GuestNames, guestID.Scoped.String(), name)
 	}
 
-	if len(tab.CustomGuestNames) > 0 {
-		for guestID, name := range tab.CustomGuestNames {
-			q.UpdateGuestName(ctx, tab.ID, guestID.Scoped, name)
+	sentOrders := tab.Orders
+	if lastOrder := tab.Orders[len(tab.Orders)-1]; lastOrder.SentAt == nil {
+		sentOrders = tab.Orders[:len(tab.Orders)-1]
+
+		var maxID model.ScopedOrderItemID
+		items := make([]orderItemScriptArgs, len(lastOrder.Items))
+		for i, item := range lastOrder.Items {
+			maxID = max(maxID, item.ID.Scoped)
+			items[i] = newOrderItemScriptArgs(item)
 		}
-		if tab.ClosedAt != nil {
-			q.rdb.Expire(ctx, tabGuestNamesKey(tab.ID), tabCacheTTL)
+		args.NotSentOrder = &notSentOrderScriptArgs{
+			ID:        strconv.Itoa(int(lastOrder.ID.Scoped)),
+			MaxItemID: strconv.Itoa(int(maxID)),
+			Items:     items,
 		}
 	}
-
-	var sentOrder []*model.Order
-	if lastOrder := tab.Orders[len(tab.Orders)-1]; lastOrder.SentAt != nil {
-		sentOrder = tab.Orders
-	} else {
-		sentOrder = tab.Orders[:len(tab.Orders)-1]
-		q.cacheNotSentOrder(ctx, lastOrder)
+	for _, order := range sentOrders {
+		orderJSON, err := json.Marshal(order)
+		if err != nil {
+			return err
+		}
+		args.SentOrders = append(args.SentOrders, string(orderJSON))
 	}
 
-	if err := q.cacheSentOrders(ctx, sentOrder, tab.ClosedAt != nil); err != nil {
+	argsJSON, err := json.Marshal(args)
+	if err != nil {
 		return err
 	}
 
-	return nil
-}
-
-func (q *RedisQueries) cacheNotSentOrder(ctx context.Context, order *model.Order) {
-	q.rdb.Del(ctx, orderItemsListKey(order.ID.TabID))
-
-	var maxID model.ScopedOrderItemID
-	for _, item := range order.Items {
-		maxID = max(maxID, model.ScopedOrderItemID(item.ID.Scoped))
-		q.cacheOrderItem(ctx, item)
-	}
-	q.rdb.Set(ctx, orderItemIDSequenceKey(order.ID.TabID), maxID, 0)
-	q.rdb.Set(ctx, tabNotSentOrderIDKey(order.ID.TabID), order.ID.Scoped, 0)
-}
-
-func (q *RedisQueries) cacheOrderItem(ctx context.Context, item *model.OrderItem) {
-	q.rdb.ZAdd(ctx, orderItemsListKey(item.ID.OrderID.TabID), redis.Z{
-		Score:  float64(item.ID.Scoped),
-		Member: item.ID.Scoped,
-	})
-	q.rdb.HSet(ctx, orderItemKey(item.ID),
-		"scoped_id", item.ID.Scoped,
-		"menu_item_id", item.MenuItemID,
-		"name", item.Name,
-		"description", item.Description,
-		"photo_pathinfo", item.PhotoPathinfo,
-		"price", item.Price,
-		"portion_size", item.PortionSize,
-		"modifiers_config", string(item.ModifiersConfig),
-	)
-	q.UpdateOrderItemModifiers(ctx, item.ID, item.Modifiers)
-	q.UpdateOrderItemQuantity(ctx, item.ID, item.Quantity)
-	q.rdb.Del(ctx, orderItemGuestOwnersListKey(item.ID))
-	for _, guestID := range item.GuestOwnerIDs {
-		q.AddOrderItemGuestOwner(ctx, item.ID, guestID)
-	}
-	q.rdb.Del(ctx, orderItemCustomerOwnersListKey(item.ID))
-	for _, customerID := range item.CustomerOwnerIDs {
-		q.AddOrderItemCustomerOwner(ctx, item.ID, customerID)
-	}
-}
-
-func (q *RedisQueries) cacheSentOrders(ctx context.Context, orders []*model.Order, tabClosed bool) error {
-	if len(orders) > 0 {
-		tabID := orders[0].ID.TabID
-
-		sentOrdersJSON := make([]any, len(orders))
-		for i, order := range orders {
-			orderJSON, err := json.Marshal(order)
-			if err != nil {
-				return err
-			}
-			sentOrdersJSON[i] = string(orderJSON)
-		}
-		q.rdb.Del(ctx, ordersListKey(tabID))
-		q.rdb.RPush(ctx, ordersListKey(tabID), sentOrdersJSON...)
-		if tabClosed {
-			q.rdb.Expire(ctx, ordersListKey(tabID), tabCacheTTL)
-		}
-	}
-
-	return nil
+	return q.runScript(ctx, cacheTabScript, TabKeys(tab), string(argsJSON)).Err()
 }
 
 func (q *RedisQueries) InvalidateTab(ctx context.Context, tabID model.TabID, notSentOrderItemIDs []model.OrderItemID) {
-	q.rdb.Del(ctx, tabKey(tabID))
-	q.rdb.Del(ctx, tabGuestNamesKey(tabID))
-	q.rdb.Del(ctx, ordersListKey(tabID))
-	q.rdb.Del(ctx, tabNotSentOrderIDKey(tabID))
-	q.rdb.Del(ctx, orderItemIDSequenceKey(tabID))
-	q.rdb.Del(ctx, orderItemsListKey(tabID))
-	if len(notSentOrderItemIDs) > 0 {
-		orderItemKeys := make([]string, len(notSentOrderItemIDs))
-		orderItemGuestOwnersListKeys := make([]string, len(notSentOrderItemIDs))
-		orderItemCustomerOwnersListKeys := make([]string, len(notSentOrderItemIDs))
-		for i, id := range notSentOrderItemIDs {
-			orderItemKeys[i] = orderItemKey(id)
-			orderItemGuestOwnersListKeys[i] = orderItemGuestOwnersListKey(id)
-			orderItemCustomerOwnersListKeys[i] = orderItemCustomerOwnersListKey(id)
-		}
-		q.rdb.Del(ctx, orderItemKeys...)
-		q.rdb.Del(ctx, orderItemGuestOwnersListKeys...)
-		q.rdb.Del(ctx, orderItemCustomerOwnersListKeys...)
-	}
+	q.runScript(ctx, invalidateTabScript, tabKeys(tabID, notSentOrderItemIDs))
 }
 
+// ErrTabClosed is returned by GetOpenTabWithOrders for closed tabs
+var ErrTabClosed = errors.New("tab is already closed")
+
 func (q *RedisQueries) GetOpenTabWithOrders(ctx context.Context, id model.TabID) (*model.Tab, error) {
 	tab, err := q.getTabWithOrders(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if tab.ClosedAt != nil {
-		return nil, errors.New("tab is already closed")
+		return nil, ErrTabClosed
 	}
 	return tab, nil
 }
@@ -301,10 +252,12 @@ func (q *RedisQueries) getTabsWithOrders(ctx context.Context, ids []model.TabID)
 		orders := tabs[i].Orders
 		lastOrder := orders[len(orders)-1]
 		lastOrder.Items = make([]*model.OrderItem, len(orderItemIDsStr))
-		if lastOrder.Items[i], err = OrderItemFromCmds(lastOrder.ID, cmds[j+0], cmds[j+1], cmds[j+2]); err != nil {
-			return nil, err
+		for k := range lastOrder.Items {
+			if lastOrder.Items[k], err = OrderItemFromCmds(lastOrder.ID, cmds[j+0], cmds[j+1], cmds[j+2]); err != nil {
+				return nil, err
+			}
+			j += 3
 		}
-		j += 3
 	}
 
 	return tabs, nil
@@ -313,3 +266,7 @@ func (q *RedisQueries) getTabsWithOrders(ctx context.Context, ids []model.TabID)
 func (q *RedisQueries) UpdateGuestName(ctx context.Context, tabID model.TabID, scopedGuestID model.ScopedGuestID, name string) {
 	q.rdb.HSet(ctx, tabGuestNamesKey(tabID), strconv.Itoa(int(scopedGuestID)), name)
 }
+
+func (q *RedisQueries) RemoveGuestName(ctx context.Context, tabID model.TabID, scopedGuestID model.ScopedGuestID) {
+	q.rdb.HDel(ctx, tabGuestNamesKey(tabID), strconv.Itoa(int(scopedGuestID)))
+}
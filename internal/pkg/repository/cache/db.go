@@ -18,4 +18,8 @@ type RedisQueries struct {
 
 const (
 	tabCacheTTL = 1 * time.Hour
+
+	// tabEventsMaxLen bounds the number of events kept per tab for resuming followers
+	tabEventsMaxLen = 1000
+	tabEventsTTL    = 12 * time.Hour
 )
@@ -8,40 +8,122 @@ import (
 	"restaurant-ordering-system/internal/pkg/model"
 )
 
+// Every key of a tab is hash-tagged with the tab ID so that all of them belong to
+// the same Redis Cluster slot, which multi-key scripts and transactions require.
+
 func tabKey(id model.TabID) string {
-	return fmt.Sprintf("tab:%s", id)
+	return fmt.Sprintf("tab:{%s}", id)
 }
 
 func tabGuestNamesKey(id model.TabID) string {
-	return fmt.Sprintf("tab:%s:guest_names", id)
+	return fmt.Sprintf("tab:{%s}:guest_names", id)
 }
 
 func ordersListKey(id model.TabID) string {
-	return fmt.Sprintf("tab:%s:orders", id)
+	return fmt.Sprintf("tab:{%s}:orders", id)
 }
 
 func tabNotSentOrderIDKey(id model.TabID) string {
-	return fmt.Sprintf("tab:%s:not_sent_order:id", id)
+	return fmt.Sprintf("tab:{%s}:not_sent_order:id", id)
 }
 
 func orderItemIDSequenceKey(id model.TabID) string {
-	return fmt.Sprintf("tab:%s:not_sent_order:order_item_id_sequence", id)
+	return fmt.Sprintf("tab:{%s}:not_sent_order:order_item_id_sequence", id)
 }
 
 func orderItemsListKey(id model.TabID) string {
-	return fmt.Sprintf("tab:%s:not_sent_order:order_items", id)
+	return fmt.Sprintf("tab:{%s}:not_sent_order:order_items", id)
 }
 
 func orderItemKey(id model.OrderItemID) string {
-	return fmt.Sprintf("tab:%s:order:%d:order_item:%d", id.OrderID.TabID, id.OrderID.Scoped, id.Scoped)
+	return fmt.Sprintf("tab:{%s}:order:%d:order_item:%d", id.OrderID.TabID, id.OrderID.Scoped, id.Scoped)
 }
 
 func orderItemGuestOwnersListKey(id model.OrderItemID) string {
-	return fmt.Sprintf("tab:%s:order:%d:order_item:%d:guest_owners", id.OrderID.TabID, id.OrderID.Scoped, id.Scoped)
+	return fmt.Sprintf("tab:{%s}:order:%d:order_item:%d:guest_owners", id.OrderID.TabID, id.OrderID.Scoped, id.Scoped)
 }
 
 func orderItemCustomerOwnersListKey(id model.OrderItemID) string {
-	return fmt.Sprintf("tab:%s:order:%d:order_item:%d:customer_owners", id.OrderID.TabID, id.OrderID.Scoped, id.Scoped)
+	return fmt.Sprintf("tab:{%s}:order:%d:order_item:%d:customer_owners", id.OrderID.TabID, id.OrderID.Scoped, id.Scoped)
+}
+
+// tabEventsKey is not a key of the cached tab: it outlives invalidations of the tab
+func tabEventsKey(id model.TabID) string {
+	return fmt.Sprintf("tab:{%s}:events", id)
+}
+
+// customerSessionVersionKey holds the version of the sessions of a customer, which
+// is incremented to revoke them
+func customerSessionVersionKey(id model.CustomerID) string {
+	return fmt.Sprintf("customer:{%s}:session_version", id)
+}
+
+// loginFailuresKey counts the recent failed logins of a subject, see LoginIDSubject and ClientIPSubject
+func loginFailuresKey(subject string) string {
+	return fmt.Sprintf("login:{%s}:failures", subject)
+}
+
+// loginLockoutKey exists while the logins of a subject are locked out
+func loginLockoutKey(subject string) string {
+	return fmt.Sprintf("login:{%s}:lockout", subject)
+}
+
+// rateLimitKey holds the token bucket of a method for a subject, see ClientIPSubject and TabSubject
+func rateLimitKey(subject, method string) string {
+	return fmt.Sprintf("rate_limit:{%s}:%s", subject, method)
+}
+
+// oidcLoginKey holds an OpenID Connect login until the customer comes back with its state
+func oidcLoginKey(state string) string {
+	return fmt.Sprintf("oidc_login:{%s}", state)
+}
+
+// TxKey returns the key that optimistic transactions on a tab watch first.
+// Redis Cluster routes the transaction to the node serving this key.
+func TxKey(id model.TabID) string {
+	return tabNotSentOrderIDKey(id)
+}
+
+// TabKeys returns every key that caching tab may write.
+func TabKeys(tab *model.Tab) []string {
+	var lastOrder *model.Order
+	if len(tab.Orders) > 0 {
+		lastOrder = tab.Orders[len(tab.Orders)-1]
+	}
+	if lastOrder == nil || lastOrder.SentAt != nil {
+		return tabKeys(tab.ID, nil)[:3]
+	}
+	orderItemIDs := make([]model.OrderItemID, len(lastOrder.Items))
+	for i, item := range lastOrder.Items {
+		orderItemIDs[i] = item.ID
+	}
+	return tabKeys(tab.ID, orderItemIDs)
+}
+
+// tabKeys returns the keys of a tab whose not sent order contains notSentOrderItemIDs
+func tabKeys(id model.TabID, notSentOrderItemIDs []model.OrderItemID) []string {
+	keys := make([]string, 0, 6+3*len(notSentOrderItemIDs))
+	keys = append(keys,
+		tabKey(id),
+		tabGuestNamesKey(id),
+		ordersListKey(id),
+		tabNotSentOrderIDKey(id),
+		orderItemIDSequenceKey(id),
+		orderItemsListKey(id),
+	)
+	for _, itemID := range notSentOrderItemIDs {
+		keys = append(keys, orderItemKeys(itemID)...)
+	}
+	return keys
+}
+
+// orderItemKeys returns the order item, guest owners and customer owners keys of an order item
+func orderItemKeys(id model.OrderItemID) []string {
+	return []string{
+		orderItemKey(id),
+		orderItemGuestOwnersListKey(id),
+		orderItemCustomerOwnersListKey(id),
+	}
 }
 
 func parseInt16(s string) (int16, error) {
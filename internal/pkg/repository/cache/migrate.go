@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// legacyTabKeyPattern matches the keys written before keys were hash-tagged,
+// e.g. "tab:<id>:orders" instead of "tab:{<id>}:orders".
+const legacyTabKeyPattern = "tab:????????-????-????-????-????????????*"
+
+// MigrateLegacyKeys moves every key written before keys were hash-tagged to its
+// hash-tagged name, preserving its value and TTL. Not sent orders only live in
+// Redis, so their keys must be moved instead of being dropped and rebuilt.
+// It returns the number of migrated keys.
+func MigrateLegacyKeys(ctx context.Context, rdb redis.UniversalClient) (int, error) {
+	if cluster, ok := rdb.(*redis.ClusterClient); ok {
+		var migrated atomic.Int64
+		err := cluster.ForEachMaster(ctx, func(ctx context.Context, node *redis.Client) error {
+			n, err := migrateLegacyKeys(ctx, node, rdb)
+			migrated.Add(int64(n))
+			return err
+		})
+		return int(migrated.Load()), err
+	}
+	return migrateLegacyKeys(ctx, rdb, rdb)
+}
+
+// migrateLegacyKeys scans the keys of node and writes them through rdb,
+// which may route the new keys to another node.
+func migrateLegacyKeys(ctx context.Context, node redis.Cmdable, rdb redis.Cmdable) (int, error) {
+	var migrated int
+	iter := node.Scan(ctx, 0, legacyTabKeyPattern, 100).Iterator()
+	for iter.Next(ctx) {
+		legacyKey := iter.Val()
+		key, ok := hashTaggedKey(legacyKey)
+		if !ok {
+			continue
+		}
+
+		value, err := node.Dump(ctx, legacyKey).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue // Expired or deleted since it was scanned
+			}
+			return migrated, err
+		}
+		ttl, err := node.PTTL(ctx, legacyKey).Result()
+		if err != nil {
+			return migrated, err
+		}
+		if ttl < 0 {
+			ttl = 0
+		}
+		if err := rdb.RestoreReplace(ctx, key, ttl, value).Err(); err != nil {
+			return migrated, err
+		}
+		if err := node.Del(ctx, legacyKey).Err(); err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+	return migrated, iter.Err()
+}
+
+// hashTaggedKey converts a legacy key "tab:<id>..." into "tab:{<id>}..."
+func hashTaggedKey(legacyKey string) (string, bool) {
+	const prefix = "tab:"
+	const idLen = 36
+	if !strings.HasPrefix(legacyKey, prefix) || len(legacyKey) < len(prefix)+idLen {
+		return "", false
+	}
+	id, rest := legacyKey[len(prefix):len(prefix)+idLen], legacyKey[len(prefix)+idLen:]
+	if rest != "" && rest[0] != ':' {
+		return "", false
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return "", false
+	}
+	return prefix + "{" + id + "}" + rest, true
+}
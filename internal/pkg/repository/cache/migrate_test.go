@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashTaggedKey(t *testing.T) {
+	const id = "0198c0f1-6a34-7d2e-9f4b-3c1a2b3c4d5e"
+	tests := []struct {
+		legacyKey string
+		want      string
+		ok        bool
+	}{
+		{"tab:" + id, "tab:{" + id + "}", true},
+		{"tab:" + id + ":orders", "tab:{" + id + "}:orders", true},
+		{"tab:" + id + ":order:1:item:2:guest_owners", "tab:{" + id + "}:order:1:item:2:guest_owners", true},
+		{"tab:{" + id + "}:orders", "", false},
+		{"tab:" + id + "x", "", false},
+		{"tab:not-a-uuid-but-thirty-six-chars-long", "", false},
+		{"tab:", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := hashTaggedKey(tt.legacyKey)
+		require.Equal(t, tt.ok, ok, tt.legacyKey)
+		require.Equal(t, tt.want, got, tt.legacyKey)
+	}
+}
@@ -0,0 +1,191 @@
+package cache
+
+import (
+	"context"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// cacheOrderItemLua defines cache_order_item(keys, item) which writes an order item
+// into the not sent order.
+//
+// keys: order items sorted set, order item hash, guest owners set, customer owners set
+// item: {"scoped_id": "1", "fields": ["k", "v", ...], "guest_owners": [...], "customer_owners": [...]}
+const cacheOrderItemLua = `
+local function cache_order_item(keys, item)
+	redis.call('ZADD', keys[1], item.scoped_id, item.scoped_id)
+	redis.call('HSET', keys[2], unpack(item.fields))
+	redis.call('DEL', keys[3])
+	for _, id in ipairs(item.guest_owners) do
+		redis.call('SADD', keys[3], id)
+	end
+	redis.call('DEL', keys[4])
+	for _, id in ipairs(item.customer_owners) do
+		redis.call('SADD', keys[4], id)
+	end
+end
+`
+
+// cacheTabScript writes a whole tab.
+//
+// KEYS: as returned by TabKeys, i.e. tab, guest names, sent orders, not sent order ID,
+// order item ID sequence, order items, then the order item, guest owners and
+// customer owners keys of every not sent order item.
+// ARGV[1]: JSON encoded tabScriptArgs
+var cacheTabScript = redis.NewScript(cacheOrderItemLua + `
+local tab = cjson.decode(ARGV[1])
+
+redis.call('HSET', KEYS[1], unpack(tab.fields))
+if tab.ttl > 0 then
+	redis.call('EXPIRE', KEYS[1], tab.ttl)
+end
+
+if #tab.guest_names > 0 then
+	redis.call('HSET', KEYS[2], unpack(tab.guest_names))
+	if tab.ttl > 0 then
+		redis.call('EXPIRE', KEYS[2], tab.ttl)
+	end
+end
+
+if tab.not_sent_order ~= cjson.null then
+	local order = tab.not_sent_order
+	redis.call('DEL', KEYS[6])
+	for i, item in ipairs(order.items) do
+		local j = 6 + (i - 1) * 3
+		cache_order_item({KEYS[6], KEYS[j + 1], KEYS[j + 2], KEYS[j + 3]}, item)
+	end
+	redis.call('SET', KEYS[5], order.max_item_id)
+	redis.call('SET', KEYS[4], order.id)
+end
+
+if #tab.sent_orders > 0 then
+	redis.call('DEL', KEYS[3])
+	for _, order in ipairs(tab.sent_orders) do
+		redis.call('RPUSH', KEYS[3], order)
+	end
+	if tab.ttl > 0 then
+		redis.call('EXPIRE', KEYS[3], tab.ttl)
+	end
+end
+
+return #KEYS
+`)
+
+// createOrderItemScript writes a single order item.
+//
+// KEYS: order items, order item, guest owners, customer owners
+// ARGV[1]: JSON encoded orderItemScriptArgs
+var createOrderItemScript = redis.NewScript(cacheOrderItemLua + `
+cache_order_item(KEYS, cjson.decode(ARGV[1]))
+return 1
+`)
+
+// deleteOrderItemScript removes a single order item.
+//
+// KEYS: order items, order item, guest owners, customer owners
+// ARGV[1]: scoped order item ID
+var deleteOrderItemScript = redis.NewScript(`
+redis.call('ZREM', KEYS[1], ARGV[1])
+return redis.call('DEL', KEYS[2], KEYS[3], KEYS[4])
+`)
+
+// invalidateTabScript removes every key of a tab.
+//
+// KEYS: every key of the tab
+var invalidateTabScript = redis.NewScript(`
+local deleted = 0
+for _, key in ipairs(KEYS) do
+	deleted = deleted + redis.call('DEL', key)
+end
+return deleted
+`)
+
+// recordLoginFailureScript counts a failed login and locks the subject out once
+// it reaches the maximum failures, returning the lockout in milliseconds or 0.
+// The counter outlives the lockout so that further failures double it.
+//
+// KEYS: login failures, login lockout
+// ARGV: maximum failures, failure window, lockout, maximum lockout, all durations in milliseconds
+var recordLoginFailureScript = redis.NewScript(`
+local failures = redis.call('INCR', KEYS[1])
+local max_failures = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+if failures < max_failures then
+	if failures == 1 then
+		redis.call('PEXPIRE', KEYS[1], window)
+	end
+	return 0
+end
+local lockout = tonumber(ARGV[4])
+local doublings = failures - max_failures
+if doublings < 32 then
+	lockout = math.min(tonumber(ARGV[3]) * 2 ^ doublings, lockout)
+end
+redis.call('SET', KEYS[2], 1, 'PX', lockout)
+redis.call('PEXPIRE', KEYS[1], lockout + window)
+return lockout
+`)
+
+// takeRateLimitTokenScript takes a token from a bucket refilled continuously,
+// returning 0 or how many milliseconds to wait for a token when the bucket is empty.
+// Buckets are refilled according to the clock of Redis, shared by every replica.
+//
+// KEYS: rate limit bucket
+// ARGV: tokens per second, maximum tokens
+var takeRateLimitTokenScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'updated_at')
+local tokens = burst
+if bucket[1] then
+	tokens = math.min(burst, tonumber(bucket[1]) + (now - tonumber(bucket[2])) * rate / 1000)
+end
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated_at', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate))
+return wait
+`)
+
+var scripts = []*redis.Script{
+	cacheTabScript,
+	createOrderItemScript,
+	deleteOrderItemScript,
+	invalidateTabScript,
+	recordLoginFailureScript,
+	takeRateLimitTokenScript,
+}
+
+// LoadScripts loads every script into the script cache of rdb.
+// Scripts queued in pipelines and transactions are run with EVALSHA only,
+// so they have to be loaded before such pipelines are executed.
+func LoadScripts(ctx context.Context, rdb redis.Scripter) error {
+	for _, script := range scripts {
+		if err := script.Load(ctx, rdb).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsNoScript reports whether err is caused by a script missing from the script cache
+func IsNoScript(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "NOSCRIPT")
+}
+
+// runScript runs script with EVALSHA, falling back to EVAL when the script is not loaded.
+// Inside pipelines the fallback is unavailable because the reply is not known yet.
+func (q *RedisQueries) runScript(ctx context.Context, script *redis.Script, keys []string, args ...any) *redis.Cmd {
+	if _, ok := q.rdb.(redis.Pipeliner); ok {
+		return script.EvalSha(ctx, q.rdb, keys, args...)
+	}
+	return script.Run(ctx, q.rdb, keys, args...)
+}
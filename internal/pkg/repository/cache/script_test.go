@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+func newTestRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	rC, err := tcredis.Run(t.Context(), "redis:8")
+	testcontainers.CleanupContainer(t, rC)
+	require.NoError(t, err, "failed to start redis container")
+
+	redisURL, err := rC.ConnectionString(t.Context())
+	require.NoError(t, err)
+	opts, err := redis.ParseURL(redisURL)
+	require.NoError(t, err)
+	rdb := redis.NewClient(opts)
+	t.Cleanup(func() { rdb.Close() })
+	return rdb
+}
+
+func TestScripts(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := t.Context()
+	q := New(rdb)
+
+	tabID := model.TabID(uuid.New())
+	customerID := model.CustomerID(uuid.New())
+	guestID := model.GuestID{TabID: tabID, Scoped: 1}
+	now := time.Now()
+	sentOrderID := model.OrderID{TabID: tabID, Scoped: 1}
+	orderID := model.OrderID{TabID: tabID, Scoped: 2}
+	tab := &model.Tab{
+		ID:               tabID,
+		TotalPrice:       100,
+		CreatedAt:        now,
+		CustomGuestNames: map[model.GuestID]string{guestID: "Alice"},
+		Orders: []*model.Order{
+			{
+				ID:     sentOrderID,
+				SentAt: &now,
+				Items: []*model.OrderItem{
+					{ID: model.OrderItemID{OrderID: sentOrderID, Scoped: 1}, Quantity: 1, MenuItemID: 1},
+				},
+			},
+			{
+				ID: orderID,
+				Items: []*model.OrderItem{
+					{
+						ID:               model.OrderItemID{OrderID: orderID, Scoped: 2},
+						Quantity:         3,
+						Modifiers:        []byte(`{"spicy":true}`),
+						GuestOwnerIDs:    []model.GuestID{guestID},
+						CustomerOwnerIDs: []model.CustomerID{customerID},
+						MenuItemID:       1,
+					},
+					{ID: model.OrderItemID{OrderID: orderID, Scoped: 5}, Quantity: 1, MenuItemID: 1},
+				},
+			},
+		},
+	}
+
+	// Cache tab
+	require.NoError(t, q.CacheTab(ctx, tab))
+	cached, err := q.GetOpenTabWithOrders(ctx, tabID)
+	require.NoError(t, err)
+	require.Len(t, cached.Orders, 2)
+	require.Len(t, cached.Orders[1].Items, 2)
+	require.Equal(t, int16(3), cached.Orders[1].Items[0].Quantity)
+	require.Equal(t, []model.GuestID{guestID}, cached.Orders[1].Items[0].GuestOwnerIDs)
+	require.Equal(t, []model.CustomerID{customerID}, cached.Orders[1].Items[0].CustomerOwnerIDs)
+	require.Equal(t, "Alice", cached.CustomGuestNames[guestID])
+
+	nextID, err := q.GetNextOrderItemID(ctx, orderID)
+	require.NoError(t, err)
+	require.Equal(t, model.ScopedOrderItemID(6), nextID)
+
+	// Create and delete order items inside transactions
+	require.NoError(t, LoadScripts(ctx, rdb))
+	_, err = rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		return New(p).CreateOrderItem(ctx, &model.OrderItem{
+			ID:         model.OrderItemID{OrderID: orderID, Scoped: nextID},
+			Quantity:   2,
+			MenuItemID: 1,
+		})
+	})
+	require.NoError(t, err)
+	_, err = rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		New(p).DeleteOrderItem(ctx, model.OrderItemID{OrderID: orderID, Scoped: 2})
+		return nil
+	})
+	require.NoError(t, err)
+	cached, err = q.GetOpenTabWithOrders(ctx, tabID)
+	require.NoError(t, err)
+	require.Len(t, cached.Orders[1].Items, 2)
+
+	// Invalidate tab
+	err = rdb.Watch(ctx, func(tx *redis.Tx) error {
+		_, orderItemIDs, err := WatchAndGetNotSentOrderIDAndItemIDs(ctx, tx, tabID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			New(p).InvalidateTab(ctx, tabID, orderItemIDs)
+			return nil
+		})
+		return err
+	}, TxKey(tabID))
+	require.NoError(t, err)
+	_, err = q.GetOpenTabWithOrders(ctx, tabID)
+	require.ErrorIs(t, err, redis.Nil)
+
+	// Scripts missing from the script cache are reported inside transactions
+	require.NoError(t, rdb.ScriptFlush(ctx).Err())
+	_, err = rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		New(p).DeleteOrderItem(ctx, model.OrderItemID{OrderID: orderID, Scoped: 5})
+		return nil
+	})
+	require.True(t, IsNoScript(err))
+	require.NoError(t, q.CacheTab(ctx, tab))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTab(t *testing.T) {
@@ -30,3 +31,31 @@ func TestTab(t *testing.T) {
 		},
 	})
 }
+
+func TestGetTabsWithOrders(t *testing.T) {
+	q := New(newTestRedis(t))
+	// Tabs with several items, and one without any in between
+	tabs := []*model.Tab{newTestTab(t, q, 3), newTestTab(t, q, 0), newTestTab(t, q, 2)}
+	ids := make([]model.TabID, len(tabs))
+	for i, tab := range tabs {
+		ids[i] = tab.ID
+	}
+
+	cached, err := q.getTabsWithOrders(t.Context(), ids)
+	require.NoError(t, err)
+	require.Len(t, cached, len(tabs))
+	for i, tab := range tabs {
+		require.Equal(t, tab.ID, cached[i].ID)
+		want := tab.Orders[0].Items
+		got := cached[i].Orders[len(cached[i].Orders)-1].Items
+		require.Len(t, got, len(want), "tab %d", i)
+		for k, item := range got {
+			require.Equal(t, want[k].ID, item.ID, "tab %d", i)
+			require.Equal(t, want[k].Quantity, item.Quantity, "tab %d", i)
+		}
+	}
+
+	tab, err := q.GetOpenTabWithOrders(t.Context(), tabs[0].ID)
+	require.NoError(t, err)
+	require.Len(t, tab.Orders[0].Items, 3)
+}
@@ -10,14 +10,53 @@ import (
 )
 
 type Customer struct {
-	ID           uuid.UUID        `json:"id"`
-	LoginID      string           `json:"login_id"`
-	Email        string           `json:"email"`
-	PasswordHash string           `json:"password_hash"`
-	Name         string           `json:"name"`
-	PhoneNumber  pgtype.Text      `json:"phone_number"`
-	CreatedAt    pgtype.Timestamp `json:"created_at"`
-	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	ID              uuid.UUID        `json:"id"`
+	LoginID         pgtype.Text      `json:"login_id"`
+	Email           pgtype.Text      `json:"email"`
+	PasswordHash    string           `json:"password_hash"`
+	Name            string           `json:"name"`
+	PhoneNumber     pgtype.Text      `json:"phone_number"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+	DeletedAt       pgtype.Timestamp `json:"deleted_at"`
+	EmailVerifiedAt pgtype.Timestamp `json:"email_verified_at"`
+}
+
+type CustomerIdentity struct {
+	Issuer      string           `json:"issuer"`
+	Subject     string           `json:"subject"`
+	CustomerID  uuid.UUID        `json:"customer_id"`
+	Email       pgtype.Text      `json:"email"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	LastLoginAt pgtype.Timestamp `json:"last_login_at"`
+}
+
+type CustomerToken struct {
+	TokenHash  []byte           `json:"token_hash"`
+	CustomerID uuid.UUID        `json:"customer_id"`
+	Purpose    string           `json:"purpose"`
+	Email      string           `json:"email"`
+	ExpiresAt  pgtype.Timestamp `json:"expires_at"`
+	UsedAt     pgtype.Timestamp `json:"used_at"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type Favorite struct {
+	ID         int64            `json:"id"`
+	CustomerID uuid.UUID        `json:"customer_id"`
+	MenuItemID int16            `json:"menu_item_id"`
+	Name       string           `json:"name"`
+	Modifiers  []byte           `json:"modifiers"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+}
+
+type GuestClaimToken struct {
+	TabID     uuid.UUID        `json:"tab_id"`
+	GuestID   int16            `json:"guest_id"`
+	TokenHash []byte           `json:"token_hash"`
+	ClaimedBy pgtype.UUID      `json:"claimed_by"`
+	ClaimedAt pgtype.Timestamp `json:"claimed_at"`
 }
 
 type GuestIDSequence struct {
@@ -25,18 +64,41 @@ type GuestIDSequence struct {
 	Value int32     `json:"value"`
 }
 
+type Menu struct {
+	ID          int16            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	Timezone    string           `json:"timezone"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
 type MenuItem struct {
-	ID              int16            `json:"id"`
-	Name            string           `json:"name"`
-	Description     pgtype.Text      `json:"description"`
-	PhotoPathinfo   pgtype.Text      `json:"photo_pathinfo"`
-	Price           int32            `json:"price"`
-	PortionSize     int16            `json:"portion_size"`
-	Available       bool             `json:"available"`
-	ModifiersConfig []byte           `json:"modifiers_config"`
-	CreatedAt       pgtype.Timestamp `json:"created_at"`
-	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
-	DeletedAt       pgtype.Timestamp `json:"deleted_at"`
+	ID                        int16            `json:"id"`
+	Name                      string           `json:"name"`
+	Description               pgtype.Text      `json:"description"`
+	PhotoPathinfo             pgtype.Text      `json:"photo_pathinfo"`
+	Price                     int32            `json:"price"`
+	PortionSize               int16            `json:"portion_size"`
+	Available                 bool             `json:"available"`
+	ModifiersConfig           []byte           `json:"modifiers_config"`
+	CreatedAt                 pgtype.Timestamp `json:"created_at"`
+	UpdatedAt                 pgtype.Timestamp `json:"updated_at"`
+	DeletedAt                 pgtype.Timestamp `json:"deleted_at"`
+	ExternalKey               string           `json:"external_key"`
+	AvailabilityOverride      pgtype.Bool      `json:"availability_override"`
+	AvailabilityOverrideUntil pgtype.Timestamp `json:"availability_override_until"`
+}
+
+type MenuItemPhoto struct {
+	MenuItemID  int16            `json:"menu_item_id"`
+	ContentType string           `json:"content_type"`
+	Width       int32            `json:"width"`
+	Height      int32            `json:"height"`
+	Blurhash    string           `json:"blurhash"`
+	OriginalKey string           `json:"original_key"`
+	Variants    []byte           `json:"variants"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
 }
 
 type MenuItemTag struct {
@@ -44,6 +106,21 @@ type MenuItemTag struct {
 	MenuTagID  int16 `json:"menu_tag_id"`
 }
 
+type MenuMenuItem struct {
+	MenuID     int16 `json:"menu_id"`
+	MenuItemID int16 `json:"menu_item_id"`
+}
+
+type MenuSchedule struct {
+	ID        int32       `json:"id"`
+	MenuID    int16       `json:"menu_id"`
+	Weekdays  []int16     `json:"weekdays"`
+	StartTime pgtype.Time `json:"start_time"`
+	EndTime   pgtype.Time `json:"end_time"`
+	StartDate pgtype.Date `json:"start_date"`
+	EndDate   pgtype.Date `json:"end_date"`
+}
+
 type MenuTag struct {
 	ID          int16            `json:"id"`
 	Value       string           `json:"value"`
@@ -51,6 +128,7 @@ type MenuTag struct {
 	Dimension   pgtype.Int2      `json:"dimension"`
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	ExternalKey string           `json:"external_key"`
 }
 
 type MenuTagDimension struct {
@@ -59,6 +137,7 @@ type MenuTagDimension struct {
 	Description pgtype.Text      `json:"description"`
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	ExternalKey string           `json:"external_key"`
 }
 
 type MenuTagPrerequisite struct {
@@ -45,6 +45,34 @@ FROM "tab_with_orders" t
 JOIN "visitation" v ON t."id" = v."tab_id"
 WHERE v."customer_id" = $1;
 
+-- name: GetVisitedTabs :many
+SELECT t.*
+FROM "tab" t
+JOIN "visitation" v ON t."id" = v."tab_id"
+WHERE v."customer_id" = $1
+ORDER BY t."created_at", t."id";
+
+-- name: ListVisitedTabs :many
+SELECT t.*
+FROM "tab" t
+JOIN "visitation" v ON t."id" = v."tab_id"
+WHERE v."customer_id" = $1
+    AND (sqlc.narg('since')::TIMESTAMP IS NULL OR t."created_at" >= sqlc.narg('since')::TIMESTAMP)
+    AND (sqlc.narg('until')::TIMESTAMP IS NULL OR t."created_at" < sqlc.narg('until')::TIMESTAMP)
+    AND (sqlc.narg('closed')::BOOLEAN IS NULL OR (t."closed_at" IS NOT NULL) = sqlc.narg('closed')::BOOLEAN)
+    AND (sqlc.narg('after_created_at')::TIMESTAMP IS NULL
+        OR (t."created_at", t."id") < (sqlc.narg('after_created_at')::TIMESTAMP, sqlc.narg('after_id')::UUID))
+ORDER BY t."created_at" DESC, t."id" DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: GetTabsWithOrders :many
+SELECT *
+FROM "tab_with_orders"
+WHERE "id" = ANY(sqlc.arg('ids')::UUID[]);
+
+-- name: CountVisitedTabs :one
+SELECT COUNT(*) FROM "visitation" WHERE "customer_id" = $1;
+
 -- name: UpdateTabTotalPrice :exec
 UPDATE "tab" SET "total_price" = COALESCE((
     SELECT SUM(mi."price" * oi."quantity")
@@ -77,7 +105,7 @@ DELETE FROM "guest_id_sequence" WHERE "tab_id" = $1;
 
 -- name: CreateGuest :one
 UPDATE "guest_id_sequence" SET "value" = "value" + 1
-WHERE "tab_id" = $1
+WHERE "tab_id" = $1 AND "value" < sqlc.arg('max_guests')::SMALLINT
 RETURNING "value";
 
 -- name: UpdateGuestName :exec
@@ -88,6 +116,21 @@ UPDATE "tab" SET "guest_names" = jsonb_set(
 )
 WHERE "id" = $1;
 
+-- name: RemoveGuestName :exec
+UPDATE "tab" SET "guest_names" = "guest_names" - sqlc.arg('scoped_id')::SMALLINT::TEXT
+WHERE "id" = $1;
+
+-- name: CreateGuestClaimToken :exec
+INSERT INTO "guest_claim_token" ("tab_id", "guest_id", "token_hash") VALUES ($1, $2, $3);
+
+-- name: GetGuestClaimTokenForUpdate :one
+SELECT * FROM "guest_claim_token" WHERE "tab_id" = $1 AND "guest_id" = $2
+FOR UPDATE;
+
+-- name: MarkGuestClaimed :exec
+UPDATE "guest_claim_token" SET "claimed_by" = $3, "claimed_at" = NOW()
+WHERE "tab_id" = $1 AND "guest_id" = $2;
+
 -- name: CreateOrderIDSequence :exec
 INSERT INTO "order_id_sequence" ("tab_id") VALUES ($1);
 
@@ -151,6 +194,26 @@ WHERE "tab_id" = $1 AND "order_id" = $2 AND "scoped_id" = $3 AND sqlc.arg('custo
 UPDATE "order_item" SET "customer_owners" = array_remove("customer_owners", sqlc.arg('customer_id')::UUID)
 WHERE "tab_id" = $1 AND "order_id" = $2 AND "scoped_id" = $3 AND sqlc.arg('customer_id')::UUID = ANY("customer_owners");
 
+-- name: TransferOrderItemsGuestOwner :exec
+UPDATE "order_item" SET
+    "guest_owners" = array_remove("guest_owners", sqlc.arg('guest_id')::SMALLINT),
+    "customer_owners" = CASE
+        WHEN sqlc.arg('customer_id')::UUID = ANY("customer_owners") THEN "customer_owners"
+        ELSE array_append("customer_owners", sqlc.arg('customer_id')::UUID)
+    END
+WHERE "tab_id" = $1 AND sqlc.arg('guest_id')::SMALLINT = ANY("guest_owners");
+
+-- name: GetSentOrderItemsForReorder :many
+SELECT "oi"."menu_item_id", "oi"."quantity", "oi"."modifiers", "oi"."customer_owners",
+    "mi"."name", "mi"."description", "mi"."photo_pathinfo", "mi"."price", "mi"."portion_size", "mi"."modifiers_config",
+    "mi"."available", "mi"."availability_override", "mi"."availability_override_until", "mi"."deleted_at"
+FROM "order_item" AS "oi"
+JOIN "order" AS "o" ON "oi"."tab_id" = "o"."tab_id" AND "oi"."order_id" = "o"."scoped_id"
+JOIN "menu_item" AS "mi" ON "oi"."menu_item_id" = "mi"."id"
+WHERE "oi"."tab_id" = $1 AND "o"."sent_at" IS NOT NULL
+    AND (sqlc.narg('order_id')::SMALLINT IS NULL OR "oi"."order_id" = sqlc.narg('order_id')::SMALLINT)
+ORDER BY "oi"."order_id", "oi"."scoped_id";
+
 -- name: DeleteOrderItem :exec
 DELETE FROM "order_item"
 WHERE "tab_id" = $1 AND "order_id" = $2 AND "scoped_id" = $3;
@@ -161,27 +224,152 @@ VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetCustomerByID :one
-SELECT * FROM "customer" WHERE "id" = $1;
+SELECT * FROM "customer" WHERE "id" = $1 AND "deleted_at" IS NULL;
+
+-- name: GetCustomerByIDForUpdate :one
+SELECT * FROM "customer" WHERE "id" = $1 AND "deleted_at" IS NULL FOR UPDATE;
 
 -- name: GetCustomerByLogin :one
 SELECT * FROM "customer" WHERE "login_id" = $1;
 
 -- name: UpdateCustomerLoginID :one
-UPDATE "customer" SET "login_id" = $2 WHERE "id" = $1
+UPDATE "customer" SET "login_id" = $2, "updated_at" = NOW() WHERE "id" = $1 AND "deleted_at" IS NULL
 RETURNING *;
 
 -- name: UpdateCustomerEmail :one
-UPDATE "customer" SET "email" = $2 WHERE "id" = $1
-RETURNING *; 
+UPDATE "customer" SET "email" = $2, "email_verified_at" = NULL, "updated_at" = NOW() WHERE "id" = $1 AND "deleted_at" IS NULL
+RETURNING *;
 
 -- name: UpdateCustomerPassword :one
-UPDATE "customer" SET "password_hash" = $2 WHERE "id" = $1
+UPDATE "customer" SET "password_hash" = $2, "updated_at" = NOW() WHERE "id" = $1 AND "deleted_at" IS NULL
 RETURNING *;
 
 -- name: UpdateCustomerInfo :one
-UPDATE "customer" SET "name" = $2, "phone_number" = $3 WHERE "id" = $1
+UPDATE "customer" SET "name" = $2, "phone_number" = $3, "updated_at" = NOW() WHERE "id" = $1 AND "deleted_at" IS NULL
 RETURNING *;
 
+-- name: SetCustomerNameIfEmpty :exec
+UPDATE "customer" SET "name" = $2, "updated_at" = NOW() WHERE "id" = $1 AND "name" = '' AND "deleted_at" IS NULL;
+
+-- name: GetCustomerByEmail :one
+SELECT * FROM "customer" WHERE "email" = $1 AND "deleted_at" IS NULL;
+
+-- name: VerifyCustomerEmail :one
+UPDATE "customer" SET "email_verified_at" = COALESCE("email_verified_at", NOW()), "updated_at" = NOW()
+WHERE "id" = $1 AND "email" = $2 AND "deleted_at" IS NULL
+RETURNING *;
+
+-- name: CreateCustomerToken :exec
+INSERT INTO "customer_token" ("token_hash", "customer_id", "purpose", "email", "expires_at")
+VALUES ($1, $2, $3, $4, NOW() + sqlc.arg('ttl_seconds')::INT * INTERVAL '1 second');
+
+-- name: DeleteUnusedCustomerTokens :exec
+DELETE FROM "customer_token" WHERE "customer_id" = $1 AND "purpose" = $2 AND "used_at" IS NULL;
+
+-- name: DeleteCustomerTokens :exec
+DELETE FROM "customer_token" WHERE "customer_id" = $1;
+
+-- name: UseCustomerToken :one
+UPDATE "customer_token" SET "used_at" = NOW()
+WHERE "token_hash" = $1 AND "purpose" = $2 AND "used_at" IS NULL AND "expires_at" > NOW()
+RETURNING "customer_id", "email";
+
+-- name: GetCustomerByIdentity :one
+SELECT "c".* FROM "customer" AS "c"
+JOIN "customer_identity" AS "ci" ON "ci"."customer_id" = "c"."id"
+WHERE "ci"."issuer" = $1 AND "ci"."subject" = $2 AND "c"."deleted_at" IS NULL;
+
+-- name: CreateCustomerIdentity :exec
+INSERT INTO "customer_identity" ("issuer", "subject", "customer_id", "email")
+VALUES ($1, $2, $3, $4);
+
+-- name: TouchCustomerIdentity :exec
+UPDATE "customer_identity" SET "last_login_at" = NOW() WHERE "issuer" = $1 AND "subject" = $2;
+
+-- name: HasRecentIdentityLogin :one
+SELECT EXISTS (
+    SELECT 1 FROM "customer_identity"
+    WHERE "customer_id" = $1 AND "last_login_at" > NOW() - sqlc.arg('window_seconds')::INT * INTERVAL '1 second'
+);
+
+-- name: GetCustomerIdentities :many
+SELECT * FROM "customer_identity" WHERE "customer_id" = $1
+ORDER BY "created_at";
+
+-- name: DeleteCustomerIdentities :exec
+DELETE FROM "customer_identity" WHERE "customer_id" = $1;
+
+-- name: AnonymizeCustomer :exec
+UPDATE "customer" SET "login_id" = NULL, "email" = NULL, "password_hash" = '', "name" = '', "phone_number" = NULL,
+    "deleted_at" = NOW(), "updated_at" = NOW()
+WHERE "id" = $1 AND "deleted_at" IS NULL;
+
+-- name: RemoveCustomerFromOrderItems :exec
+UPDATE "order_item" SET "customer_owners" = array_remove("customer_owners", sqlc.arg('customer_id')::UUID)
+WHERE sqlc.arg('customer_id')::UUID = ANY("customer_owners");
+
+-- name: DeleteCustomerVisitations :many
+DELETE FROM "visitation" WHERE "customer_id" = $1
+RETURNING "tab_id";
+
+-- name: GetOpenTabIDsForShare :many
+SELECT "id" FROM "tab" WHERE "id" = ANY(sqlc.arg('ids')::UUID[]) AND "closed_at" IS NULL
+FOR SHARE;
+
+-- name: GetCustomerSentOrderItems :many
+SELECT "oi".*
+FROM "order_item_with_menu" AS "oi"
+JOIN "order" AS "o" ON "oi"."tab_id" = "o"."tab_id" AND "oi"."order_id" = "o"."scoped_id"
+WHERE sqlc.arg('customer_id')::UUID = ANY("oi"."customer_owners") AND "o"."sent_at" IS NOT NULL
+ORDER BY "o"."sent_at", "oi"."tab_id", "oi"."order_id", "oi"."scoped_id";
+
+-- name: GetCustomerSpend :one
+-- Items shared by several guests and customers are split evenly between them
+SELECT COALESCE(ROUND(SUM(
+    "mi"."price" * "oi"."quantity"::NUMERIC
+    / GREATEST(COALESCE(cardinality("oi"."guest_owners"), 0) + cardinality("oi"."customer_owners"), 1)
+)), 0)::BIGINT AS "total_spend"
+FROM "order_item" AS "oi"
+JOIN "order" AS "o" ON "oi"."tab_id" = "o"."tab_id" AND "oi"."order_id" = "o"."scoped_id"
+JOIN "menu_item" AS "mi" ON "oi"."menu_item_id" = "mi"."id"
+WHERE sqlc.arg('customer_id')::UUID = ANY("oi"."customer_owners") AND "o"."sent_at" IS NOT NULL;
+
+-- name: GetCustomerFavoriteItems :many
+SELECT "mi"."id", "mi"."name", SUM("oi"."quantity")::BIGINT AS "quantity", COUNT(DISTINCT "oi"."tab_id")::BIGINT AS "tab_count"
+FROM "order_item" AS "oi"
+JOIN "order" AS "o" ON "oi"."tab_id" = "o"."tab_id" AND "oi"."order_id" = "o"."scoped_id"
+JOIN "menu_item" AS "mi" ON "oi"."menu_item_id" = "mi"."id"
+WHERE sqlc.arg('customer_id')::UUID = ANY("oi"."customer_owners") AND "o"."sent_at" IS NOT NULL
+GROUP BY "mi"."id"
+ORDER BY "quantity" DESC, "tab_count" DESC, "mi"."id"
+LIMIT sqlc.arg('max_items');
+
+-- name: SaveFavorite :one
+INSERT INTO "favorite" ("customer_id", "menu_item_id", "name", "modifiers")
+VALUES ($1, $2, $3, $4)
+ON CONFLICT ("customer_id", "name") DO UPDATE
+SET "menu_item_id" = EXCLUDED."menu_item_id", "modifiers" = EXCLUDED."modifiers", "updated_at" = NOW()
+RETURNING *;
+
+-- name: GetCustomerFavorites :many
+SELECT "f".*, "mi"."name" AS "menu_item_name", "mi"."modifiers_config", "mi"."deleted_at" AS "menu_item_deleted_at"
+FROM "favorite" AS "f"
+JOIN "menu_item" AS "mi" ON "f"."menu_item_id" = "mi"."id"
+WHERE "f"."customer_id" = $1
+ORDER BY "f"."name";
+
+-- name: GetCustomerFavorite :one
+SELECT "f".*, "mi"."name" AS "menu_item_name", "mi"."modifiers_config", "mi"."deleted_at" AS "menu_item_deleted_at"
+FROM "favorite" AS "f"
+JOIN "menu_item" AS "mi" ON "f"."menu_item_id" = "mi"."id"
+WHERE "f"."id" = $1 AND "f"."customer_id" = $2;
+
+-- name: DeleteFavorite :execrows
+DELETE FROM "favorite" WHERE "id" = $1 AND "customer_id" = $2;
+
+-- name: DeleteCustomerFavorites :exec
+DELETE FROM "favorite" WHERE "customer_id" = $1;
+
 -- name: CreateMenuItem :one
 INSERT INTO "menu_item" ("name", "description", "photo_pathinfo", "price", "portion_size", "available", "modifiers_config")
 VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -204,6 +392,27 @@ RETURNING *;
 -- name: SoftDeleteMenuItem :exec
 UPDATE "menu_item" SET "deleted_at" = COALESCE("deleted_at", NOW()) WHERE "id" = $1;
 
+-- name: LockNotDeletedMenuItem :one
+SELECT "id" FROM "menu_item" WHERE "id" = $1 AND "deleted_at" IS NULL FOR UPDATE;
+
+-- name: UpdateMenuItemPhotoPathinfo :exec
+UPDATE "menu_item" SET "photo_pathinfo" = $2, "updated_at" = NOW() WHERE "id" = $1;
+
+-- name: GetMenuItemPhoto :one
+SELECT * FROM "menu_item_photo" WHERE "menu_item_id" = $1;
+
+-- name: GetMenuItemPhotos :many
+SELECT * FROM "menu_item_photo" WHERE "menu_item_id" = ANY(sqlc.arg('menu_item_ids')::SMALLINT[]);
+
+-- name: SaveMenuItemPhoto :one
+INSERT INTO "menu_item_photo" ("menu_item_id", "content_type", "width", "height", "blurhash", "original_key", "variants")
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT ("menu_item_id") DO UPDATE
+SET "content_type" = EXCLUDED."content_type", "width" = EXCLUDED."width", "height" = EXCLUDED."height",
+    "blurhash" = EXCLUDED."blurhash", "original_key" = EXCLUDED."original_key", "variants" = EXCLUDED."variants",
+    "created_at" = NOW()
+RETURNING *;
+
 -- name: CreateMenuTag :one
 INSERT INTO "menu_tag" ("value", "description", "dimension")
 VALUES ($1, $2, $3)
@@ -219,3 +428,103 @@ RETURNING *;
 
 -- name: ListMenuTagDimensions :many
 SELECT * FROM "menu_tag_dimension" ORDER BY "value";
+
+-- name: ListAllMenuItems :many
+SELECT * FROM "menu_item" ORDER BY "name", "id";
+
+-- name: ListMenuTagPrerequisites :many
+SELECT * FROM "menu_tag_prerequisite";
+
+-- name: ListMenuItemTags :many
+SELECT * FROM "menu_item_tag";
+
+-- name: UpsertMenuTagDimension :one
+INSERT INTO "menu_tag_dimension" ("external_key", "value", "description")
+VALUES ($1, $2, $3)
+ON CONFLICT ("external_key") DO UPDATE
+SET "value" = EXCLUDED."value", "description" = EXCLUDED."description", "updated_at" = NOW()
+RETURNING *;
+
+-- name: UpsertMenuTag :one
+INSERT INTO "menu_tag" ("external_key", "value", "description", "dimension")
+VALUES ($1, $2, $3, $4)
+ON CONFLICT ("external_key") DO UPDATE
+SET "value" = EXCLUDED."value", "description" = EXCLUDED."description", "dimension" = EXCLUDED."dimension", "updated_at" = NOW()
+RETURNING *;
+
+-- name: DeleteMenuTagPrerequisites :exec
+DELETE FROM "menu_tag_prerequisite" WHERE "menu_tag_id" = $1;
+
+-- name: AddMenuTagPrerequisite :exec
+INSERT INTO "menu_tag_prerequisite" ("menu_tag_id", "prerequisite_tag_id") VALUES ($1, $2);
+
+-- name: UpsertMenuItem :one
+INSERT INTO "menu_item" ("external_key", "name", "description", "photo_pathinfo", "price", "portion_size", "available", "modifiers_config")
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT ("external_key") DO UPDATE
+SET "name" = EXCLUDED."name", "description" = EXCLUDED."description", "photo_pathinfo" = EXCLUDED."photo_pathinfo",
+    "price" = EXCLUDED."price", "portion_size" = EXCLUDED."portion_size", "available" = EXCLUDED."available",
+    "modifiers_config" = EXCLUDED."modifiers_config", "deleted_at" = NULL, "updated_at" = NOW()
+RETURNING *;
+
+-- name: DeleteMenuItemTags :exec
+DELETE FROM "menu_item_tag" WHERE "menu_item_id" = $1;
+
+-- name: AddMenuItemTag :exec
+INSERT INTO "menu_item_tag" ("menu_item_id", "menu_tag_id") VALUES ($1, $2);
+
+-- name: CreateMenu :one
+INSERT INTO "menu" ("name", "description", "timezone") VALUES ($1, $2, $3) RETURNING *;
+
+-- name: GetMenu :one
+SELECT * FROM "menu" WHERE "id" = $1;
+
+-- name: ListMenus :many
+SELECT * FROM "menu" ORDER BY "name";
+
+-- name: UpdateMenu :one
+UPDATE "menu" SET "name" = $2, "description" = $3, "timezone" = $4, "updated_at" = NOW()
+WHERE "id" = $1
+RETURNING *;
+
+-- name: DeleteMenu :execrows
+DELETE FROM "menu" WHERE "id" = $1;
+
+-- name: ListMenuSchedules :many
+SELECT * FROM "menu_schedule" WHERE "menu_id" = ANY(sqlc.arg('menu_ids')::SMALLINT[]) ORDER BY "menu_id", "id";
+
+-- name: DeleteMenuSchedules :exec
+DELETE FROM "menu_schedule" WHERE "menu_id" = $1;
+
+-- name: AddMenuSchedule :exec
+INSERT INTO "menu_schedule" ("menu_id", "weekdays", "start_time", "end_time", "start_date", "end_date")
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListMenuMenuItems :many
+SELECT * FROM "menu_menu_item" WHERE "menu_id" = ANY(sqlc.arg('menu_ids')::SMALLINT[]) ORDER BY "menu_id", "menu_item_id";
+
+-- name: ListMenuSchedulesOfMenuItems :many
+-- A row per schedule of every menu of the menu items, with NULL schedule columns for
+-- menus without schedules
+SELECT "mmi"."menu_item_id", "m"."id" AS "menu_id", "m"."timezone", "ms"."id" AS "schedule_id",
+    "ms"."weekdays", "ms"."start_time", "ms"."end_time", "ms"."start_date", "ms"."end_date"
+FROM "menu_menu_item" AS "mmi"
+JOIN "menu" AS "m" ON "mmi"."menu_id" = "m"."id"
+LEFT JOIN "menu_schedule" AS "ms" ON "ms"."menu_id" = "m"."id"
+WHERE "mmi"."menu_item_id" = ANY(sqlc.arg('menu_item_ids')::SMALLINT[])
+ORDER BY "mmi"."menu_item_id", "m"."id", "ms"."id";
+
+-- name: DeleteMenuMenuItems :exec
+DELETE FROM "menu_menu_item" WHERE "menu_id" = $1;
+
+-- name: AddMenuMenuItems :exec
+INSERT INTO "menu_menu_item" ("menu_id", "menu_item_id")
+SELECT sqlc.arg('menu_id')::SMALLINT, UNNEST(sqlc.arg('menu_item_ids')::SMALLINT[]);
+
+-- name: SetMenuItemAvailabilityOverride :one
+UPDATE "menu_item" SET "availability_override" = $2, "availability_override_until" = $3, "updated_at" = NOW()
+WHERE "id" = $1 AND "deleted_at" IS NULL
+RETURNING *;
+
+-- name: CountNotDeletedMenuItems :one
+SELECT COUNT(*) FROM "menu_item" WHERE "id" = ANY(sqlc.arg('ids')::SMALLINT[]) AND "deleted_at" IS NULL;
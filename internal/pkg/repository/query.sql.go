@@ -12,6 +12,75 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addMenuItemTag = `-- name: AddMenuItemTag :exec
+INSERT INTO "menu_item_tag" ("menu_item_id", "menu_tag_id") VALUES ($1, $2)
+`
+
+type AddMenuItemTagParams struct {
+	MenuItemID int16 `json:"menu_item_id"`
+	MenuTagID  int16 `json:"menu_tag_id"`
+}
+
+func (q *Queries) AddMenuItemTag(ctx context.Context, arg AddMenuItemTagParams) error {
+	_, err := q.db.Exec(ctx, addMenuItemTag, arg.MenuItemID, arg.MenuTagID)
+	return err
+}
+
+const addMenuMenuItems = `-- name: AddMenuMenuItems :exec
+INSERT INTO "menu_menu_item" ("menu_id", "menu_item_id")
+SELECT $1::SMALLINT, UNNEST($2::SMALLINT[])
+`
+
+type AddMenuMenuItemsParams struct {
+	MenuID      int16   `json:"menu_id"`
+	MenuItemIds []int16 `json:"menu_item_ids"`
+}
+
+func (q *Queries) AddMenuMenuItems(ctx context.Context, arg AddMenuMenuItemsParams) error {
+	_, err := q.db.Exec(ctx, addMenuMenuItems, arg.MenuID, arg.MenuItemIds)
+	return err
+}
+
+const addMenuSchedule = `-- name: AddMenuSchedule :exec
+INSERT INTO "menu_schedule" ("menu_id", "weekdays", "start_time", "end_time", "start_date", "end_date")
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type AddMenuScheduleParams struct {
+	MenuID    int16       `json:"menu_id"`
+	Weekdays  []int16     `json:"weekdays"`
+	StartTime pgtype.Time `json:"start_time"`
+	EndTime   pgtype.Time `json:"end_time"`
+	StartDate pgtype.Date `json:"start_date"`
+	EndDate   pgtype.Date `json:"end_date"`
+}
+
+func (q *Queries) AddMenuSchedule(ctx context.Context, arg AddMenuScheduleParams) error {
+	_, err := q.db.Exec(ctx, addMenuSchedule,
+		arg.MenuID,
+		arg.Weekdays,
+		arg.StartTime,
+		arg.EndTime,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	return err
+}
+
+const addMenuTagPrerequisite = `-- name: AddMenuTagPrerequisite :exec
+INSERT INTO "menu_tag_prerequisite" ("menu_tag_id", "prerequisite_tag_id") VALUES ($1, $2)
+`
+
+type AddMenuTagPrerequisiteParams struct {
+	MenuTagID         int16 `json:"menu_tag_id"`
+	PrerequisiteTagID int16 `json:"prerequisite_tag_id"`
+}
+
+func (q *Queries) AddMenuTagPrerequisite(ctx context.Context, arg AddMenuTagPrerequisiteParams) error {
+	_, err := q.db.Exec(ctx, addMenuTagPrerequisite, arg.MenuTagID, arg.PrerequisiteTagID)
+	return err
+}
+
 const addOrderItemCustomerOwner = `-- name: AddOrderItemCustomerOwner :exec
 UPDATE "order_item" SET "customer_owners" = array_append("customer_owners", $4::UUID)
 WHERE "tab_id" = $1 AND "order_id" = $2 AND "scoped_id" = $3 AND $4::UUID != ANY("customer_owners")
@@ -56,6 +125,17 @@ func (q *Queries) AddOrderItemGuestOwner(ctx context.Context, arg AddOrderItemGu
 	return err
 }
 
+const anonymizeCustomer = `-- name: AnonymizeCustomer :exec
+UPDATE "customer" SET "login_id" = NULL, "email" = NULL, "password_hash" = '', "name" = '', "phone_number" = NULL,
+    "deleted_at" = NOW(), "updated_at" = NOW()
+WHERE "id" = $1 AND "deleted_at" IS NULL
+`
+
+func (q *Queries) AnonymizeCustomer(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, anonymizeCustomer, id)
+	return err
+}
+
 const closeTab = `-- name: CloseTab :one
 UPDATE "tab" SET "closed_at" = NOW() WHERE "id" = $1
 RETURNING "closed_at"
@@ -68,15 +148,37 @@ func (q *Queries) CloseTab(ctx context.Context, id uuid.UUID) (pgtype.Timestamp,
 	return closed_at, err
 }
 
+const countNotDeletedMenuItems = `-- name: CountNotDeletedMenuItems :one
+SELECT COUNT(*) FROM "menu_item" WHERE "id" = ANY($1::SMALLINT[]) AND "deleted_at" IS NULL
+`
+
+func (q *Queries) CountNotDeletedMenuItems(ctx context.Context, ids []int16) (int64, error) {
+	row := q.db.QueryRow(ctx, countNotDeletedMenuItems, ids)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countVisitedTabs = `-- name: CountVisitedTabs :one
+SELECT COUNT(*) FROM "visitation" WHERE "customer_id" = $1
+`
+
+func (q *Queries) CountVisitedTabs(ctx context.Context, customerID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countVisitedTabs, customerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCustomer = `-- name: CreateCustomer :one
 INSERT INTO "customer" ("login_id", "email", "password_hash", "name", "phone_number")
 VALUES ($1, $2, $3, $4, $5)
-RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at
+RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at
 `
 
 type CreateCustomerParams struct {
-	LoginID      string      `json:"login_id"`
-	Email        string      `json:"email"`
+	LoginID      pgtype.Text `json:"login_id"`
+	Email        pgtype.Text `json:"email"`
 	PasswordHash string      `json:"password_hash"`
 	Name         string      `json:"name"`
 	PhoneNumber  pgtype.Text `json:"phone_number"`
@@ -100,23 +202,91 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
+const createCustomerIdentity = `-- name: CreateCustomerIdentity :exec
+INSERT INTO "customer_identity" ("issuer", "subject", "customer_id", "email")
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateCustomerIdentityParams struct {
+	Issuer     string      `json:"issuer"`
+	Subject    string      `json:"subject"`
+	CustomerID uuid.UUID   `json:"customer_id"`
+	Email      pgtype.Text `json:"email"`
+}
+
+func (q *Queries) CreateCustomerIdentity(ctx context.Context, arg CreateCustomerIdentityParams) error {
+	_, err := q.db.Exec(ctx, createCustomerIdentity,
+		arg.Issuer,
+		arg.Subject,
+		arg.CustomerID,
+		arg.Email,
+	)
+	return err
+}
+
+const createCustomerToken = `-- name: CreateCustomerToken :exec
+INSERT INTO "customer_token" ("token_hash", "customer_id", "purpose", "email", "expires_at")
+VALUES ($1, $2, $3, $4, NOW() + $5::INT * INTERVAL '1 second')
+`
+
+type CreateCustomerTokenParams struct {
+	TokenHash  []byte    `json:"token_hash"`
+	CustomerID uuid.UUID `json:"customer_id"`
+	Purpose    string    `json:"purpose"`
+	Email      string    `json:"email"`
+	TtlSeconds int32     `json:"ttl_seconds"`
+}
+
+func (q *Queries) CreateCustomerToken(ctx context.Context, arg CreateCustomerTokenParams) error {
+	_, err := q.db.Exec(ctx, createCustomerToken,
+		arg.TokenHash,
+		arg.CustomerID,
+		arg.Purpose,
+		arg.Email,
+		arg.TtlSeconds,
+	)
+	return err
+}
+
 const createGuest = `-- name: CreateGuest :one
 UPDATE "guest_id_sequence" SET "value" = "value" + 1
-WHERE "tab_id" = $1
+WHERE "tab_id" = $1 AND "value" < $2::SMALLINT
 RETURNING "value"
 `
 
-func (q *Queries) CreateGuest(ctx context.Context, tabID uuid.UUID) (int32, error) {
-	row := q.db.QueryRow(ctx, createGuest, tabID)
+type CreateGuestParams struct {
+	TabID     uuid.UUID `json:"tab_id"`
+	MaxGuests int16     `json:"max_guests"`
+}
+
+func (q *Queries) CreateGuest(ctx context.Context, arg CreateGuestParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createGuest, arg.TabID, arg.MaxGuests)
 	var value int32
 	err := row.Scan(&value)
 	return value, err
 }
 
+const createGuestClaimToken = `-- name: CreateGuestClaimToken :exec
+INSERT INTO "guest_claim_token" ("tab_id", "guest_id", "token_hash") VALUES ($1, $2, $3)
+`
+
+type CreateGuestClaimTokenParams struct {
+	TabID     uuid.UUID `json:"tab_id"`
+	GuestID   int16     `json:"guest_id"`
+	TokenHash []byte    `json:"token_hash"`
+}
+
+func (q *Queries) CreateGuestClaimToken(ctx context.Context, arg CreateGuestClaimTokenParams) error {
+	_, err := q.db.Exec(ctx, createGuestClaimToken, arg.TabID, arg.GuestID, arg.TokenHash)
+	return err
+}
+
 const createGuestIDSequence = `-- name: CreateGuestIDSequence :exec
 INSERT INTO "guest_id_sequence" ("tab_id") VALUES ($1)
 `
@@ -126,10 +296,34 @@ func (q *Queries) CreateGuestIDSequence(ctx context.Context, tabID uuid.UUID) er
 	return err
 }
 
+const createMenu = `-- name: CreateMenu :one
+INSERT INTO "menu" ("name", "description", "timezone") VALUES ($1, $2, $3) RETURNING id, name, description, timezone, created_at, updated_at
+`
+
+type CreateMenuParams struct {
+	Name        string      `json:"name"`
+	Description pgtype.Text `json:"description"`
+	Timezone    string      `json:"timezone"`
+}
+
+func (q *Queries) CreateMenu(ctx context.Context, arg CreateMenuParams) (Menu, error) {
+	row := q.db.QueryRow(ctx, createMenu, arg.Name, arg.Description, arg.Timezone)
+	var i Menu
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Timezone,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const createMenuItem = `-- name: CreateMenuItem :one
 INSERT INTO "menu_item" ("name", "description", "photo_pathinfo", "price", "portion_size", "available", "modifiers_config")
 VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at
+RETURNING id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at, external_key, availability_override, availability_override_until
 `
 
 type CreateMenuItemParams struct {
@@ -165,6 +359,9 @@ func (q *Queries) CreateMenuItem(ctx context.Context, arg CreateMenuItemParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ExternalKey,
+		&i.AvailabilityOverride,
+		&i.AvailabilityOverrideUntil,
 	)
 	return i, err
 }
@@ -172,7 +369,7 @@ func (q *Queries) CreateMenuItem(ctx context.Context, arg CreateMenuItemParams)
 const createMenuTag = `-- name: CreateMenuTag :one
 INSERT INTO "menu_tag" ("value", "description", "dimension")
 VALUES ($1, $2, $3)
-RETURNING id, value, description, dimension, created_at, updated_at
+RETURNING id, value, description, dimension, created_at, updated_at, external_key
 `
 
 type CreateMenuTagParams struct {
@@ -191,6 +388,7 @@ func (q *Queries) CreateMenuTag(ctx context.Context, arg CreateMenuTagParams) (M
 		&i.Dimension,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ExternalKey,
 	)
 	return i, err
 }
@@ -198,7 +396,7 @@ func (q *Queries) CreateMenuTag(ctx context.Context, arg CreateMenuTagParams) (M
 const createMenuTagDimension = `-- name: CreateMenuTagDimension :one
 INSERT INTO "menu_tag_dimension" ("value", "description")
 VALUES ($1, $2)
-RETURNING id, value, description, created_at, updated_at
+RETURNING id, value, description, created_at, updated_at, external_key
 `
 
 type CreateMenuTagDimensionParams struct {
@@ -215,6 +413,7 @@ func (q *Queries) CreateMenuTagDimension(ctx context.Context, arg CreateMenuTagD
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ExternalKey,
 	)
 	return i, err
 }
@@ -275,6 +474,75 @@ func (q *Queries) CreateTab(ctx context.Context) (CreateTabRow, error) {
 	return i, err
 }
 
+const deleteCustomerFavorites = `-- name: DeleteCustomerFavorites :exec
+DELETE FROM "favorite" WHERE "customer_id" = $1
+`
+
+func (q *Queries) DeleteCustomerFavorites(ctx context.Context, customerID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCustomerFavorites, customerID)
+	return err
+}
+
+const deleteCustomerIdentities = `-- name: DeleteCustomerIdentities :exec
+DELETE FROM "customer_identity" WHERE "customer_id" = $1
+`
+
+func (q *Queries) DeleteCustomerIdentities(ctx context.Context, customerID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCustomerIdentities, customerID)
+	return err
+}
+
+const deleteCustomerTokens = `-- name: DeleteCustomerTokens :exec
+DELETE FROM "customer_token" WHERE "customer_id" = $1
+`
+
+func (q *Queries) DeleteCustomerTokens(ctx context.Context, customerID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCustomerTokens, customerID)
+	return err
+}
+
+const deleteCustomerVisitations = `-- name: DeleteCustomerVisitations :many
+DELETE FROM "visitation" WHERE "customer_id" = $1
+RETURNING "tab_id"
+`
+
+func (q *Queries) DeleteCustomerVisitations(ctx context.Context, customerID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, deleteCustomerVisitations, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var tab_id uuid.UUID
+		if err := rows.Scan(&tab_id); err != nil {
+			return nil, err
+		}
+		items = append(items, tab_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteFavorite = `-- name: DeleteFavorite :execrows
+DELETE FROM "favorite" WHERE "id" = $1 AND "customer_id" = $2
+`
+
+type DeleteFavoriteParams struct {
+	ID         int64     `json:"id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+}
+
+func (q *Queries) DeleteFavorite(ctx context.Context, arg DeleteFavoriteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFavorite, arg.ID, arg.CustomerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteGuestIDSequence = `-- name: DeleteGuestIDSequence :exec
 DELETE FROM "guest_id_sequence" WHERE "tab_id" = $1
 `
@@ -284,6 +552,54 @@ func (q *Queries) DeleteGuestIDSequence(ctx context.Context, tabID uuid.UUID) er
 	return err
 }
 
+const deleteMenu = `-- name: DeleteMenu :execrows
+DELETE FROM "menu" WHERE "id" = $1
+`
+
+func (q *Queries) DeleteMenu(ctx context.Context, id int16) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMenu, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteMenuItemTags = `-- name: DeleteMenuItemTags :exec
+DELETE FROM "menu_item_tag" WHERE "menu_item_id" = $1
+`
+
+func (q *Queries) DeleteMenuItemTags(ctx context.Context, menuItemID int16) error {
+	_, err := q.db.Exec(ctx, deleteMenuItemTags, menuItemID)
+	return err
+}
+
+const deleteMenuMenuItems = `-- name: DeleteMenuMenuItems :exec
+DELETE FROM "menu_menu_item" WHERE "menu_id" = $1
+`
+
+func (q *Queries) DeleteMenuMenuItems(ctx context.Context, menuID int16) error {
+	_, err := q.db.Exec(ctx, deleteMenuMenuItems, menuID)
+	return err
+}
+
+const deleteMenuSchedules = `-- name: DeleteMenuSchedules :exec
+DELETE FROM "menu_schedule" WHERE "menu_id" = $1
+`
+
+func (q *Queries) DeleteMenuSchedules(ctx context.Context, menuID int16) error {
+	_, err := q.db.Exec(ctx, deleteMenuSchedules, menuID)
+	return err
+}
+
+const deleteMenuTagPrerequisites = `-- name: DeleteMenuTagPrerequisites :exec
+DELETE FROM "menu_tag_prerequisite" WHERE "menu_tag_id" = $1
+`
+
+func (q *Queries) DeleteMenuTagPrerequisites(ctx context.Context, menuTagID int16) error {
+	_, err := q.db.Exec(ctx, deleteMenuTagPrerequisites, menuTagID)
+	return err
+}
+
 const deleteNotSentOrders = `-- name: DeleteNotSentOrders :exec
 DELETE FROM "order" WHERE "tab_id" = $1 AND "sent_at" IS NULL
 `
@@ -332,12 +648,26 @@ func (q *Queries) DeleteOrderItems(ctx context.Context, arg DeleteOrderItemsPara
 	return err
 }
 
-const getCustomerByID = `-- name: GetCustomerByID :one
-SELECT id, login_id, email, password_hash, name, phone_number, created_at, updated_at FROM "customer" WHERE "id" = $1
+const deleteUnusedCustomerTokens = `-- name: DeleteUnusedCustomerTokens :exec
+DELETE FROM "customer_token" WHERE "customer_id" = $1 AND "purpose" = $2 AND "used_at" IS NULL
 `
 
-func (q *Queries) GetCustomerByID(ctx context.Context, id uuid.UUID) (Customer, error) {
-	row := q.db.QueryRow(ctx, getCustomerByID, id)
+type DeleteUnusedCustomerTokensParams struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	Purpose    string    `json:"purpose"`
+}
+
+func (q *Queries) DeleteUnusedCustomerTokens(ctx context.Context, arg DeleteUnusedCustomerTokensParams) error {
+	_, err := q.db.Exec(ctx, deleteUnusedCustomerTokens, arg.CustomerID, arg.Purpose)
+	return err
+}
+
+const getCustomerByEmail = `-- name: GetCustomerByEmail :one
+SELECT id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at FROM "customer" WHERE "email" = $1 AND "deleted_at" IS NULL
+`
+
+func (q *Queries) GetCustomerByEmail(ctx context.Context, email pgtype.Text) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByEmail, email)
 	var i Customer
 	err := row.Scan(
 		&i.ID,
@@ -348,16 +678,18 @@ func (q *Queries) GetCustomerByID(ctx context.Context, id uuid.UUID) (Customer,
 		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
-const getCustomerByLogin = `-- name: GetCustomerByLogin :one
-SELECT id, login_id, email, password_hash, name, phone_number, created_at, updated_at FROM "customer" WHERE "login_id" = $1
+const getCustomerByID = `-- name: GetCustomerByID :one
+SELECT id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at FROM "customer" WHERE "id" = $1 AND "deleted_at" IS NULL
 `
 
-func (q *Queries) GetCustomerByLogin(ctx context.Context, loginID string) (Customer, error) {
-	row := q.db.QueryRow(ctx, getCustomerByLogin, loginID)
+func (q *Queries) GetCustomerByID(ctx context.Context, id uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByID, id)
 	var i Customer
 	err := row.Scan(
 		&i.ID,
@@ -368,105 +700,542 @@ func (q *Queries) GetCustomerByLogin(ctx context.Context, loginID string) (Custo
 		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
-const getMenuItem = `-- name: GetMenuItem :one
-SELECT id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at FROM "menu_item" WHERE "id" = $1
+const getCustomerByIDForUpdate = `-- name: GetCustomerByIDForUpdate :one
+SELECT id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at FROM "customer" WHERE "id" = $1 AND "deleted_at" IS NULL FOR UPDATE
 `
 
-func (q *Queries) GetMenuItem(ctx context.Context, id int16) (MenuItem, error) {
-	row := q.db.QueryRow(ctx, getMenuItem, id)
-	var i MenuItem
+func (q *Queries) GetCustomerByIDForUpdate(ctx context.Context, id uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByIDForUpdate, id)
+	var i Customer
 	err := row.Scan(
 		&i.ID,
+		&i.LoginID,
+		&i.Email,
+		&i.PasswordHash,
 		&i.Name,
-		&i.Description,
-		&i.PhotoPathinfo,
-		&i.Price,
-		&i.PortionSize,
-		&i.Available,
-		&i.ModifiersConfig,
+		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
-const getNotDeletedMenuItem = `-- name: GetNotDeletedMenuItem :one
-SELECT id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at FROM "menu_item" WHERE "id" = $1 AND "deleted_at" IS NULL
+const getCustomerByIdentity = `-- name: GetCustomerByIdentity :one
+SELECT c.id, c.login_id, c.email, c.password_hash, c.name, c.phone_number, c.created_at, c.updated_at, c.deleted_at, c.email_verified_at FROM "customer" AS "c"
+JOIN "customer_identity" AS "ci" ON "ci"."customer_id" = "c"."id"
+WHERE "ci"."issuer" = $1 AND "ci"."subject" = $2 AND "c"."deleted_at" IS NULL
 `
 
-func (q *Queries) GetNotDeletedMenuItem(ctx context.Context, id int16) (MenuItem, error) {
-	row := q.db.QueryRow(ctx, getNotDeletedMenuItem, id)
-	var i MenuItem
+type GetCustomerByIdentityParams struct {
+	Issuer  string `json:"issuer"`
+	Subject string `json:"subject"`
+}
+
+func (q *Queries) GetCustomerByIdentity(ctx context.Context, arg GetCustomerByIdentityParams) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByIdentity, arg.Issuer, arg.Subject)
+	var i Customer
 	err := row.Scan(
 		&i.ID,
+		&i.LoginID,
+		&i.Email,
+		&i.PasswordHash,
 		&i.Name,
-		&i.Description,
-		&i.PhotoPathinfo,
-		&i.Price,
-		&i.PortionSize,
-		&i.Available,
-		&i.ModifiersConfig,
+		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
-const getOpenTabWithOrders = `-- name: GetOpenTabWithOrders :one
-SELECT id, total_price, created_at, closed_at, guest_names, orders
-FROM "tab_with_orders"
-WHERE "id" = $1 AND "closed_at" IS NULL
+const getCustomerByLogin = `-- name: GetCustomerByLogin :one
+SELECT id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at FROM "customer" WHERE "login_id" = $1
 `
 
-func (q *Queries) GetOpenTabWithOrders(ctx context.Context, id uuid.UUID) (TabWithOrders, error) {
-	row := q.db.QueryRow(ctx, getOpenTabWithOrders, id)
-	var i TabWithOrders
+func (q *Queries) GetCustomerByLogin(ctx context.Context, loginID pgtype.Text) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByLogin, loginID)
+	var i Customer
 	err := row.Scan(
 		&i.ID,
-		&i.TotalPrice,
+		&i.LoginID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Name,
+		&i.PhoneNumber,
 		&i.CreatedAt,
-		&i.ClosedAt,
-		&i.GuestNames,
-		&i.Orders,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
-const getOrderForNoKeyUpdate = `-- name: GetOrderForNoKeyUpdate :one
-SELECT tab_id, scoped_id, sent_at FROM "order" WHERE "tab_id" = $1 AND "scoped_id" = $2 FOR NO KEY UPDATE
+const getCustomerFavorite = `-- name: GetCustomerFavorite :one
+SELECT f.id, f.customer_id, f.menu_item_id, f.name, f.modifiers, f.created_at, f.updated_at, "mi"."name" AS "menu_item_name", "mi"."modifiers_config", "mi"."deleted_at" AS "menu_item_deleted_at"
+FROM "favorite" AS "f"
+JOIN "menu_item" AS "mi" ON "f"."menu_item_id" = "mi"."id"
+WHERE "f"."id" = $1 AND "f"."customer_id" = $2
 `
 
-type GetOrderForNoKeyUpdateParams struct {
-	TabID    uuid.UUID `json:"tab_id"`
-	ScopedID int16     `json:"scoped_id"`
+type GetCustomerFavoriteParams struct {
+	ID         int64     `json:"id"`
+	CustomerID uuid.UUID `json:"customer_id"`
 }
 
-func (q *Queries) GetOrderForNoKeyUpdate(ctx context.Context, arg GetOrderForNoKeyUpdateParams) (Order, error) {
-	row := q.db.QueryRow(ctx, getOrderForNoKeyUpdate, arg.TabID, arg.ScopedID)
-	var i Order
-	err := row.Scan(&i.TabID, &i.ScopedID, &i.SentAt)
+type GetCustomerFavoriteRow struct {
+	ID                int64            `json:"id"`
+	CustomerID        uuid.UUID        `json:"customer_id"`
+	MenuItemID        int16            `json:"menu_item_id"`
+	Name              string           `json:"name"`
+	Modifiers         []byte           `json:"modifiers"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+	MenuItemName      string           `json:"menu_item_name"`
+	ModifiersConfig   []byte           `json:"modifiers_config"`
+	MenuItemDeletedAt pgtype.Timestamp `json:"menu_item_deleted_at"`
+}
+
+func (q *Queries) GetCustomerFavorite(ctx context.Context, arg GetCustomerFavoriteParams) (GetCustomerFavoriteRow, error) {
+	row := q.db.QueryRow(ctx, getCustomerFavorite, arg.ID, arg.CustomerID)
+	var i GetCustomerFavoriteRow
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.MenuItemID,
+		&i.Name,
+		&i.Modifiers,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MenuItemName,
+		&i.ModifiersConfig,
+		&i.MenuItemDeletedAt,
+	)
 	return i, err
 }
 
-const getOrderForShare = `-- name: GetOrderForShare :one
-SELECT tab_id, scoped_id, sent_at FROM "order" WHERE "tab_id" = $1 AND "scoped_id" = $2 FOR SHARE
+const getCustomerFavoriteItems = `-- name: GetCustomerFavoriteItems :many
+SELECT "mi"."id", "mi"."name", SUM("oi"."quantity")::BIGINT AS "quantity", COUNT(DISTINCT "oi"."tab_id")::BIGINT AS "tab_count"
+FROM "order_item" AS "oi"
+JOIN "order" AS "o" ON "oi"."tab_id" = "o"."tab_id" AND "oi"."order_id" = "o"."scoped_id"
+JOIN "menu_item" AS "mi" ON "oi"."menu_item_id" = "mi"."id"
+WHERE $1::UUID = ANY("oi"."customer_owners") AND "o"."sent_at" IS NOT NULL
+GROUP BY "mi"."id"
+ORDER BY "quantity" DESC, "tab_count" DESC, "mi"."id"
+LIMIT $2
 `
 
-type GetOrderForShareParams struct {
-	TabID    uuid.UUID `json:"tab_id"`
-	ScopedID int16     `json:"scoped_id"`
+type GetCustomerFavoriteItemsParams struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	MaxItems   int32     `json:"max_items"`
 }
 
-func (q *Queries) GetOrderForShare(ctx context.Context, arg GetOrderForShareParams) (Order, error) {
-	row := q.db.QueryRow(ctx, getOrderForShare, arg.TabID, arg.ScopedID)
-	var i Order
-	err := row.Scan(&i.TabID, &i.ScopedID, &i.SentAt)
+type GetCustomerFavoriteItemsRow struct {
+	ID       int16  `json:"id"`
+	Name     string `json:"name"`
+	Quantity int64  `json:"quantity"`
+	TabCount int64  `json:"tab_count"`
+}
+
+func (q *Queries) GetCustomerFavoriteItems(ctx context.Context, arg GetCustomerFavoriteItemsParams) ([]GetCustomerFavoriteItemsRow, error) {
+	rows, err := q.db.Query(ctx, getCustomerFavoriteItems, arg.CustomerID, arg.MaxItems)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCustomerFavoriteItemsRow
+	for rows.Next() {
+		var i GetCustomerFavoriteItemsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Quantity,
+			&i.TabCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerFavorites = `-- name: GetCustomerFavorites :many
+SELECT f.id, f.customer_id, f.menu_item_id, f.name, f.modifiers, f.created_at, f.updated_at, "mi"."name" AS "menu_item_name", "mi"."modifiers_config", "mi"."deleted_at" AS "menu_item_deleted_at"
+FROM "favorite" AS "f"
+JOIN "menu_item" AS "mi" ON "f"."menu_item_id" = "mi"."id"
+WHERE "f"."customer_id" = $1
+ORDER BY "f"."name"
+`
+
+type GetCustomerFavoritesRow struct {
+	ID                int64            `json:"id"`
+	CustomerID        uuid.UUID        `json:"customer_id"`
+	MenuItemID        int16            `json:"menu_item_id"`
+	Name              string           `json:"name"`
+	Modifiers         []byte           `json:"modifiers"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+	MenuItemName      string           `json:"menu_item_name"`
+	ModifiersConfig   []byte           `json:"modifiers_config"`
+	MenuItemDeletedAt pgtype.Timestamp `json:"menu_item_deleted_at"`
+}
+
+func (q *Queries) GetCustomerFavorites(ctx context.Context, customerID uuid.UUID) ([]GetCustomerFavoritesRow, error) {
+	rows, err := q.db.Query(ctx, getCustomerFavorites, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCustomerFavoritesRow
+	for rows.Next() {
+		var i GetCustomerFavoritesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CustomerID,
+			&i.MenuItemID,
+			&i.Name,
+			&i.Modifiers,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MenuItemName,
+			&i.ModifiersConfig,
+			&i.MenuItemDeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerIdentities = `-- name: GetCustomerIdentities :many
+SELECT issuer, subject, customer_id, email, created_at, last_login_at FROM "customer_identity" WHERE "customer_id" = $1
+ORDER BY "created_at"
+`
+
+func (q *Queries) GetCustomerIdentities(ctx context.Context, customerID uuid.UUID) ([]CustomerIdentity, error) {
+	rows, err := q.db.Query(ctx, getCustomerIdentities, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CustomerIdentity
+	for rows.Next() {
+		var i CustomerIdentity
+		if err := rows.Scan(
+			&i.Issuer,
+			&i.Subject,
+			&i.CustomerID,
+			&i.Email,
+			&i.CreatedAt,
+			&i.LastLoginAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerSentOrderItems = `-- name: GetCustomerSentOrderItems :many
+SELECT oi.tab_id, oi.order_id, oi.scoped_id, oi.menu_item_id, oi.quantity, oi.modifiers, oi.guest_owners, oi.customer_owners, oi.name, oi.description, oi.photo_pathinfo, oi.price, oi.portion_size, oi.modifiers_config
+FROM "order_item_with_menu" AS "oi"
+JOIN "order" AS "o" ON "oi"."tab_id" = "o"."tab_id" AND "oi"."order_id" = "o"."scoped_id"
+WHERE $1::UUID = ANY("oi"."customer_owners") AND "o"."sent_at" IS NOT NULL
+ORDER BY "o"."sent_at", "oi"."tab_id", "oi"."order_id", "oi"."scoped_id"
+`
+
+func (q *Queries) GetCustomerSentOrderItems(ctx context.Context, customerID uuid.UUID) ([]OrderItemWithMenu, error) {
+	rows, err := q.db.Query(ctx, getCustomerSentOrderItems, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrderItemWithMenu
+	for rows.Next() {
+		var i OrderItemWithMenu
+		if err := rows.Scan(
+			&i.TabID,
+			&i.OrderID,
+			&i.ScopedID,
+			&i.MenuItemID,
+			&i.Quantity,
+			&i.Modifiers,
+			&i.GuestOwners,
+			&i.CustomerOwners,
+			&i.Name,
+			&i.Description,
+			&i.PhotoPathinfo,
+			&i.Price,
+			&i.PortionSize,
+			&i.ModifiersConfig,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomerSpend = `-- name: GetCustomerSpend :one
+SELECT COALESCE(ROUND(SUM(
+    "mi"."price" * "oi"."quantity"::NUMERIC
+    / GREATEST(COALESCE(cardinality("oi"."guest_owners"), 0) + cardinality("oi"."customer_owners"), 1)
+)), 0)::BIGINT AS "total_spend"
+FROM "order_item" AS "oi"
+JOIN "order" AS "o" ON "oi"."tab_id" = "o"."tab_id" AND "oi"."order_id" = "o"."scoped_id"
+JOIN "menu_item" AS "mi" ON "oi"."menu_item_id" = "mi"."id"
+WHERE $1::UUID = ANY("oi"."customer_owners") AND "o"."sent_at" IS NOT NULL
+`
+
+// Items shared by several guests and customers are split evenly between them
+func (q *Queries) GetCustomerSpend(ctx context.Context, customerID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, getCustomerSpend, customerID)
+	var total_spend int64
+	err := row.Scan(&total_spend)
+	return total_spend, err
+}
+
+const getGuestClaimTokenForUpdate = `-- name: GetGuestClaimTokenForUpdate :one
+SELECT tab_id, guest_id, token_hash, claimed_by, claimed_at FROM "guest_claim_token" WHERE "tab_id" = $1 AND "guest_id" = $2
+FOR UPDATE
+`
+
+type GetGuestClaimTokenForUpdateParams struct {
+	TabID   uuid.UUID `json:"tab_id"`
+	GuestID int16     `json:"guest_id"`
+}
+
+func (q *Queries) GetGuestClaimTokenForUpdate(ctx context.Context, arg GetGuestClaimTokenForUpdateParams) (GuestClaimToken, error) {
+	row := q.db.QueryRow(ctx, getGuestClaimTokenForUpdate, arg.TabID, arg.GuestID)
+	var i GuestClaimToken
+	err := row.Scan(
+		&i.TabID,
+		&i.GuestID,
+		&i.TokenHash,
+		&i.ClaimedBy,
+		&i.ClaimedAt,
+	)
+	return i, err
+}
+
+const getMenu = `-- name: GetMenu :one
+SELECT id, name, description, timezone, created_at, updated_at FROM "menu" WHERE "id" = $1
+`
+
+func (q *Queries) GetMenu(ctx context.Context, id int16) (Menu, error) {
+	row := q.db.QueryRow(ctx, getMenu, id)
+	var i Menu
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Timezone,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMenuItem = `-- name: GetMenuItem :one
+SELECT id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at, external_key, availability_override, availability_override_until FROM "menu_item" WHERE "id" = $1
+`
+
+func (q *Queries) GetMenuItem(ctx context.Context, id int16) (MenuItem, error) {
+	row := q.db.QueryRow(ctx, getMenuItem, id)
+	var i MenuItem
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.PhotoPathinfo,
+		&i.Price,
+		&i.PortionSize,
+		&i.Available,
+		&i.ModifiersConfig,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ExternalKey,
+		&i.AvailabilityOverride,
+		&i.AvailabilityOverrideUntil,
+	)
+	return i, err
+}
+
+const getMenuItemPhoto = `-- name: GetMenuItemPhoto :one
+SELECT menu_item_id, content_type, width, height, blurhash, original_key, variants, created_at FROM "menu_item_photo" WHERE "menu_item_id" = $1
+`
+
+func (q *Queries) GetMenuItemPhoto(ctx context.Context, menuItemID int16) (MenuItemPhoto, error) {
+	row := q.db.QueryRow(ctx, getMenuItemPhoto, menuItemID)
+	var i MenuItemPhoto
+	err := row.Scan(
+		&i.MenuItemID,
+		&i.ContentType,
+		&i.Width,
+		&i.Height,
+		&i.Blurhash,
+		&i.OriginalKey,
+		&i.Variants,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMenuItemPhotos = `-- name: GetMenuItemPhotos :many
+SELECT menu_item_id, content_type, width, height, blurhash, original_key, variants, created_at FROM "menu_item_photo" WHERE "menu_item_id" = ANY($1::SMALLINT[])
+`
+
+func (q *Queries) GetMenuItemPhotos(ctx context.Context, menuItemIds []int16) ([]MenuItemPhoto, error) {
+	rows, err := q.db.Query(ctx, getMenuItemPhotos, menuItemIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MenuItemPhoto
+	for rows.Next() {
+		var i MenuItemPhoto
+		if err := rows.Scan(
+			&i.MenuItemID,
+			&i.ContentType,
+			&i.Width,
+			&i.Height,
+			&i.Blurhash,
+			&i.OriginalKey,
+			&i.Variants,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotDeletedMenuItem = `-- name: GetNotDeletedMenuItem :one
+SELECT id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at, external_key, availability_override, availability_override_until FROM "menu_item" WHERE "id" = $1 AND "deleted_at" IS NULL
+`
+
+func (q *Queries) GetNotDeletedMenuItem(ctx context.Context, id int16) (MenuItem, error) {
+	row := q.db.QueryRow(ctx, getNotDeletedMenuItem, id)
+	var i MenuItem
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.PhotoPathinfo,
+		&i.Price,
+		&i.PortionSize,
+		&i.Available,
+		&i.ModifiersConfig,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ExternalKey,
+		&i.AvailabilityOverride,
+		&i.AvailabilityOverrideUntil,
+	)
+	return i, err
+}
+
+const getOpenTabIDsForShare = `-- name: GetOpenTabIDsForShare :many
+SELECT "id" FROM "tab" WHERE "id" = ANY($1::UUID[]) AND "closed_at" IS NULL
+FOR SHARE
+`
+
+func (q *Queries) GetOpenTabIDsForShare(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getOpenTabIDsForShare, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpenTabWithOrders = `-- name: GetOpenTabWithOrders :one
+SELECT id, total_price, created_at, closed_at, guest_names, orders
+FROM "tab_with_orders"
+WHERE "id" = $1 AND "closed_at" IS NULL
+`
+
+func (q *Queries) GetOpenTabWithOrders(ctx context.Context, id uuid.UUID) (TabWithOrders, error) {
+	row := q.db.QueryRow(ctx, getOpenTabWithOrders, id)
+	var i TabWithOrders
+	err := row.Scan(
+		&i.ID,
+		&i.TotalPrice,
+		&i.CreatedAt,
+		&i.ClosedAt,
+		&i.GuestNames,
+		&i.Orders,
+	)
+	return i, err
+}
+
+const getOrderForNoKeyUpdate = `-- name: GetOrderForNoKeyUpdate :one
+SELECT tab_id, scoped_id, sent_at FROM "order" WHERE "tab_id" = $1 AND "scoped_id" = $2 FOR NO KEY UPDATE
+`
+
+type GetOrderForNoKeyUpdateParams struct {
+	TabID    uuid.UUID `json:"tab_id"`
+	ScopedID int16     `json:"scoped_id"`
+}
+
+func (q *Queries) GetOrderForNoKeyUpdate(ctx context.Context, arg GetOrderForNoKeyUpdateParams) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderForNoKeyUpdate, arg.TabID, arg.ScopedID)
+	var i Order
+	err := row.Scan(&i.TabID, &i.ScopedID, &i.SentAt)
+	return i, err
+}
+
+const getOrderForShare = `-- name: GetOrderForShare :one
+SELECT tab_id, scoped_id, sent_at FROM "order" WHERE "tab_id" = $1 AND "scoped_id" = $2 FOR SHARE
+`
+
+type GetOrderForShareParams struct {
+	TabID    uuid.UUID `json:"tab_id"`
+	ScopedID int16     `json:"scoped_id"`
+}
+
+func (q *Queries) GetOrderForShare(ctx context.Context, arg GetOrderForShareParams) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderForShare, arg.TabID, arg.ScopedID)
+	var i Order
+	err := row.Scan(&i.TabID, &i.ScopedID, &i.SentAt)
 	return i, err
 }
 
@@ -491,6 +1260,75 @@ func (q *Queries) GetOrderWithItems(ctx context.Context, arg GetOrderWithItemsPa
 	return i, err
 }
 
+const getSentOrderItemsForReorder = `-- name: GetSentOrderItemsForReorder :many
+SELECT "oi"."menu_item_id", "oi"."quantity", "oi"."modifiers", "oi"."customer_owners",
+    "mi"."name", "mi"."description", "mi"."photo_pathinfo", "mi"."price", "mi"."portion_size", "mi"."modifiers_config",
+    "mi"."available", "mi"."availability_override", "mi"."availability_override_until", "mi"."deleted_at"
+FROM "order_item" AS "oi"
+JOIN "order" AS "o" ON "oi"."tab_id" = "o"."tab_id" AND "oi"."order_id" = "o"."scoped_id"
+JOIN "menu_item" AS "mi" ON "oi"."menu_item_id" = "mi"."id"
+WHERE "oi"."tab_id" = $1 AND "o"."sent_at" IS NOT NULL
+    AND ($2::SMALLINT IS NULL OR "oi"."order_id" = $2::SMALLINT)
+ORDER BY "oi"."order_id", "oi"."scoped_id"
+`
+
+type GetSentOrderItemsForReorderParams struct {
+	TabID   uuid.UUID   `json:"tab_id"`
+	OrderID pgtype.Int2 `json:"order_id"`
+}
+
+type GetSentOrderItemsForReorderRow struct {
+	MenuItemID                int16            `json:"menu_item_id"`
+	Quantity                  int16            `json:"quantity"`
+	Modifiers                 []byte           `json:"modifiers"`
+	CustomerOwners            []uuid.UUID      `json:"customer_owners"`
+	Name                      string           `json:"name"`
+	Description               pgtype.Text      `json:"description"`
+	PhotoPathinfo             pgtype.Text      `json:"photo_pathinfo"`
+	Price                     int32            `json:"price"`
+	PortionSize               int16            `json:"portion_size"`
+	ModifiersConfig           []byte           `json:"modifiers_config"`
+	Available                 bool             `json:"available"`
+	AvailabilityOverride      pgtype.Bool      `json:"availability_override"`
+	AvailabilityOverrideUntil pgtype.Timestamp `json:"availability_override_until"`
+	DeletedAt                 pgtype.Timestamp `json:"deleted_at"`
+}
+
+func (q *Queries) GetSentOrderItemsForReorder(ctx context.Context, arg GetSentOrderItemsForReorderParams) ([]GetSentOrderItemsForReorderRow, error) {
+	rows, err := q.db.Query(ctx, getSentOrderItemsForReorder, arg.TabID, arg.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSentOrderItemsForReorderRow
+	for rows.Next() {
+		var i GetSentOrderItemsForReorderRow
+		if err := rows.Scan(
+			&i.MenuItemID,
+			&i.Quantity,
+			&i.Modifiers,
+			&i.CustomerOwners,
+			&i.Name,
+			&i.Description,
+			&i.PhotoPathinfo,
+			&i.Price,
+			&i.PortionSize,
+			&i.ModifiersConfig,
+			&i.Available,
+			&i.AvailabilityOverride,
+			&i.AvailabilityOverrideUntil,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTabForNoKeyUpdate = `-- name: GetTabForNoKeyUpdate :one
 SELECT id, total_price, created_at, closed_at, guest_names FROM "tab" WHERE "id" = $1 FOR NO KEY UPDATE
 `
@@ -575,6 +1413,73 @@ func (q *Queries) GetTabWithOrdersForShare(ctx context.Context, tabID uuid.UUID)
 	return i, err
 }
 
+const getTabsWithOrders = `-- name: GetTabsWithOrders :many
+SELECT id, total_price, created_at, closed_at, guest_names, orders
+FROM "tab_with_orders"
+WHERE "id" = ANY($1::UUID[])
+`
+
+func (q *Queries) GetTabsWithOrders(ctx context.Context, ids []uuid.UUID) ([]TabWithOrders, error) {
+	rows, err := q.db.Query(ctx, getTabsWithOrders, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TabWithOrders
+	for rows.Next() {
+		var i TabWithOrders
+		if err := rows.Scan(
+			&i.ID,
+			&i.TotalPrice,
+			&i.CreatedAt,
+			&i.ClosedAt,
+			&i.GuestNames,
+			&i.Orders,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVisitedTabs = `-- name: GetVisitedTabs :many
+SELECT t.id, t.total_price, t.created_at, t.closed_at, t.guest_names
+FROM "tab" t
+JOIN "visitation" v ON t."id" = v."tab_id"
+WHERE v."customer_id" = $1
+ORDER BY t."created_at", t."id"
+`
+
+func (q *Queries) GetVisitedTabs(ctx context.Context, customerID uuid.UUID) ([]Tab, error) {
+	rows, err := q.db.Query(ctx, getVisitedTabs, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tab
+	for rows.Next() {
+		var i Tab
+		if err := rows.Scan(
+			&i.ID,
+			&i.TotalPrice,
+			&i.CreatedAt,
+			&i.ClosedAt,
+			&i.GuestNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getVisitedTabsWithOrders = `-- name: GetVisitedTabsWithOrders :many
 SELECT t.id, t.total_price, t.created_at, t.closed_at, t.guest_names, t.orders
 FROM "tab_with_orders" t
@@ -609,6 +1514,25 @@ func (q *Queries) GetVisitedTabsWithOrders(ctx context.Context, customerID uuid.
 	return items, nil
 }
 
+const hasRecentIdentityLogin = `-- name: HasRecentIdentityLogin :one
+SELECT EXISTS (
+    SELECT 1 FROM "customer_identity"
+    WHERE "customer_id" = $1 AND "last_login_at" > NOW() - $2::INT * INTERVAL '1 second'
+)
+`
+
+type HasRecentIdentityLoginParams struct {
+	CustomerID    uuid.UUID `json:"customer_id"`
+	WindowSeconds int32     `json:"window_seconds"`
+}
+
+func (q *Queries) HasRecentIdentityLogin(ctx context.Context, arg HasRecentIdentityLoginParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasRecentIdentityLogin, arg.CustomerID, arg.WindowSeconds)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const isVisitingCustomerIDs = `-- name: IsVisitingCustomerIDs :many
 SELECT "customer_id"
 FROM "visitation"
@@ -640,8 +1564,71 @@ func (q *Queries) IsVisitingCustomerIDs(ctx context.Context, arg IsVisitingCusto
 	return items, nil
 }
 
+const listAllMenuItems = `-- name: ListAllMenuItems :many
+SELECT id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at, external_key, availability_override, availability_override_until FROM "menu_item" ORDER BY "name", "id"
+`
+
+func (q *Queries) ListAllMenuItems(ctx context.Context) ([]MenuItem, error) {
+	rows, err := q.db.Query(ctx, listAllMenuItems)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MenuItem
+	for rows.Next() {
+		var i MenuItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.PhotoPathinfo,
+			&i.Price,
+			&i.PortionSize,
+			&i.Available,
+			&i.ModifiersConfig,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ExternalKey,
+			&i.AvailabilityOverride,
+			&i.AvailabilityOverrideUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuItemTags = `-- name: ListMenuItemTags :many
+SELECT menu_item_id, menu_tag_id FROM "menu_item_tag"
+`
+
+func (q *Queries) ListMenuItemTags(ctx context.Context) ([]MenuItemTag, error) {
+	rows, err := q.db.Query(ctx, listMenuItemTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MenuItemTag
+	for rows.Next() {
+		var i MenuItemTag
+		if err := rows.Scan(&i.MenuItemID, &i.MenuTagID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMenuItems = `-- name: ListMenuItems :many
-SELECT id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at FROM "menu_item" WHERE "deleted_at" IS NULL ORDER BY "name"
+SELECT id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at, external_key, availability_override, availability_override_until FROM "menu_item" WHERE "deleted_at" IS NULL ORDER BY "name"
 `
 
 func (q *Queries) ListMenuItems(ctx context.Context) ([]MenuItem, error) {
@@ -665,6 +1652,119 @@ func (q *Queries) ListMenuItems(ctx context.Context) ([]MenuItem, error) {
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ExternalKey,
+			&i.AvailabilityOverride,
+			&i.AvailabilityOverrideUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuMenuItems = `-- name: ListMenuMenuItems :many
+SELECT menu_id, menu_item_id FROM "menu_menu_item" WHERE "menu_id" = ANY($1::SMALLINT[]) ORDER BY "menu_id", "menu_item_id"
+`
+
+func (q *Queries) ListMenuMenuItems(ctx context.Context, menuIds []int16) ([]MenuMenuItem, error) {
+	rows, err := q.db.Query(ctx, listMenuMenuItems, menuIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MenuMenuItem
+	for rows.Next() {
+		var i MenuMenuItem
+		if err := rows.Scan(&i.MenuID, &i.MenuItemID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuSchedules = `-- name: ListMenuSchedules :many
+SELECT id, menu_id, weekdays, start_time, end_time, start_date, end_date FROM "menu_schedule" WHERE "menu_id" = ANY($1::SMALLINT[]) ORDER BY "menu_id", "id"
+`
+
+func (q *Queries) ListMenuSchedules(ctx context.Context, menuIds []int16) ([]MenuSchedule, error) {
+	rows, err := q.db.Query(ctx, listMenuSchedules, menuIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MenuSchedule
+	for rows.Next() {
+		var i MenuSchedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.MenuID,
+			&i.Weekdays,
+			&i.StartTime,
+			&i.EndTime,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuSchedulesOfMenuItems = `-- name: ListMenuSchedulesOfMenuItems :many
+SELECT "mmi"."menu_item_id", "m"."id" AS "menu_id", "m"."timezone", "ms"."id" AS "schedule_id",
+    "ms"."weekdays", "ms"."start_time", "ms"."end_time", "ms"."start_date", "ms"."end_date"
+FROM "menu_menu_item" AS "mmi"
+JOIN "menu" AS "m" ON "mmi"."menu_id" = "m"."id"
+LEFT JOIN "menu_schedule" AS "ms" ON "ms"."menu_id" = "m"."id"
+WHERE "mmi"."menu_item_id" = ANY($1::SMALLINT[])
+ORDER BY "mmi"."menu_item_id", "m"."id", "ms"."id"
+`
+
+type ListMenuSchedulesOfMenuItemsRow struct {
+	MenuItemID int16       `json:"menu_item_id"`
+	MenuID     int16       `json:"menu_id"`
+	Timezone   string      `json:"timezone"`
+	ScheduleID pgtype.Int4 `json:"schedule_id"`
+	Weekdays   []int16     `json:"weekdays"`
+	StartTime  pgtype.Time `json:"start_time"`
+	EndTime    pgtype.Time `json:"end_time"`
+	StartDate  pgtype.Date `json:"start_date"`
+	EndDate    pgtype.Date `json:"end_date"`
+}
+
+// A row per schedule of every menu of the menu items, with NULL schedule columns for
+// menus without schedules
+func (q *Queries) ListMenuSchedulesOfMenuItems(ctx context.Context, menuItemIds []int16) ([]ListMenuSchedulesOfMenuItemsRow, error) {
+	rows, err := q.db.Query(ctx, listMenuSchedulesOfMenuItems, menuItemIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMenuSchedulesOfMenuItemsRow
+	for rows.Next() {
+		var i ListMenuSchedulesOfMenuItemsRow
+		if err := rows.Scan(
+			&i.MenuItemID,
+			&i.MenuID,
+			&i.Timezone,
+			&i.ScheduleID,
+			&i.Weekdays,
+			&i.StartTime,
+			&i.EndTime,
+			&i.StartDate,
+			&i.EndDate,
 		); err != nil {
 			return nil, err
 		}
@@ -677,7 +1777,7 @@ func (q *Queries) ListMenuItems(ctx context.Context) ([]MenuItem, error) {
 }
 
 const listMenuTagDimensions = `-- name: ListMenuTagDimensions :many
-SELECT id, value, description, created_at, updated_at FROM "menu_tag_dimension" ORDER BY "value"
+SELECT id, value, description, created_at, updated_at, external_key FROM "menu_tag_dimension" ORDER BY "value"
 `
 
 func (q *Queries) ListMenuTagDimensions(ctx context.Context) ([]MenuTagDimension, error) {
@@ -686,13 +1786,101 @@ func (q *Queries) ListMenuTagDimensions(ctx context.Context) ([]MenuTagDimension
 		return nil, err
 	}
 	defer rows.Close()
-	var items []MenuTagDimension
+	var items []MenuTagDimension
+	for rows.Next() {
+		var i MenuTagDimension
+		if err := rows.Scan(
+			&i.ID,
+			&i.Value,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExternalKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuTagPrerequisites = `-- name: ListMenuTagPrerequisites :many
+SELECT menu_tag_id, prerequisite_tag_id FROM "menu_tag_prerequisite"
+`
+
+func (q *Queries) ListMenuTagPrerequisites(ctx context.Context) ([]MenuTagPrerequisite, error) {
+	rows, err := q.db.Query(ctx, listMenuTagPrerequisites)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MenuTagPrerequisite
+	for rows.Next() {
+		var i MenuTagPrerequisite
+		if err := rows.Scan(&i.MenuTagID, &i.PrerequisiteTagID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenuTags = `-- name: ListMenuTags :many
+SELECT id, value, description, dimension, created_at, updated_at, external_key FROM "menu_tag" ORDER BY "value"
+`
+
+func (q *Queries) ListMenuTags(ctx context.Context) ([]MenuTag, error) {
+	rows, err := q.db.Query(ctx, listMenuTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MenuTag
+	for rows.Next() {
+		var i MenuTag
+		if err := rows.Scan(
+			&i.ID,
+			&i.Value,
+			&i.Description,
+			&i.Dimension,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExternalKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMenus = `-- name: ListMenus :many
+SELECT id, name, description, timezone, created_at, updated_at FROM "menu" ORDER BY "name"
+`
+
+func (q *Queries) ListMenus(ctx context.Context) ([]Menu, error) {
+	rows, err := q.db.Query(ctx, listMenus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Menu
 	for rows.Next() {
-		var i MenuTagDimension
+		var i Menu
 		if err := rows.Scan(
 			&i.ID,
-			&i.Value,
+			&i.Name,
 			&i.Description,
+			&i.Timezone,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -706,26 +1894,53 @@ func (q *Queries) ListMenuTagDimensions(ctx context.Context) ([]MenuTagDimension
 	return items, nil
 }
 
-const listMenuTags = `-- name: ListMenuTags :many
-SELECT id, value, description, dimension, created_at, updated_at FROM "menu_tag" ORDER BY "value"
+const listVisitedTabs = `-- name: ListVisitedTabs :many
+SELECT t.id, t.total_price, t.created_at, t.closed_at, t.guest_names
+FROM "tab" t
+JOIN "visitation" v ON t."id" = v."tab_id"
+WHERE v."customer_id" = $1
+    AND ($2::TIMESTAMP IS NULL OR t."created_at" >= $2::TIMESTAMP)
+    AND ($3::TIMESTAMP IS NULL OR t."created_at" < $3::TIMESTAMP)
+    AND ($4::BOOLEAN IS NULL OR (t."closed_at" IS NOT NULL) = $4::BOOLEAN)
+    AND ($5::TIMESTAMP IS NULL
+        OR (t."created_at", t."id") < ($5::TIMESTAMP, $6::UUID))
+ORDER BY t."created_at" DESC, t."id" DESC
+LIMIT $7
 `
 
-func (q *Queries) ListMenuTags(ctx context.Context) ([]MenuTag, error) {
-	rows, err := q.db.Query(ctx, listMenuTags)
+type ListVisitedTabsParams struct {
+	CustomerID     uuid.UUID        `json:"customer_id"`
+	Since          pgtype.Timestamp `json:"since"`
+	Until          pgtype.Timestamp `json:"until"`
+	Closed         pgtype.Bool      `json:"closed"`
+	AfterCreatedAt pgtype.Timestamp `json:"after_created_at"`
+	AfterID        pgtype.UUID      `json:"after_id"`
+	PageSize       int32            `json:"page_size"`
+}
+
+func (q *Queries) ListVisitedTabs(ctx context.Context, arg ListVisitedTabsParams) ([]Tab, error) {
+	rows, err := q.db.Query(ctx, listVisitedTabs,
+		arg.CustomerID,
+		arg.Since,
+		arg.Until,
+		arg.Closed,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []MenuTag
+	var items []Tab
 	for rows.Next() {
-		var i MenuTag
+		var i Tab
 		if err := rows.Scan(
 			&i.ID,
-			&i.Value,
-			&i.Description,
-			&i.Dimension,
+			&i.TotalPrice,
 			&i.CreatedAt,
-			&i.UpdatedAt,
+			&i.ClosedAt,
+			&i.GuestNames,
 		); err != nil {
 			return nil, err
 		}
@@ -737,6 +1952,57 @@ func (q *Queries) ListMenuTags(ctx context.Context) ([]MenuTag, error) {
 	return items, nil
 }
 
+const lockNotDeletedMenuItem = `-- name: LockNotDeletedMenuItem :one
+SELECT "id" FROM "menu_item" WHERE "id" = $1 AND "deleted_at" IS NULL FOR UPDATE
+`
+
+func (q *Queries) LockNotDeletedMenuItem(ctx context.Context, id int16) (int16, error) {
+	row := q.db.QueryRow(ctx, lockNotDeletedMenuItem, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const markGuestClaimed = `-- name: MarkGuestClaimed :exec
+UPDATE "guest_claim_token" SET "claimed_by" = $3, "claimed_at" = NOW()
+WHERE "tab_id" = $1 AND "guest_id" = $2
+`
+
+type MarkGuestClaimedParams struct {
+	TabID     uuid.UUID   `json:"tab_id"`
+	GuestID   int16       `json:"guest_id"`
+	ClaimedBy pgtype.UUID `json:"claimed_by"`
+}
+
+func (q *Queries) MarkGuestClaimed(ctx context.Context, arg MarkGuestClaimedParams) error {
+	_, err := q.db.Exec(ctx, markGuestClaimed, arg.TabID, arg.GuestID, arg.ClaimedBy)
+	return err
+}
+
+const removeCustomerFromOrderItems = `-- name: RemoveCustomerFromOrderItems :exec
+UPDATE "order_item" SET "customer_owners" = array_remove("customer_owners", $1::UUID)
+WHERE $1::UUID = ANY("customer_owners")
+`
+
+func (q *Queries) RemoveCustomerFromOrderItems(ctx context.Context, customerID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, removeCustomerFromOrderItems, customerID)
+	return err
+}
+
+const removeGuestName = `-- name: RemoveGuestName :exec
+UPDATE "tab" SET "guest_names" = "guest_names" - $2::SMALLINT::TEXT
+WHERE "id" = $1
+`
+
+type RemoveGuestNameParams struct {
+	ID       uuid.UUID `json:"id"`
+	ScopedID int16     `json:"scoped_id"`
+}
+
+func (q *Queries) RemoveGuestName(ctx context.Context, arg RemoveGuestNameParams) error {
+	_, err := q.db.Exec(ctx, removeGuestName, arg.ID, arg.ScopedID)
+	return err
+}
+
 const removeOrderItemCustomerOwner = `-- name: RemoveOrderItemCustomerOwner :exec
 UPDATE "order_item" SET "customer_owners" = array_remove("customer_owners", $4::UUID)
 WHERE "tab_id" = $1 AND "order_id" = $2 AND "scoped_id" = $3 AND $4::UUID = ANY("customer_owners")
@@ -781,6 +2047,85 @@ func (q *Queries) RemoveOrderItemGuestOwner(ctx context.Context, arg RemoveOrder
 	return err
 }
 
+const saveFavorite = `-- name: SaveFavorite :one
+INSERT INTO "favorite" ("customer_id", "menu_item_id", "name", "modifiers")
+VALUES ($1, $2, $3, $4)
+ON CONFLICT ("customer_id", "name") DO UPDATE
+SET "menu_item_id" = EXCLUDED."menu_item_id", "modifiers" = EXCLUDED."modifiers", "updated_at" = NOW()
+RETURNING id, customer_id, menu_item_id, name, modifiers, created_at, updated_at
+`
+
+type SaveFavoriteParams struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	MenuItemID int16     `json:"menu_item_id"`
+	Name       string    `json:"name"`
+	Modifiers  []byte    `json:"modifiers"`
+}
+
+func (q *Queries) SaveFavorite(ctx context.Context, arg SaveFavoriteParams) (Favorite, error) {
+	row := q.db.QueryRow(ctx, saveFavorite,
+		arg.CustomerID,
+		arg.MenuItemID,
+		arg.Name,
+		arg.Modifiers,
+	)
+	var i Favorite
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.MenuItemID,
+		&i.Name,
+		&i.Modifiers,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const saveMenuItemPhoto = `-- name: SaveMenuItemPhoto :one
+INSERT INTO "menu_item_photo" ("menu_item_id", "content_type", "width", "height", "blurhash", "original_key", "variants")
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT ("menu_item_id") DO UPDATE
+SET "content_type" = EXCLUDED."content_type", "width" = EXCLUDED."width", "height" = EXCLUDED."height",
+    "blurhash" = EXCLUDED."blurhash", "original_key" = EXCLUDED."original_key", "variants" = EXCLUDED."variants",
+    "created_at" = NOW()
+RETURNING menu_item_id, content_type, width, height, blurhash, original_key, variants, created_at
+`
+
+type SaveMenuItemPhotoParams struct {
+	MenuItemID  int16  `json:"menu_item_id"`
+	ContentType string `json:"content_type"`
+	Width       int32  `json:"width"`
+	Height      int32  `json:"height"`
+	Blurhash    string `json:"blurhash"`
+	OriginalKey string `json:"original_key"`
+	Variants    []byte `json:"variants"`
+}
+
+func (q *Queries) SaveMenuItemPhoto(ctx context.Context, arg SaveMenuItemPhotoParams) (MenuItemPhoto, error) {
+	row := q.db.QueryRow(ctx, saveMenuItemPhoto,
+		arg.MenuItemID,
+		arg.ContentType,
+		arg.Width,
+		arg.Height,
+		arg.Blurhash,
+		arg.OriginalKey,
+		arg.Variants,
+	)
+	var i MenuItemPhoto
+	err := row.Scan(
+		&i.MenuItemID,
+		&i.ContentType,
+		&i.Width,
+		&i.Height,
+		&i.Blurhash,
+		&i.OriginalKey,
+		&i.Variants,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const sendOrder = `-- name: SendOrder :exec
 UPDATE "order" SET "sent_at" = NOW() WHERE "tab_id" = $1 AND "scoped_id" = $2
 `
@@ -795,6 +2140,54 @@ func (q *Queries) SendOrder(ctx context.Context, arg SendOrderParams) error {
 	return err
 }
 
+const setCustomerNameIfEmpty = `-- name: SetCustomerNameIfEmpty :exec
+UPDATE "customer" SET "name" = $2, "updated_at" = NOW() WHERE "id" = $1 AND "name" = '' AND "deleted_at" IS NULL
+`
+
+type SetCustomerNameIfEmptyParams struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func (q *Queries) SetCustomerNameIfEmpty(ctx context.Context, arg SetCustomerNameIfEmptyParams) error {
+	_, err := q.db.Exec(ctx, setCustomerNameIfEmpty, arg.ID, arg.Name)
+	return err
+}
+
+const setMenuItemAvailabilityOverride = `-- name: SetMenuItemAvailabilityOverride :one
+UPDATE "menu_item" SET "availability_override" = $2, "availability_override_until" = $3, "updated_at" = NOW()
+WHERE "id" = $1 AND "deleted_at" IS NULL
+RETURNING id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at, external_key, availability_override, availability_override_until
+`
+
+type SetMenuItemAvailabilityOverrideParams struct {
+	ID                        int16            `json:"id"`
+	AvailabilityOverride      pgtype.Bool      `json:"availability_override"`
+	AvailabilityOverrideUntil pgtype.Timestamp `json:"availability_override_until"`
+}
+
+func (q *Queries) SetMenuItemAvailabilityOverride(ctx context.Context, arg SetMenuItemAvailabilityOverrideParams) (MenuItem, error) {
+	row := q.db.QueryRow(ctx, setMenuItemAvailabilityOverride, arg.ID, arg.AvailabilityOverride, arg.AvailabilityOverrideUntil)
+	var i MenuItem
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.PhotoPathinfo,
+		&i.Price,
+		&i.PortionSize,
+		&i.Available,
+		&i.ModifiersConfig,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ExternalKey,
+		&i.AvailabilityOverride,
+		&i.AvailabilityOverrideUntil,
+	)
+	return i, err
+}
+
 const softDeleteMenuItem = `-- name: SoftDeleteMenuItem :exec
 UPDATE "menu_item" SET "deleted_at" = COALESCE("deleted_at", NOW()) WHERE "id" = $1
 `
@@ -804,14 +2197,49 @@ func (q *Queries) SoftDeleteMenuItem(ctx context.Context, id int16) error {
 	return err
 }
 
+const touchCustomerIdentity = `-- name: TouchCustomerIdentity :exec
+UPDATE "customer_identity" SET "last_login_at" = NOW() WHERE "issuer" = $1 AND "subject" = $2
+`
+
+type TouchCustomerIdentityParams struct {
+	Issuer  string `json:"issuer"`
+	Subject string `json:"subject"`
+}
+
+func (q *Queries) TouchCustomerIdentity(ctx context.Context, arg TouchCustomerIdentityParams) error {
+	_, err := q.db.Exec(ctx, touchCustomerIdentity, arg.Issuer, arg.Subject)
+	return err
+}
+
+const transferOrderItemsGuestOwner = `-- name: TransferOrderItemsGuestOwner :exec
+UPDATE "order_item" SET
+    "guest_owners" = array_remove("guest_owners", $2::SMALLINT),
+    "customer_owners" = CASE
+        WHEN $3::UUID = ANY("customer_owners") THEN "customer_owners"
+        ELSE array_append("customer_owners", $3::UUID)
+    END
+WHERE "tab_id" = $1 AND $2::SMALLINT = ANY("guest_owners")
+`
+
+type TransferOrderItemsGuestOwnerParams struct {
+	TabID      uuid.UUID `json:"tab_id"`
+	GuestID    int16     `json:"guest_id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+}
+
+func (q *Queries) TransferOrderItemsGuestOwner(ctx context.Context, arg TransferOrderItemsGuestOwnerParams) error {
+	_, err := q.db.Exec(ctx, transferOrderItemsGuestOwner, arg.TabID, arg.GuestID, arg.CustomerID)
+	return err
+}
+
 const updateCustomerEmail = `-- name: UpdateCustomerEmail :one
-UPDATE "customer" SET "email" = $2 WHERE "id" = $1
-RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at
+UPDATE "customer" SET "email" = $2, "email_verified_at" = NULL, "updated_at" = NOW() WHERE "id" = $1 AND "deleted_at" IS NULL
+RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at
 `
 
 type UpdateCustomerEmailParams struct {
-	ID    uuid.UUID `json:"id"`
-	Email string    `json:"email"`
+	ID    uuid.UUID   `json:"id"`
+	Email pgtype.Text `json:"email"`
 }
 
 func (q *Queries) UpdateCustomerEmail(ctx context.Context, arg UpdateCustomerEmailParams) (Customer, error) {
@@ -826,13 +2254,15 @@ func (q *Queries) UpdateCustomerEmail(ctx context.Context, arg UpdateCustomerEma
 		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const updateCustomerInfo = `-- name: UpdateCustomerInfo :one
-UPDATE "customer" SET "name" = $2, "phone_number" = $3 WHERE "id" = $1
-RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at
+UPDATE "customer" SET "name" = $2, "phone_number" = $3, "updated_at" = NOW() WHERE "id" = $1 AND "deleted_at" IS NULL
+RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at
 `
 
 type UpdateCustomerInfoParams struct {
@@ -853,18 +2283,20 @@ func (q *Queries) UpdateCustomerInfo(ctx context.Context, arg UpdateCustomerInfo
 		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const updateCustomerLoginID = `-- name: UpdateCustomerLoginID :one
-UPDATE "customer" SET "login_id" = $2 WHERE "id" = $1
-RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at
+UPDATE "customer" SET "login_id" = $2, "updated_at" = NOW() WHERE "id" = $1 AND "deleted_at" IS NULL
+RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at
 `
 
 type UpdateCustomerLoginIDParams struct {
-	ID      uuid.UUID `json:"id"`
-	LoginID string    `json:"login_id"`
+	ID      uuid.UUID   `json:"id"`
+	LoginID pgtype.Text `json:"login_id"`
 }
 
 func (q *Queries) UpdateCustomerLoginID(ctx context.Context, arg UpdateCustomerLoginIDParams) (Customer, error) {
@@ -879,13 +2311,15 @@ func (q *Queries) UpdateCustomerLoginID(ctx context.Context, arg UpdateCustomerL
 		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const updateCustomerPassword = `-- name: UpdateCustomerPassword :one
-UPDATE "customer" SET "password_hash" = $2 WHERE "id" = $1
-RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at
+UPDATE "customer" SET "password_hash" = $2, "updated_at" = NOW() WHERE "id" = $1 AND "deleted_at" IS NULL
+RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at
 `
 
 type UpdateCustomerPasswordParams struct {
@@ -905,6 +2339,8 @@ func (q *Queries) UpdateCustomerPassword(ctx context.Context, arg UpdateCustomer
 		&i.PhoneNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
@@ -929,10 +2365,42 @@ func (q *Queries) UpdateGuestName(ctx context.Context, arg UpdateGuestNameParams
 	return err
 }
 
+const updateMenu = `-- name: UpdateMenu :one
+UPDATE "menu" SET "name" = $2, "description" = $3, "timezone" = $4, "updated_at" = NOW()
+WHERE "id" = $1
+RETURNING id, name, description, timezone, created_at, updated_at
+`
+
+type UpdateMenuParams struct {
+	ID          int16       `json:"id"`
+	Name        string      `json:"name"`
+	Description pgtype.Text `json:"description"`
+	Timezone    string      `json:"timezone"`
+}
+
+func (q *Queries) UpdateMenu(ctx context.Context, arg UpdateMenuParams) (Menu, error) {
+	row := q.db.QueryRow(ctx, updateMenu,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.Timezone,
+	)
+	var i Menu
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Timezone,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateMenuItem = `-- name: UpdateMenuItem :one
 UPDATE "menu_item" SET "name" = $2, "description" = $3, "photo_pathinfo" = $4, "price" = $5, "portion_size" = $6, "available" = $7, "modifiers_config" = $8, "updated_at" = NOW()
 WHERE "id" = $1
-RETURNING id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at
+RETURNING id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at, external_key, availability_override, availability_override_until
 `
 
 type UpdateMenuItemParams struct {
@@ -970,10 +2438,27 @@ func (q *Queries) UpdateMenuItem(ctx context.Context, arg UpdateMenuItemParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ExternalKey,
+		&i.AvailabilityOverride,
+		&i.AvailabilityOverrideUntil,
 	)
 	return i, err
 }
 
+const updateMenuItemPhotoPathinfo = `-- name: UpdateMenuItemPhotoPathinfo :exec
+UPDATE "menu_item" SET "photo_pathinfo" = $2, "updated_at" = NOW() WHERE "id" = $1
+`
+
+type UpdateMenuItemPhotoPathinfoParams struct {
+	ID            int16       `json:"id"`
+	PhotoPathinfo pgtype.Text `json:"photo_pathinfo"`
+}
+
+func (q *Queries) UpdateMenuItemPhotoPathinfo(ctx context.Context, arg UpdateMenuItemPhotoPathinfoParams) error {
+	_, err := q.db.Exec(ctx, updateMenuItemPhotoPathinfo, arg.ID, arg.PhotoPathinfo)
+	return err
+}
+
 const updateOrderItemModifiers = `-- name: UpdateOrderItemModifiers :exec
 UPDATE "order_item" SET "modifiers" = $4
 WHERE "tab_id" = $1 AND "order_id" = $2 AND "scoped_id" = $3
@@ -1034,6 +2519,173 @@ func (q *Queries) UpdateTabTotalPrice(ctx context.Context, tabID uuid.UUID) erro
 	return err
 }
 
+const upsertMenuItem = `-- name: UpsertMenuItem :one
+INSERT INTO "menu_item" ("external_key", "name", "description", "photo_pathinfo", "price", "portion_size", "available", "modifiers_config")
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT ("external_key") DO UPDATE
+SET "name" = EXCLUDED."name", "description" = EXCLUDED."description", "photo_pathinfo" = EXCLUDED."photo_pathinfo",
+    "price" = EXCLUDED."price", "portion_size" = EXCLUDED."portion_size", "available" = EXCLUDED."available",
+    "modifiers_config" = EXCLUDED."modifiers_config", "deleted_at" = NULL, "updated_at" = NOW()
+RETURNING id, name, description, photo_pathinfo, price, portion_size, available, modifiers_config, created_at, updated_at, deleted_at, external_key, availability_override, availability_override_until
+`
+
+type UpsertMenuItemParams struct {
+	ExternalKey     string      `json:"external_key"`
+	Name            string      `json:"name"`
+	Description     pgtype.Text `json:"description"`
+	PhotoPathinfo   pgtype.Text `json:"photo_pathinfo"`
+	Price           int32       `json:"price"`
+	PortionSize     int16       `json:"portion_size"`
+	Available       bool        `json:"available"`
+	ModifiersConfig []byte      `json:"modifiers_config"`
+}
+
+func (q *Queries) UpsertMenuItem(ctx context.Context, arg UpsertMenuItemParams) (MenuItem, error) {
+	row := q.db.QueryRow(ctx, upsertMenuItem,
+		arg.ExternalKey,
+		arg.Name,
+		arg.Description,
+		arg.PhotoPathinfo,
+		arg.Price,
+		arg.PortionSize,
+		arg.Available,
+		arg.ModifiersConfig,
+	)
+	var i MenuItem
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.PhotoPathinfo,
+		&i.Price,
+		&i.PortionSize,
+		&i.Available,
+		&i.ModifiersConfig,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ExternalKey,
+		&i.AvailabilityOverride,
+		&i.AvailabilityOverrideUntil,
+	)
+	return i, err
+}
+
+const upsertMenuTag = `-- name: UpsertMenuTag :one
+INSERT INTO "menu_tag" ("external_key", "value", "description", "dimension")
+VALUES ($1, $2, $3, $4)
+ON CONFLICT ("external_key") DO UPDATE
+SET "value" = EXCLUDED."value", "description" = EXCLUDED."description", "dimension" = EXCLUDED."dimension", "updated_at" = NOW()
+RETURNING id, value, description, dimension, created_at, updated_at, external_key
+`
+
+type UpsertMenuTagParams struct {
+	ExternalKey string      `json:"external_key"`
+	Value       string      `json:"value"`
+	Description pgtype.Text `json:"description"`
+	Dimension   pgtype.Int2 `json:"dimension"`
+}
+
+func (q *Queries) UpsertMenuTag(ctx context.Context, arg UpsertMenuTagParams) (MenuTag, error) {
+	row := q.db.QueryRow(ctx, upsertMenuTag,
+		arg.ExternalKey,
+		arg.Value,
+		arg.Description,
+		arg.Dimension,
+	)
+	var i MenuTag
+	err := row.Scan(
+		&i.ID,
+		&i.Value,
+		&i.Description,
+		&i.Dimension,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExternalKey,
+	)
+	return i, err
+}
+
+const upsertMenuTagDimension = `-- name: UpsertMenuTagDimension :one
+INSERT INTO "menu_tag_dimension" ("external_key", "value", "description")
+VALUES ($1, $2, $3)
+ON CONFLICT ("external_key") DO UPDATE
+SET "value" = EXCLUDED."value", "description" = EXCLUDED."description", "updated_at" = NOW()
+RETURNING id, value, description, created_at, updated_at, external_key
+`
+
+type UpsertMenuTagDimensionParams struct {
+	ExternalKey string      `json:"external_key"`
+	Value       string      `json:"value"`
+	Description pgtype.Text `json:"description"`
+}
+
+func (q *Queries) UpsertMenuTagDimension(ctx context.Context, arg UpsertMenuTagDimensionParams) (MenuTagDimension, error) {
+	row := q.db.QueryRow(ctx, upsertMenuTagDimension, arg.ExternalKey, arg.Value, arg.Description)
+	var i MenuTagDimension
+	err := row.Scan(
+		&i.ID,
+		&i.Value,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExternalKey,
+	)
+	return i, err
+}
+
+const useCustomerToken = `-- name: UseCustomerToken :one
+UPDATE "customer_token" SET "used_at" = NOW()
+WHERE "token_hash" = $1 AND "purpose" = $2 AND "used_at" IS NULL AND "expires_at" > NOW()
+RETURNING "customer_id", "email"
+`
+
+type UseCustomerTokenParams struct {
+	TokenHash []byte `json:"token_hash"`
+	Purpose   string `json:"purpose"`
+}
+
+type UseCustomerTokenRow struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	Email      string    `json:"email"`
+}
+
+func (q *Queries) UseCustomerToken(ctx context.Context, arg UseCustomerTokenParams) (UseCustomerTokenRow, error) {
+	row := q.db.QueryRow(ctx, useCustomerToken, arg.TokenHash, arg.Purpose)
+	var i UseCustomerTokenRow
+	err := row.Scan(&i.CustomerID, &i.Email)
+	return i, err
+}
+
+const verifyCustomerEmail = `-- name: VerifyCustomerEmail :one
+UPDATE "customer" SET "email_verified_at" = COALESCE("email_verified_at", NOW()), "updated_at" = NOW()
+WHERE "id" = $1 AND "email" = $2 AND "deleted_at" IS NULL
+RETURNING id, login_id, email, password_hash, name, phone_number, created_at, updated_at, deleted_at, email_verified_at
+`
+
+type VerifyCustomerEmailParams struct {
+	ID    uuid.UUID   `json:"id"`
+	Email pgtype.Text `json:"email"`
+}
+
+func (q *Queries) VerifyCustomerEmail(ctx context.Context, arg VerifyCustomerEmailParams) (Customer, error) {
+	row := q.db.QueryRow(ctx, verifyCustomerEmail, arg.ID, arg.Email)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.LoginID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Name,
+		&i.PhoneNumber,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
 const visitTab = `-- name: VisitTab :exec
 INSERT INTO "visitation" ("tab_id", "customer_id")
 VALUES ($1, $2)
@@ -0,0 +1,74 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errConflict = errors.New("conflict")
+
+func isConflict(err error) bool {
+	return errors.Is(err, errConflict)
+}
+
+func TestDoRetriesUntilSuccess(t *testing.T) {
+	var calls int
+	err := Do(t.Context(), Policy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}, isConflict, func() error {
+		calls++
+		if calls < 3 {
+			return errConflict
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestDoStopsAfterMaxAttempts(t *testing.T) {
+	var calls int
+	err := Do(t.Context(), Policy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}, isConflict, func() error {
+		calls++
+		return errConflict
+	})
+	require.ErrorIs(t, err, errConflict)
+	require.Equal(t, 4, calls)
+}
+
+func TestDoDoesNotRetryOtherErrors(t *testing.T) {
+	other := errors.New("other")
+	var calls int
+	err := Do(t.Context(), DefaultPolicy, isConflict, func() error {
+		calls++
+		return other
+	})
+	require.ErrorIs(t, err, other)
+	require.Equal(t, 1, calls)
+}
+
+func TestDoRespectsContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	var calls int
+	start := time.Now()
+	err := Do(ctx, Policy{MaxAttempts: 100, BaseDelay: time.Second, MaxDelay: time.Second}, isConflict, func() error {
+		calls++
+		return errConflict
+	})
+	require.ErrorIs(t, err, errConflict)
+	require.Less(t, time.Since(start), time.Second)
+	require.GreaterOrEqual(t, calls, 1)
+}
+
+func TestBackoffIsBounded(t *testing.T) {
+	p := Policy{MaxAttempts: 10, BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	for attempt := range 64 {
+		d := p.backoff(attempt)
+		require.GreaterOrEqual(t, d, time.Duration(0))
+		require.LessOrEqual(t, d, p.MaxDelay)
+	}
+}
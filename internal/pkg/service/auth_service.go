@@ -2,43 +2,624 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sync"
+	"time"
 
 	"restaurant-ordering-system/internal/pkg/auth"
+	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/mailer"
 	"restaurant-ordering-system/internal/pkg/model"
+	"restaurant-ordering-system/internal/pkg/oidc"
 	"restaurant-ordering-system/internal/pkg/repository"
+	"restaurant-ordering-system/internal/pkg/repository/cache"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrInvalidCredentials does not tell unknown login IDs from wrong passwords
+	ErrInvalidCredentials   = status.Error(codes.Unauthenticated, "invalid login ID or password")
+	ErrInvalidToken         = status.Error(codes.InvalidArgument, "token is invalid or expired")
+	ErrEmailAlreadyVerified = status.Error(codes.FailedPrecondition, "email is already verified")
+	ErrUnknownOIDCProvider  = status.Error(codes.InvalidArgument, "unknown OIDC provider")
+	ErrInvalidOIDCState     = status.Error(codes.InvalidArgument, "OIDC login state is invalid or expired")
+	ErrOIDCLoginFailed      = status.Error(codes.Unauthenticated, "OIDC login failed")
+)
+
+// Purposes of the tokens sent to customers by email
+const (
+	tokenPurposeEmailVerification = "email_verification"
+	tokenPurposePasswordReset     = "password_reset"
+)
+
+const (
+	// maxBackgroundTasks bounds the emails being sent off the request path,
+	// beyond which more are dropped
+	maxBackgroundTasks = 100
+	// backgroundTimeout bounds every task done off the request path
+	backgroundTimeout = time.Minute
 )
 
 type AuthService struct {
-	db          *pgxpool.Pool
-	queries     *repository.Queries
-	generateJWT auth.CustomerJWTGenerator
+	db            *pgxpool.Pool
+	queries       *repository.Queries
+	rqueries      *cache.RedisQueries
+	generateJWT   auth.CustomerJWTGenerator
+	tokenTTL      time.Duration
+	mailer        mailer.Mailer
+	account       config.AccountConfig
+	oidcProviders map[string]*oidc.Provider
+
+	// background tracks the work done off the request path, up to maxBackgroundTasks at once
+	background sync.WaitGroup
+	slots      chan struct{}
 }
 
-func NewAuthService(db *pgxpool.Pool, generateJWT auth.CustomerJWTGenerator) *AuthService {
+// NewAuthService creates an AuthService. tokenTTL is the lifetime of the tokens
+// made by generateJWT, for which revoked sessions are remembered. Customers can log in
+// with the OpenID Connect providers, by name.
+func NewAuthService(db *pgxpool.Pool, rdb redis.UniversalClient, generateJWT auth.CustomerJWTGenerator, tokenTTL time.Duration, mailer mailer.Mailer, account config.AccountConfig, oidcProviders map[string]*oidc.Provider) *AuthService {
 	return &AuthService{
-		db:          db,
-		queries:     repository.New(db),
-		generateJWT: generateJWT,
+		db:            db,
+		queries:       repository.New(db),
+		rqueries:      cache.New(rdb),
+		generateJWT:   generateJWT,
+		tokenTTL:      tokenTTL,
+		mailer:        mailer,
+		account:       account,
+		oidcProviders: oidcProviders,
+		slots:         make(chan struct{}, maxBackgroundTasks),
 	}
 }
 
-func (s *AuthService) GenerateToken(ctx context.Context, loginID model.LoginID, password string) (string, error) {
-	c, err := s.queries.GetCustomerByLogin(ctx, string(loginID))
-	if err != nil {
+// TokenTTL returns the lifetime of the tokens returned by the service
+func (s *AuthService) TokenTTL() time.Duration {
+	return s.tokenTTL
+}
+
+// dummyPasswordHash is compared with the passwords of unknown login IDs, so that
+// they take as long to reject as wrong passwords
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// GenerateToken returns a token for the customer after checking their password.
+// Failed attempts are counted per login ID and per client IP, either of which is
+// locked out after too many failures, see config.LoginConfig. Logins are not throttled
+// while Redis is unreachable, but fail with ErrSessionsUnavailable as tokens carry the
+// version of the sessions of the customer kept there. clientIP may be empty.
+func (s *AuthService) GenerateToken(ctx context.Context, loginID model.LoginID, password string, clientIP string) (string, error) {
+	subjects := []string{cache.LoginIDSubject(loginID)}
+	if clientIP != "" {
+		subjects = append(subjects, cache.ClientIPSubject(clientIP))
+	}
+	lockout, err := s.rqueries.GetLoginLockout(ctx, subjects...)
+	if err != nil && !cache.IsUnavailable(err) {
 		return "", err
 	}
+	if lockout > 0 {
+		return "", loginLockedOutError(lockout)
+	}
 
+	c, err := s.queries.GetCustomerByLogin(ctx, pgtype.Text{String: string(loginID), Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+		return "", s.recordLoginFailure(ctx, loginID, clientIP)
+	}
+	if err != nil {
+		return "", err
+	}
+	if c.PasswordHash == "" {
+		// Customers without a password, logging in with an identity provider, take as
+		// long to reject as unknown login IDs
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+		return "", s.recordLoginFailure(ctx, loginID, clientIP)
+	}
 	if err := bcrypt.CompareHashAndPassword([]byte(c.PasswordHash), []byte(password)); err != nil {
+		return "", s.recordLoginFailure(ctx, loginID, clientIP)
+	}
+
+	if err := s.rqueries.ResetLoginFailures(ctx, cache.LoginIDSubject(loginID)); err != nil && !cache.IsUnavailable(err) {
+		return "", err
+	}
+	token, err := s.issueJWT(ctx, model.CustomerID(c.ID))
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// recordLoginFailure counts a failed login, returning the error for the client.
+// Failures are not counted while Redis is unreachable.
+func (s *AuthService) recordLoginFailure(ctx context.Context, loginID model.LoginID, clientIP string) error {
+	if err := s.recordLoginSubjectFailure(ctx, cache.LoginIDSubject(loginID), s.account.Login.MaxFailuresPerLogin); err != nil {
+		return err
+	}
+	if clientIP != "" {
+		if err := s.recordLoginSubjectFailure(ctx, cache.ClientIPSubject(clientIP), s.account.Login.MaxFailuresPerIP); err != nil {
+			return err
+		}
+	}
+	return ErrInvalidCredentials
+}
+
+func (s *AuthService) recordLoginSubjectFailure(ctx context.Context, subject string, maxFailures int) error {
+	_, err := s.rqueries.RecordLoginFailure(ctx, subject, cache.LoginLockoutPolicy{
+		MaxFailures: maxFailures,
+		Window:      s.account.Login.FailureWindow,
+		Lockout:     s.account.Login.Lockout,
+		MaxLockout:  s.account.Login.MaxLockout,
+	})
+	if err != nil && !cache.IsUnavailable(err) {
+		return err
+	}
+	return nil
+}
+
+func loginLockedOutError(lockout time.Duration) error {
+	seconds := (lockout + time.Second - 1) / time.Second
+	return status.Errorf(codes.ResourceExhausted, "too many failed login attempts, retry in %ds", seconds)
+}
+
+// StartOIDCLogin returns the URL of the provider where the customer logs in. The
+// provider redirects them to account.oidc.redirectURL with the code and state
+// completing the login. The login is bound to the browser starting it by the
+// returned binding, which it must keep out of reach of scripts, e.g. in an HttpOnly cookie.
+func (s *AuthService) StartOIDCLogin(ctx context.Context, providerName string) (authURL, binding string, err error) {
+	provider, ok := s.oidcProviders[providerName]
+	if !ok {
+		return "", "", ErrUnknownOIDCProvider
+	}
+
+	state := rand.Text()
+	binding = rand.Text()
+	login := cache.OIDCLogin{
+		Provider:     providerName,
+		Nonce:        rand.Text(),
+		CodeVerifier: oauth2.GenerateVerifier(),
+		BindingHash:  hashToken(binding),
+	}
+	authURL, err = provider.AuthCodeURL(ctx, state, login.Nonce, login.CodeVerifier)
+	if err != nil {
+		return "", "", err
+	}
+	if err := s.rqueries.SaveOIDCLogin(ctx, state, login, s.account.OIDC.StateTTL); err != nil {
+		return "", "", err
+	}
+	return authURL, binding, nil
+}
+
+// CompleteOIDCLogin returns a token for the customer logged in with the provider,
+// see linkOIDCIdentity. Every state completes a single login, in the browser which
+// started it and gives back its binding: a victim lured into completing the login
+// of an attacker would be logged in as the attacker otherwise.
+func (s *AuthService) CompleteOIDCLogin(ctx context.Context, state, code, binding string) (string, error) {
+	login, err := s.rqueries.TakeOIDCLogin(ctx, state)
+	if err != nil {
+		return "", err
+	}
+	if login == nil || subtle.ConstantTimeCompare(login.BindingHash, hashToken(binding)) != 1 {
+		return "", ErrInvalidOIDCState
+	}
+	provider, ok := s.oidcProviders[login.Provider]
+	if !ok {
+		return "", ErrInvalidOIDCState // The provider was removed since
+	}
+
+	identity, err := provider.Exchange(ctx, code, login.CodeVerifier, login.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrLoginFailed) {
+			return "", ErrOIDCLoginFailed
+		}
+		return "", err
+	}
+	id, err := s.linkOIDCIdentity(ctx, identity)
+	if err != nil {
+		return "", err
+	}
+	return s.issueJWT(ctx, id)
+}
+
+// linkOIDCIdentity returns the customer of the identity. Unknown identities are linked
+// to the customer with the same email if the provider verified it, or to a new
+// customer without login ID nor password otherwise. Customers who never verified
+// their email lose their password and sessions when linked, as whoever registered
+// the email may not own it.
+func (s *AuthService) linkOIDCIdentity(ctx context.Context, identity *oidc.Identity) (model.CustomerID, error) {
+	id, err := s.tryLinkOIDCIdentity(ctx, identity)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		// A concurrent first login with the identity linked it, or created its customer,
+		// first. Looking it up again finds the identity it linked.
+		return s.tryLinkOIDCIdentity(ctx, identity)
+	}
+	return id, err
+}
+
+func (s *AuthService) tryLinkOIDCIdentity(ctx context.Context, identity *oidc.Identity) (model.CustomerID, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return model.CustomerID{}, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	c, err := qtx.GetCustomerByIdentity(ctx, repository.GetCustomerByIdentityParams{
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+	})
+	if err == nil {
+		if err := qtx.TouchCustomerIdentity(ctx, repository.TouchCustomerIdentityParams{
+			Issuer:  identity.Issuer,
+			Subject: identity.Subject,
+		}); err != nil {
+			return model.CustomerID{}, err
+		}
+		return model.CustomerID(c.ID), tx.Commit(ctx)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return model.CustomerID{}, err
+	}
+
+	// Emails not verified by the provider could take over the account of their owner
+	email := pgtype.Text{String: identity.Email, Valid: identity.EmailVerified && validEmail(identity.Email)}
+	linked := false
+	if email.Valid {
+		c, err = qtx.GetCustomerByEmail(ctx, email)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return model.CustomerID{}, err
+		}
+		linked = err == nil
+	}
+	if linked && !c.EmailVerifiedAt.Valid {
+		if c, err = qtx.UpdateCustomerPassword(ctx, repository.UpdateCustomerPasswordParams{ID: c.ID}); err != nil {
+			return model.CustomerID{}, err
+		}
+		// Revoked before committing, so that a failure leaves the password in place to retry
+		if err := s.revokeSessions(ctx, model.CustomerID(c.ID)); err != nil {
+			return model.CustomerID{}, err
+		}
+	}
+	if !linked {
+		if c, err = qtx.CreateCustomer(ctx, repository.CreateCustomerParams{
+			Email: email,
+			Name:  identity.Name,
+		}); err != nil {
+			return model.CustomerID{}, err
+		}
+	}
+	if email.Valid {
+		if _, err := qtx.VerifyCustomerEmail(ctx, repository.VerifyCustomerEmailParams{
+			ID:    c.ID,
+			Email: email,
+		}); err != nil {
+			return model.CustomerID{}, err
+		}
+	}
+	if err := qtx.CreateCustomerIdentity(ctx, repository.CreateCustomerIdentityParams{
+		Issuer:     identity.Issuer,
+		Subject:    identity.Subject,
+		CustomerID: c.ID,
+		Email:      pgtype.Text{String: identity.Email, Valid: identity.Email != ""},
+	}); err != nil {
+		return model.CustomerID{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.CustomerID{}, err
+	}
+	return model.CustomerID(c.ID), nil
+}
+
+// IsTokenRevoked reports whether the customer token was issued with a version of the
+// sessions of its customer older than the current one, see auth.RevocationChecker.
+// Revocations can not be checked while Redis is unreachable, so customer tokens are
+// rejected until it is reachable again.
+func (s *AuthService) IsTokenRevoked(ctx context.Context, claims *auth.Claims) (bool, error) {
+	if claims.Role != auth.CustomerRole {
+		return false, nil
+	}
+	customerID, err := model.ParseCustomerID(claims.Subject)
+	if err != nil {
+		return false, err
+	}
+	version, err := s.rqueries.GetCustomerSessionVersion(ctx, customerID)
+	if err != nil {
+		return false, err
+	}
+	return claims.SessionVersion < version, nil
+}
+
+// issueJWT returns a token for the customer carrying the current version of their
+// sessions, which is kept as long as the token is valid
+func (s *AuthService) issueJWT(ctx context.Context, id model.CustomerID) (string, error) {
+	version, err := s.rqueries.KeepCustomerSessionVersion(ctx, id, s.tokenTTL)
+	if err != nil {
+		if cache.IsUnavailable(err) {
+			return "", ErrSessionsUnavailable
+		}
 		return "", err
 	}
+	return s.generateJWT(id, version)
+}
+
+// revokeSessions revokes every token of the customer issued so far, the tokens
+// issued next carrying the new version of their sessions
+func (s *AuthService) revokeSessions(ctx context.Context, id model.CustomerID) error {
+	if _, err := s.rqueries.RevokeCustomerSessions(ctx, id, s.tokenTTL); err != nil {
+		if cache.IsUnavailable(err) {
+			return ErrSessionsUnavailable
+		}
+		return err
+	}
+	return nil
+}
+
+// SendEmailVerification emails a link verifying the current email of the customer,
+// replacing the links sent before
+func (s *AuthService) SendEmailVerification(ctx context.Context, id model.CustomerID) error {
+	c, err := s.queries.GetCustomerByID(ctx, uuid.UUID(id))
+	if err != nil {
+		return err
+	}
+	if c.EmailVerifiedAt.Valid {
+		return ErrEmailAlreadyVerified
+	}
+
+	token, err := s.issueCustomerToken(ctx, c, tokenPurposeEmailVerification, s.account.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      c.Email.String,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hello %s,\n\nPlease verify your email address by opening the following link:\n\n%s\n\n"+
+			"If you did not create an account, you can ignore this email.\n",
+			c.Name, tokenLink(s.account.EmailVerificationURL, token)),
+	})
+}
+
+// SendEmailChangeNotice tells the previous email of the customer c that it was
+// changed to email, so that they notice when someone else took over their account.
+// The notice is sent in the background.
+func (s *AuthService) SendEmailChangeNotice(ctx context.Context, c repository.Customer, email string) {
+	s.runInBackground(ctx, "email change notice", func(ctx context.Context) error {
+		return s.mailer.Send(ctx, mailer.Message{
+			To:      c.Email.String,
+			Subject: "Your email address was changed",
+			Body: fmt.Sprintf("Hello %s,\n\nThe email address of your account was changed to %s.\n\n"+
+				"If you did not change it, please contact us right away.\n",
+				c.Name, email),
+		})
+	})
+}
+
+// VerifyEmail marks the email the token was sent to as verified, provided it is
+// still the email of the customer
+func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	t, err := qtx.UseCustomerToken(ctx, repository.UseCustomerTokenParams{
+		TokenHash: hashToken(token),
+		Purpose:   tokenPurposeEmailVerification,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidToken
+		}
+		return err
+	}
+	if _, err := qtx.VerifyCustomerEmail(ctx, repository.VerifyCustomerEmailParams{
+		ID:    t.CustomerID,
+		Email: pgtype.Text{String: t.Email, Valid: true},
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidToken // The email was changed since
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RequestPasswordReset emails a link resetting the password of the customer with
+// the given email. Unknown emails are ignored so that they cannot be told apart, and
+// the link is sent in the background for both to take as long.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	s.runInBackground(ctx, "password reset", func(ctx context.Context) error {
+		return s.sendPasswordReset(ctx, email)
+	})
+	return nil
+}
+
+func (s *AuthService) sendPasswordReset(ctx context.Context, email string) error {
+	c, err := s.queries.GetCustomerByEmail(ctx, pgtype.Text{String: email, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := s.issueCustomerToken(ctx, c, tokenPurposePasswordReset, s.account.PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      c.Email.String,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hello %s,\n\nYou can choose a new password by opening the following link:\n\n%s\n\n"+
+			"If you did not ask to reset your password, you can ignore this email.\n",
+			c.Name, tokenLink(s.account.PasswordResetURL, token)),
+	})
+}
+
+// runInBackground runs fn off the request path, with the values but not the
+// cancellation of ctx. Failures are logged, and tasks are dropped while
+// maxBackgroundTasks are running.
+func (s *AuthService) runInBackground(ctx context.Context, name string, fn func(ctx context.Context) error) {
+	select {
+	case s.slots <- struct{}{}:
+	default:
+		slog.WarnContext(ctx, "Too many background tasks, dropping one", "task", name)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), backgroundTimeout)
+	s.background.Add(1)
+	go func() {
+		defer func() {
+			cancel()
+			<-s.slots
+			s.background.Done()
+		}()
+		if err := fn(ctx); err != nil {
+			slog.WarnContext(ctx, "Background task failed", "task", name, "error", err)
+		}
+	}()
+}
+
+// Wait waits for the work done in the background, e.g. sending emails, before shutting down
+func (s *AuthService) Wait() {
+	s.background.Wait()
+}
+
+// ResetPassword sets the password of the customer the token was sent to,
+// which also verifies their email. Every session of the customer is revoked.
+func (s *AuthService) ResetPassword(ctx context.Context, token string, newPassword []byte) error {
+	if len(newPassword) == 0 {
+		return ErrEmptyPassword
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword(newPassword, bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
 
-	token, err := s.generateJWT(model.CustomerID(c.ID))
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	t, err := qtx.UseCustomerToken(ctx, repository.UseCustomerTokenParams{
+		TokenHash: hashToken(token),
+		Purpose:   tokenPurposePasswordReset,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidToken
+		}
+		return err
+	}
+	// The customer must still own the email the token was sent to
+	c, err := qtx.VerifyCustomerEmail(ctx, repository.VerifyCustomerEmailParams{
+		ID:    t.CustomerID,
+		Email: pgtype.Text{String: t.Email, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidToken
+		}
+		return err
+	}
+	if _, err := qtx.UpdateCustomerPassword(ctx, repository.UpdateCustomerPasswordParams{
+		ID:           t.CustomerID,
+		PasswordHash: string(passwordHash),
+	}); err != nil {
+		return err
+	}
+	if err := qtx.DeleteUnusedCustomerTokens(ctx, repository.DeleteUnusedCustomerTokensParams{
+		CustomerID: t.CustomerID,
+		Purpose:    tokenPurposePasswordReset,
+	}); err != nil {
+		return err
+	}
+
+	if err := s.revokeSessions(ctx, model.CustomerID(t.CustomerID)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	// The customer proved owning the email, the lockout of their login ID is lifted
+	// on a best effort basis
+	_ = s.rqueries.ResetLoginFailures(ctx, cache.LoginIDSubject(model.LoginID(c.LoginID.String)))
+	return nil
+}
+
+// issueCustomerToken stores a new token for the current email of the customer,
+// deleting the unused tokens issued before for the same purpose
+func (s *AuthService) issueCustomerToken(ctx context.Context, c repository.Customer, purpose string, ttl time.Duration) (string, error) {
+	token := rand.Text()
+
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
 		return "", err
 	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
 
+	if err := qtx.DeleteUnusedCustomerTokens(ctx, repository.DeleteUnusedCustomerTokensParams{
+		CustomerID: c.ID,
+		Purpose:    purpose,
+	}); err != nil {
+		return "", err
+	}
+	if err := qtx.CreateCustomerToken(ctx, repository.CreateCustomerTokenParams{
+		TokenHash:  hashToken(token),
+		CustomerID: c.ID,
+		Purpose:    purpose,
+		Email:      c.Email.String,
+		TtlSeconds: int32(ttl / time.Second),
+	}); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", err
+	}
 	return token, nil
 }
+
+// hashToken hashes a token for storage. Tokens are random, so they do
+// not need a slow password hash.
+func hashToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
+
+// tokenLink adds the token as query parameter of the link
+func tokenLink(link, token string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return link + "?token=" + url.QueryEscape(token)
+	}
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/mailer"
+	"restaurant-ordering-system/internal/pkg/model"
+	"restaurant-ordering-system/internal/pkg/oidc"
+	"restaurant-ordering-system/internal/pkg/oidc/oidctest"
+)
+
+// testMailer records the messages sent
+type testMailer struct {
+	mu       sync.Mutex
+	messages []mailer.Message
+}
+
+func (m *testMailer) Send(ctx context.Context, msg mailer.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// lastToken returns the token linked by the last message sent to the address
+func (m *testMailer) lastToken(t *testing.T, to string) string {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].To != to {
+			continue
+		}
+		for _, field := range strings.Fields(m.messages[i].Body) {
+			if u, err := url.Parse(field); err == nil && u.Query().Has("token") {
+				return u.Query().Get("token")
+			}
+		}
+	}
+	t.Fatalf("no token sent to %s", to)
+	return ""
+}
+
+func newTestAuthService(db *pgxpool.Pool, rdb redis.UniversalClient, generateJWT auth.CustomerJWTGenerator, mail mailer.Mailer) *AuthService {
+	return NewAuthService(db, rdb, generateJWT, time.Hour, mail, config.AccountConfig{
+		EmailVerificationURL: "https://example.com/verify-email",
+		EmailVerificationTTL: time.Hour,
+		PasswordResetURL:     "https://example.com/reset-password",
+		PasswordResetTTL:     time.Hour,
+		Login: config.LoginConfig{
+			MaxFailuresPerLogin: 3,
+			MaxFailuresPerIP:    5,
+			FailureWindow:       time.Minute,
+			Lockout:             time.Minute,
+			MaxLockout:          time.Hour,
+		},
+		OIDC: config.OIDCConfig{
+			RedirectURL:  "https://example.com/oidc/callback",
+			StateTTL:     time.Minute,
+			ReauthWindow: time.Minute,
+		},
+	}, nil)
+}
+
+func TestAuthServiceIsTokenRevokedWithoutRedis(t *testing.T) {
+	authService := newTestAuthService(nil, newUnreachableRedis(t), nil, &testMailer{})
+
+	claims := &auth.Claims{Role: auth.CustomerRole, RegisteredClaims: jwt.RegisteredClaims{Subject: uuid.NewString()}}
+	_, err := authService.IsTokenRevoked(t.Context(), claims)
+	require.Error(t, err)
+	revoked, err := authService.IsTokenRevoked(t.Context(), &auth.Claims{Role: auth.AdminRole})
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func TestAuthServiceLoginLockout(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	authService := newTestAuthService(db, rdb, auth.NewCustomerJWTGenerator(auth.NewHMACKeyring([]byte("secret")), time.Hour), &testMailer{})
+	customerService := NewCustomerService(db, rdb, NewCacheService(db, rdb), authService)
+	_, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("alice-password"), Name: "Alice",
+	})
+	require.NoError(t, err)
+
+	// Unknown login IDs and wrong passwords are not told apart
+	_, err = authService.GenerateToken(ctx, "nobody", "password", "192.0.2.1")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = authService.GenerateToken(ctx, "alice", "wrong-password", "192.0.2.1")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	// A success resets the failures of the login ID
+	_, err = authService.GenerateToken(ctx, "alice", "alice-password", "192.0.2.1")
+	require.NoError(t, err)
+	for range 3 {
+		_, err = authService.GenerateToken(ctx, "alice", "wrong-password", "192.0.2.2")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+	}
+	_, err = authService.GenerateToken(ctx, "alice", "alice-password", "192.0.2.3")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The IP which failed 5 times is locked out for every login ID
+	for _, loginID := range []model.LoginID{"bob", "carol", "erin"} {
+		_, err = authService.GenerateToken(ctx, loginID, "password", "192.0.2.1")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+	}
+	_, err = authService.GenerateToken(ctx, "dave", "password", "192.0.2.1")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = authService.GenerateToken(ctx, "dave", "password", "192.0.2.4")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestAuthServiceEmailVerificationAndPasswordReset(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	mail := &testMailer{}
+	authService := newTestAuthService(db, rdb, auth.NewCustomerJWTGenerator(auth.NewHMACKeyring([]byte("secret")), time.Hour), mail)
+	customerService := NewCustomerService(db, rdb, NewCacheService(db, rdb), authService)
+
+	_, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "Alice <alice@example.com>", Password: []byte("alice-password"), Name: "Alice",
+	})
+	require.ErrorIs(t, err, ErrInvalidEmail)
+	alice, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("alice-password"), Name: "Alice",
+	})
+	require.NoError(t, err)
+	require.False(t, alice.EmailVerified)
+
+	// Only the last verification link is valid, the first one being sent in the background
+	authService.Wait()
+	firstToken := mail.lastToken(t, "alice@example.com")
+	require.NoError(t, authService.SendEmailVerification(ctx, alice.ID))
+	token := mail.lastToken(t, "alice@example.com")
+	require.ErrorIs(t, authService.VerifyEmail(ctx, firstToken), ErrInvalidToken)
+	require.NoError(t, authService.VerifyEmail(ctx, token))
+	require.ErrorIs(t, authService.VerifyEmail(ctx, token), ErrInvalidToken)
+	verified, err := customerService.GetCustomerByID(ctx, alice.ID)
+	require.NoError(t, err)
+	require.True(t, verified.EmailVerified)
+	require.ErrorIs(t, authService.SendEmailVerification(ctx, alice.ID), ErrEmailAlreadyVerified)
+
+	// Changing the email takes the password, and tells the previous email
+	_, err = customerService.ChangeEmail(ctx, alice.ID, "alice@example.org", []byte("wrong-password"))
+	require.ErrorIs(t, err, ErrWrongPassword)
+	changed, err := customerService.ChangeEmail(ctx, alice.ID, "alice@example.org", []byte("alice-password"))
+	require.NoError(t, err)
+	require.False(t, changed.EmailVerified)
+	authService.Wait()
+	require.True(t, slices.ContainsFunc(mail.messages, func(msg mailer.Message) bool {
+		return msg.To == "alice@example.com" && strings.Contains(msg.Body, "alice@example.org")
+	}))
+
+	// Links sent to a previous email are invalidated by changing it
+	require.NoError(t, authService.RequestPasswordReset(ctx, "alice@example.org"))
+	authService.Wait()
+	staleToken := mail.lastToken(t, "alice@example.org")
+	_, err = customerService.ChangeEmail(ctx, alice.ID, "alice@example.net", []byte("alice-password"))
+	require.NoError(t, err)
+	authService.Wait()
+	require.ErrorIs(t, authService.ResetPassword(ctx, staleToken, []byte("new-password")), ErrInvalidToken)
+
+	// Unknown emails are not revealed
+	sent := len(mail.messages)
+	require.NoError(t, authService.RequestPasswordReset(ctx, "nobody@example.com"))
+	authService.Wait()
+	require.Len(t, mail.messages, sent)
+
+	// Resetting the password verifies the email and revokes the sessions
+	oldClaims := &auth.Claims{
+		Role:             auth.CustomerRole,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: alice.ID.String(), IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+	}
+	require.NoError(t, authService.RequestPasswordReset(ctx, "alice@example.net"))
+	authService.Wait()
+	token = mail.lastToken(t, "alice@example.net")
+	require.ErrorIs(t, authService.ResetPassword(ctx, token, nil), ErrEmptyPassword)
+	require.NoError(t, authService.ResetPassword(ctx, token, []byte("new-password")))
+	require.ErrorIs(t, authService.ResetPassword(ctx, token, []byte("other-password")), ErrInvalidToken)
+
+	revoked, err := authService.IsTokenRevoked(ctx, oldClaims)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	_, err = authService.GenerateToken(ctx, "alice", "new-password", "")
+	require.NoError(t, err)
+	verified, err = customerService.GetCustomerByID(ctx, alice.ID)
+	require.NoError(t, err)
+	require.True(t, verified.EmailVerified)
+}
+
+func TestAuthServiceOIDCLogin(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	provider := oidctest.NewServer()
+	defer provider.Close()
+	keyring := auth.NewHMACKeyring([]byte("secret"))
+	mail := &testMailer{}
+	authService := newTestAuthService(db, rdb, auth.NewCustomerJWTGenerator(keyring, time.Hour), mail)
+	authService.oidcProviders = oidc.NewProviders(config.OIDCConfig{
+		RedirectURL: "https://example.com/oidc/callback",
+		Providers:   []config.OIDCProviderConfig{provider.Config("fake")},
+	}, http.DefaultClient)
+	customerService := NewCustomerService(db, rdb, NewCacheService(db, rdb), authService)
+
+	bob, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "bob", Email: "bob@example.com", Password: []byte("bob-password"), Name: "Bob",
+	})
+	require.NoError(t, err)
+	authService.Wait()
+	require.NoError(t, authService.VerifyEmail(ctx, mail.lastToken(t, "bob@example.com")))
+	// Registered by someone else with the email of Carol, never verified
+	squatter, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "squatter", Email: "carol@example.com", Password: []byte("squatter-password"),
+	})
+	require.NoError(t, err)
+	squatterClaims := &auth.Claims{
+		Role:             auth.CustomerRole,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: squatter.ID.String(), IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+	}
+
+	login := func(user oidctest.User) (model.CustomerID, error) {
+		provider.SetUser(user)
+		authURL, binding, err := authService.StartOIDCLogin(ctx, "fake")
+		require.NoError(t, err)
+		code, state, err := provider.Authorize(authURL)
+		require.NoError(t, err)
+		token, err := authService.CompleteOIDCLogin(ctx, state, code, binding)
+		if err != nil {
+			return model.CustomerID{}, err
+		}
+		claims, err := keyring.Parse(token)
+		require.NoError(t, err)
+		return model.ParseCustomerID(claims.Subject)
+	}
+
+	// New identities create customers, found again on later logins
+	alice := oidctest.User{Subject: "alice", Email: "alice@example.com", EmailVerified: true, Name: "Alice"}
+	aliceID, err := login(alice)
+	require.NoError(t, err)
+	customer, err := customerService.GetCustomerByID(ctx, aliceID)
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", customer.Email)
+	require.True(t, customer.EmailVerified)
+	require.Empty(t, customer.LoginID)
+	require.Equal(t, "Alice", customer.Name)
+	id, err := login(alice)
+	require.NoError(t, err)
+	require.Equal(t, aliceID, id)
+
+	// Identities with the verified email of a customer are linked to them
+	id, err = login(oidctest.User{Subject: "bob", Email: "bob@example.com", EmailVerified: true, Name: "Bobby"})
+	require.NoError(t, err)
+	require.Equal(t, bob.ID, id)
+	customer, err = customerService.GetCustomerByID(ctx, bob.ID)
+	require.NoError(t, err)
+	require.True(t, customer.EmailVerified)
+	require.Equal(t, "Bob", customer.Name)
+	_, err = authService.GenerateToken(ctx, "bob", "bob-password", "")
+	require.NoError(t, err)
+
+	// Customers who never verified their email lose their password and sessions
+	id, err = login(oidctest.User{Subject: "carol", Email: "carol@example.com", EmailVerified: true, Name: "Carol"})
+	require.NoError(t, err)
+	require.Equal(t, squatter.ID, id)
+	customer, err = customerService.GetCustomerByID(ctx, squatter.ID)
+	require.NoError(t, err)
+	require.True(t, customer.EmailVerified)
+	_, err = authService.GenerateToken(ctx, "squatter", "squatter-password", "")
+	require.Error(t, err)
+	revoked, err := authService.IsTokenRevoked(ctx, squatterClaims)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// Unverified emails are neither linked nor kept
+	id, err = login(oidctest.User{Subject: "mallory", Email: "bob@example.com", Name: "Mallory"})
+	require.NoError(t, err)
+	require.NotEqual(t, bob.ID, id)
+	customer, err = customerService.GetCustomerByID(ctx, id)
+	require.NoError(t, err)
+	require.Empty(t, customer.Email)
+
+	// Customers without a password reauthenticate with a recent login instead
+	_, err = customerService.ChangePassword(ctx, aliceID, nil, []byte("alice-password"))
+	require.NoError(t, err)
+	_, err = customerService.ChangePassword(ctx, aliceID, nil, []byte("other-password"))
+	require.ErrorIs(t, err, ErrWrongPassword)
+	_, err = db.Exec(ctx, `UPDATE "customer_identity" SET "last_login_at" = NOW() - INTERVAL '1 hour' WHERE "subject" = 'mallory'`)
+	require.NoError(t, err)
+	require.ErrorIs(t, customerService.DeleteCustomer(ctx, id, nil), ErrReauthenticationRequired)
+	_, err = login(oidctest.User{Subject: "mallory", Email: "bob@example.com", Name: "Mallory"})
+	require.NoError(t, err)
+	require.NoError(t, customerService.DeleteCustomer(ctx, id, nil))
+
+	export, err := customerService.ExportCustomerData(ctx, bob.ID)
+	require.NoError(t, err)
+	require.Len(t, export.Identities, 1)
+	require.Equal(t, "bob", export.Identities[0].Subject)
+
+	// States are single-use and codes are bound to their login
+	_, _, err = authService.StartOIDCLogin(ctx, "other")
+	require.ErrorIs(t, err, ErrUnknownOIDCProvider)
+	authURL, binding, err := authService.StartOIDCLogin(ctx, "fake")
+	require.NoError(t, err)
+	code, state, err := provider.Authorize(authURL)
+	require.NoError(t, err)
+	otherURL, otherBinding, err := authService.StartOIDCLogin(ctx, "fake")
+	require.NoError(t, err)
+	_, otherState, err := provider.Authorize(otherURL)
+	require.NoError(t, err)
+	_, err = authService.CompleteOIDCLogin(ctx, otherState, code, otherBinding)
+	require.ErrorIs(t, err, ErrOIDCLoginFailed)
+	_, err = authService.CompleteOIDCLogin(ctx, otherState, code, otherBinding)
+	require.ErrorIs(t, err, ErrInvalidOIDCState)
+	// The provider used up the code of the first login
+	_, err = authService.CompleteOIDCLogin(ctx, state, code, binding)
+	require.ErrorIs(t, err, ErrOIDCLoginFailed)
+
+	// Logins complete in the browser which started them only
+	authURL, _, err = authService.StartOIDCLogin(ctx, "fake")
+	require.NoError(t, err)
+	code, state, err = provider.Authorize(authURL)
+	require.NoError(t, err)
+	_, err = authService.CompleteOIDCLogin(ctx, state, code, binding)
+	require.ErrorIs(t, err, ErrInvalidOIDCState)
+}
+
+func TestAuthServiceConcurrentOIDCLogins(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	authService := newTestAuthService(db, rdb, nil, &testMailer{})
+	identity := &oidc.Identity{Issuer: "https://idp.example.com", Subject: "dave", Email: "dave@example.com", EmailVerified: true, Name: "Dave"}
+
+	// Every first login links the same identity to the same customer
+	const logins = 5
+	ids := make([]model.CustomerID, logins)
+	errs := make([]error, logins)
+	var wg sync.WaitGroup
+	for i := range logins {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids[i], errs[i] = authService.linkOIDCIdentity(ctx, identity)
+		}()
+	}
+	wg.Wait()
+	for i := range logins {
+		require.NoError(t, errs[i])
+		require.Equal(t, ids[0], ids[i])
+	}
+}
@@ -3,24 +3,35 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
 
 	"restaurant-ordering-system/internal/pkg/model"
 	"restaurant-ordering-system/internal/pkg/repository"
 	"restaurant-ordering-system/internal/pkg/repository/cache"
+	"restaurant-ordering-system/internal/pkg/retry"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/utils/keymutex"
 )
 
-func NewCacheService(db *pgxpool.Pool, rdb *redis.Client) *CacheService {
+// ErrCacheUnavailable is returned by mutations of not sent orders, which only live in Redis,
+// while Redis is unreachable
+var ErrCacheUnavailable = status.Error(codes.Unavailable, "order drafts are temporarily unavailable")
+
+func NewCacheService(db *pgxpool.Pool, rdb redis.UniversalClient) *CacheService {
 	return &CacheService{
 		group:   new(singleflight.Group),
 		mutex:   keymutex.NewHashed(int(db.Config().MaxConns)),
 		queries: repository.New(db),
 		rdb:     rdb,
+		metrics: newCacheMetrics(),
+		retry:   retry.DefaultPolicy,
 	}
 }
 
@@ -28,43 +39,95 @@ type CacheService struct {
 	group   *singleflight.Group
 	mutex   keymutex.KeyMutex
 	queries *repository.Queries
-	rdb     *redis.Client
+	rdb     redis.UniversalClient
+	metrics *cacheMetrics
+	retry   retry.Policy
 }
 
 func (s *CacheService) GetAndCacheTab(ctx context.Context, id model.TabID) (*model.Tab, error) {
 	key := id.String()
-	v, err, _ := s.group.Do(key, func() (any, error) {
+	v, err, shared := s.group.Do(key, func() (any, error) {
 		s.mutex.LockKey(key)
 		defer s.mutex.UnlockKey(key)
 
 		s.group.Forget(key)
 
-		row, err := s.queries.GetTabWithOrdersForShare(ctx, uuid.UUID(id))
-		if err != nil {
-			return nil, err
-		}
+		start := time.Now()
+		defer func() {
+			s.metrics.rebuildLatency.Record(ctx, time.Since(start).Seconds())
+		}()
 
-		repoTab := repository.TabWithOrders{
-			ID:         row.ID,
-			TotalPrice: row.TotalPrice,
-			CreatedAt:  row.CreatedAt,
-			ClosedAt:   row.ClosedAt,
-			GuestNames: row.GuestNames,
-		}
-		if err := json.Unmarshal(row.Orders, &repoTab.Orders); err != nil {
+		tab, err := s.loadTab(ctx, id)
+		if err != nil {
 			return nil, err
 		}
 
-		tab := NewTab(repoTab)
-
-		if _, err := s.rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
-			return cache.New(p).CacheTab(ctx, tab)
-		}); err != nil {
+		if err := cache.New(s.rdb).CacheTab(ctx, tab); err != nil {
 			return tab, err
 		}
+		s.metrics.tabKeys.Record(ctx, int64(len(cache.TabKeys(tab))))
 
 		return tab, nil
 	})
+	if shared {
+		s.metrics.rebuildShared.Add(ctx, 1)
+	}
 	tab, _ := v.(*model.Tab)
 	return tab, err
 }
+
+// publishTabEvent notifies the followers of tabID of a change already committed to the database.
+// Publishing is best effort since the change cannot be rolled back anymore.
+func (s *CacheService) publishTabEvent(ctx context.Context, tabID model.TabID, event *model.TabEvent) {
+	if err := cache.New(s.rdb).AddTabEvent(ctx, tabID, event); err != nil && cache.IsUnavailable(err) {
+		s.metrics.unavailableCache(ctx, "publishTabEvent")
+	}
+}
+
+// loadTab reads a tab from the database without caching it
+func (s *CacheService) loadTab(ctx context.Context, id model.TabID) (*model.Tab, error) {
+	return loadTab(ctx, s.queries, id)
+}
+
+// loadTab reads a tab with q, which may run in a transaction that changed the tab
+func loadTab(ctx context.Context, q *repository.Queries, id model.TabID) (*model.Tab, error) {
+	row, err := q.GetTabWithOrdersForShare(ctx, uuid.UUID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	repoTab := repository.TabWithOrders{
+		ID:         row.ID,
+		TotalPrice: row.TotalPrice,
+		CreatedAt:  row.CreatedAt,
+		ClosedAt:   row.ClosedAt,
+		GuestNames: row.GuestNames,
+	}
+	if err := json.Unmarshal(row.Orders, &repoTab.Orders); err != nil {
+		return nil, err
+	}
+
+	return NewTab(repoTab), nil
+}
+
+// retryTx calls fn again whenever the optimistic transaction it runs is aborted
+// because a watched key was modified concurrently, or because the scripts it
+// queued were missing from the script cache, e.g. after a Redis restart.
+// Errors caused by Redis being unreachable are replaced by ErrCacheUnavailable.
+func (s *CacheService) retryTx(ctx context.Context, operation string, fn func() error) error {
+	err := retry.Do(ctx, s.retry, func(err error) bool {
+		if errors.Is(err, redis.TxFailedErr) {
+			s.metrics.watchConflict(ctx, operation)
+			return true
+		}
+		if cache.IsNoScript(err) {
+			return cache.LoadScripts(ctx, s.rdb) == nil
+		}
+		return false
+	}, fn)
+	if cache.IsUnavailable(err) {
+		s.metrics.unavailableCache(ctx, operation)
+		return ErrCacheUnavailable
+	}
+	return err
+}
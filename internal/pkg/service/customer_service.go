@@ -3,54 +3,119 @@ package service
 // CustomerService provides methods for managing customers
 import (
 	"context"
+	"errors"
+	"net/mail"
+	"slices"
+	"time"
 
 	"restaurant-ordering-system/internal/pkg/model"
 	"restaurant-ordering-system/internal/pkg/repository"
+	"restaurant-ordering-system/internal/pkg/repository/cache"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var (
+	ErrLoginIDTaken  = status.Error(codes.AlreadyExists, "login ID is already taken")
+	ErrEmailTaken    = status.Error(codes.AlreadyExists, "email is already registered")
+	ErrWrongPassword = status.Error(codes.PermissionDenied, "current password is incorrect")
+	ErrEmptyPassword = status.Error(codes.InvalidArgument, "password must not be empty")
+	ErrInvalidEmail  = status.Error(codes.InvalidArgument, "email is not a valid address")
+	// Favorites
+	ErrFavoriteNotFound      = status.Error(codes.NotFound, "favorite not found")
+	ErrEmptyFavoriteName     = status.Error(codes.InvalidArgument, "favorite name must not be empty")
+	ErrIncompatibleModifiers = status.Error(codes.InvalidArgument, "modifiers do not match the modifiers config of the menu item")
+	ErrOutdatedFavorite      = status.Error(codes.FailedPrecondition, "favorite is outdated, its menu item changed")
+	// ErrSessionsUnavailable is returned when sessions can not be opened nor revoked while Redis is unreachable
+	ErrSessionsUnavailable = status.Error(codes.Unavailable, "sessions are temporarily unavailable")
+	// ErrReauthenticationRequired is returned to customers without a password who did not
+	// log in with an identity provider recently
+	ErrReauthenticationRequired = status.Error(codes.PermissionDenied, "log in again with your identity provider first")
+)
+
+// uniqueViolation is the SQLSTATE of unique constraint violations
+const uniqueViolation = "23505"
+
+func NewFavorite(repoFavorite repository.GetCustomerFavoritesRow) model.Favorite {
+	return model.Favorite{
+		ID:           model.FavoriteID(repoFavorite.ID),
+		MenuItemID:   model.MenuItemID(repoFavorite.MenuItemID),
+		MenuItemName: repoFavorite.MenuItemName,
+		Name:         repoFavorite.Name,
+		Modifiers:    repoFavorite.Modifiers,
+		Outdated:     repoFavorite.MenuItemDeletedAt.Valid || !modifiersCompatible(repoFavorite.Modifiers, repoFavorite.ModifiersConfig),
+		CreatedAt:    repoFavorite.CreatedAt.Time,
+		UpdatedAt:    repoFavorite.UpdatedAt.Time,
+	}
+}
+
+// modifiersCompatible reports whether the modifiers can still be ordered with a
+// menu item of the modifiers config, as checked by CreateOrderItem
+func modifiersCompatible(modifiers, modifiersConfig []byte) bool {
+	return validateModifiers(modifiers, modifiersConfig) == nil
+}
+
 func NewCustomer(repoCustomer repository.Customer) model.Customer {
 	return model.Customer{
-		ID:          model.CustomerID(repoCustomer.ID),
-		Name:        repoCustomer.Name,
-		Email:       repoCustomer.Email,
-		PhoneNumber: repoCustomer.PhoneNumber.String,
-		CreatedAt:   repoCustomer.CreatedAt.Time,
-		UpdatedAt:   repoCustomer.UpdatedAt.Time,
+		ID:            model.CustomerID(repoCustomer.ID),
+		LoginID:       model.LoginID(repoCustomer.LoginID.String),
+		Name:          repoCustomer.Name,
+		Email:         repoCustomer.Email.String,
+		EmailVerified: repoCustomer.EmailVerifiedAt.Valid,
+		PhoneNumber:   repoCustomer.PhoneNumber.String,
+		CreatedAt:     repoCustomer.CreatedAt.Time,
+		UpdatedAt:     repoCustomer.UpdatedAt.Time,
 	}
 }
 
 type CustomerService struct {
-	db      *pgxpool.Pool
-	queries *repository.Queries
+	db           *pgxpool.Pool
+	rdb          redis.UniversalClient
+	queries      *repository.Queries
+	cacheService *CacheService
+	authService  *AuthService
 }
 
-func NewCustomerService(db *pgxpool.Pool) *CustomerService {
+// NewCustomerService creates a CustomerService. authService revokes the sessions
+// of customers and sends them emails.
+func NewCustomerService(db *pgxpool.Pool, rdb redis.UniversalClient, cacheService *CacheService, authService *AuthService) *CustomerService {
 	return &CustomerService{
-		db:      db,
-		queries: repository.New(db),
+		db:           db,
+		rdb:          rdb,
+		queries:      repository.New(db),
+		cacheService: cacheService,
+		authService:  authService,
 	}
 }
 
+// CreateCustomer creates a customer and sends them a link verifying their email
 func (s *CustomerService) CreateCustomer(ctx context.Context, params model.CreateCustomerParams) (model.Customer, error) {
+	if !validEmail(params.Email) {
+		return model.Customer{}, ErrInvalidEmail
+	}
 	passwordHash, err := bcrypt.GenerateFromPassword(params.Password, bcrypt.DefaultCost)
 	if err != nil {
 		return model.Customer{}, err
 	}
 	c, err := s.queries.CreateCustomer(ctx, repository.CreateCustomerParams{
-		LoginID:      params.LoginID.String(),
-		Email:        params.Email,
+		LoginID:      pgtype.Text{String: params.LoginID.String(), Valid: true},
+		Email:        pgtype.Text{String: params.Email, Valid: true},
 		PasswordHash: string(passwordHash),
 		Name:         params.Name,
 		PhoneNumber:  pgtype.Text{String: params.PhoneNumber, Valid: params.PhoneNumber != ""},
 	})
 	if err != nil {
-		return model.Customer{}, err
+		return model.Customer{}, customerUniqueViolation(err)
 	}
+	s.sendEmailVerification(ctx, model.CustomerID(c.ID))
 	return NewCustomer(c), nil
 }
 
@@ -61,3 +126,458 @@ func (s *CustomerService) GetCustomerByID(ctx context.Context, id model.Customer
 	}
 	return NewCustomer(c), nil
 }
+
+// UpdateCustomerProfile updates the name and phone number of the customer
+func (s *CustomerService) UpdateCustomerProfile(ctx context.Context, id model.CustomerID, params model.UpdateCustomerProfileParams) (model.Customer, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return model.Customer{}, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	c, err := qtx.GetCustomerByIDForUpdate(ctx, uuid.UUID(id))
+	if err != nil {
+		return model.Customer{}, err
+	}
+	name, phoneNumber := c.Name, c.PhoneNumber
+	if params.Name != nil {
+		name = *params.Name
+	}
+	if params.PhoneNumber != nil {
+		phoneNumber = pgtype.Text{String: *params.PhoneNumber, Valid: *params.PhoneNumber != ""}
+	}
+	c, err = qtx.UpdateCustomerInfo(ctx, repository.UpdateCustomerInfoParams{
+		ID:          c.ID,
+		Name:        name,
+		PhoneNumber: phoneNumber,
+	})
+	if err != nil {
+		return model.Customer{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return model.Customer{}, err
+	}
+	return NewCustomer(c), nil
+}
+
+// ChangeLoginID changes the login ID of the customer after checking their password,
+// see checkReauthentication for customers without any
+func (s *CustomerService) ChangeLoginID(ctx context.Context, id model.CustomerID, loginID model.LoginID, password []byte) (model.Customer, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return model.Customer{}, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	c, err := qtx.GetCustomerByIDForUpdate(ctx, uuid.UUID(id))
+	if err != nil {
+		return model.Customer{}, err
+	}
+	if err := s.checkReauthentication(ctx, qtx, c, password); err != nil {
+		return model.Customer{}, err
+	}
+	c, err = qtx.UpdateCustomerLoginID(ctx, repository.UpdateCustomerLoginIDParams{
+		ID:      c.ID,
+		LoginID: pgtype.Text{String: loginID.String(), Valid: true},
+	})
+	if err != nil {
+		return model.Customer{}, customerUniqueViolation(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return model.Customer{}, err
+	}
+	return NewCustomer(c), nil
+}
+
+// ChangeEmail changes the email of the customer after checking their password, see
+// checkReauthentication for customers without any. The new email has to be verified
+// again, and the previous one is told about the change.
+func (s *CustomerService) ChangeEmail(ctx context.Context, id model.CustomerID, email string, password []byte) (model.Customer, error) {
+	if !validEmail(email) {
+		return model.Customer{}, ErrInvalidEmail
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return model.Customer{}, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	previous, err := qtx.GetCustomerByIDForUpdate(ctx, uuid.UUID(id))
+	if err != nil {
+		return model.Customer{}, err
+	}
+	if err := s.checkReauthentication(ctx, qtx, previous, password); err != nil {
+		return model.Customer{}, err
+	}
+	c, err := qtx.UpdateCustomerEmail(ctx, repository.UpdateCustomerEmailParams{
+		ID:    previous.ID,
+		Email: pgtype.Text{String: email, Valid: true},
+	})
+	if err != nil {
+		return model.Customer{}, customerUniqueViolation(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return model.Customer{}, err
+	}
+
+	if previous.Email.Valid && previous.Email.String != email {
+		s.authService.SendEmailChangeNotice(ctx, previous, email)
+	}
+	s.sendEmailVerification(ctx, id)
+	return NewCustomer(c), nil
+}
+
+// TokenTTL returns the lifetime of the tokens returned by ChangePassword
+func (s *CustomerService) TokenTTL() time.Duration {
+	return s.authService.TokenTTL()
+}
+
+// ChangePassword changes the password of the customer after checking the current one,
+// see checkReauthentication for customers without any. Every session of the customer
+// is revoked, and a token for a new session is returned.
+func (s *CustomerService) ChangePassword(ctx context.Context, id model.CustomerID, currentPassword, newPassword []byte) (string, error) {
+	if len(newPassword) == 0 {
+		return "", ErrEmptyPassword
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	c, err := qtx.GetCustomerByIDForUpdate(ctx, uuid.UUID(id))
+	if err != nil {
+		return "", err
+	}
+	if err := s.checkReauthentication(ctx, qtx, c, currentPassword); err != nil {
+		return "", err
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword(newPassword, bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	if _, err := qtx.UpdateCustomerPassword(ctx, repository.UpdateCustomerPasswordParams{
+		ID:           c.ID,
+		PasswordHash: string(passwordHash),
+	}); err != nil {
+		return "", err
+	}
+
+	// Sessions are revoked before committing, so that the password never changes
+	// while the sessions opened with the previous one stay valid
+	if err := s.authService.revokeSessions(ctx, id); err != nil {
+		return "", err
+	}
+	token, err := s.authService.issueJWT(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// DeleteCustomer anonymizes the customer after checking their password, see
+// checkReauthentication for customers without any, and removes
+// them from the owners of order items and from the tabs they visited. Tabs keep their
+// items and totals. Every session of the customer is revoked.
+func (s *CustomerService) DeleteCustomer(ctx context.Context, id model.CustomerID, password []byte) error {
+	customerID := uuid.UUID(id)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	c, err := qtx.GetCustomerByIDForUpdate(ctx, customerID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkReauthentication(ctx, qtx, c, password); err != nil {
+		return err
+	}
+
+	visitedTabIDs, err := qtx.DeleteCustomerVisitations(ctx, customerID)
+	if err != nil {
+		return err
+	}
+	openTabIDs, err := qtx.GetOpenTabIDsForShare(ctx, visitedTabIDs)
+	if err != nil {
+		return err
+	}
+	if err := qtx.RemoveCustomerFromOrderItems(ctx, customerID); err != nil {
+		return err
+	}
+	if err := qtx.DeleteCustomerTokens(ctx, customerID); err != nil {
+		return err
+	}
+	if err := qtx.DeleteCustomerIdentities(ctx, customerID); err != nil {
+		return err
+	}
+	if err := qtx.DeleteCustomerFavorites(ctx, customerID); err != nil {
+		return err
+	}
+	if err := qtx.AnonymizeCustomer(ctx, customerID); err != nil {
+		return err
+	}
+
+	if err := s.authService.revokeSessions(ctx, id); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	// The customer is already deleted, open tabs still cached with them are only
+	// scrubbed on a best effort basis, closing the tabs rebuilds them anyway
+	for _, tabID := range openTabIDs {
+		s.scrubCachedTab(ctx, model.TabID(tabID), id)
+	}
+	return nil
+}
+
+// checkReauthentication checks the password of the customer. Customers without a
+// password, who only log in with identity providers, must have logged in with one
+// within account.oidc.reauthWindow instead.
+func (s *CustomerService) checkReauthentication(ctx context.Context, qtx *repository.Queries, c repository.Customer, password []byte) error {
+	if c.PasswordHash == "" {
+		recent, err := qtx.HasRecentIdentityLogin(ctx, repository.HasRecentIdentityLoginParams{
+			CustomerID:    c.ID,
+			WindowSeconds: int32(s.authService.account.OIDC.ReauthWindow / time.Second),
+		})
+		if err != nil {
+			return err
+		}
+		if !recent {
+			return ErrReauthenticationRequired
+		}
+		return nil
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(c.PasswordHash), password); err != nil {
+		return ErrWrongPassword
+	}
+	return nil
+}
+
+// scrubCachedTab removes the customer from the owners of the not sent order items
+// of the cached tab, which only live in Redis, and caches the sent orders again
+// from the database
+func (s *CustomerService) scrubCachedTab(ctx context.Context, tabID model.TabID, customerID model.CustomerID) error {
+	tab, err := s.cacheService.loadTab(ctx, tabID)
+	if err != nil {
+		return err
+	}
+	tab.Orders = slices.DeleteFunc(tab.Orders, func(order *model.Order) bool {
+		return order.SentAt == nil
+	})
+
+	return s.cacheService.retryTx(ctx, "scrubCachedTab", func() error {
+		return s.rdb.Watch(ctx, func(tx *redis.Tx) error {
+			_, orderItemIDs, err := cache.WatchAndGetNotSentOrderIDAndItemIDs(ctx, tx, tabID)
+			if err != nil {
+				if errors.Is(err, redis.Nil) {
+					return nil // Not cached
+				}
+				return err
+			}
+			orderItems, err := cache.WatchAndGetOrderItems(ctx, tx, orderItemIDs)
+			if err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+				q := cache.New(p)
+				for _, item := range orderItems {
+					if !slices.Contains(item.CustomerOwnerIDs, customerID) {
+						continue
+					}
+					q.RemoveOrderItemCustomerOwner(ctx, item.ID, customerID)
+					if err := q.AddTabEvent(ctx, tabID, &model.TabEvent{
+						Type:        model.OrderItemUpdated,
+						OrderItemID: &item.ID,
+					}); err != nil {
+						return err
+					}
+				}
+				if len(tab.Orders) == 0 {
+					return nil
+				}
+				return q.CacheTab(ctx, tab)
+			})
+			return err
+		}, cache.TxKey(tabID))
+	})
+}
+
+// ExportCustomerData returns the profile of the customer, their linked identities,
+// their favorites, the tabs they visited and the items they ordered, leaving out
+// what other diners of these tabs ordered and their names
+func (s *CustomerService) ExportCustomerData(ctx context.Context, id model.CustomerID) (*model.CustomerDataExport, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	c, err := qtx.GetCustomerByID(ctx, uuid.UUID(id))
+	if err != nil {
+		return nil, err
+	}
+	repoIdentities, err := qtx.GetCustomerIdentities(ctx, uuid.UUID(id))
+	if err != nil {
+		return nil, err
+	}
+	repoFavorites, err := qtx.GetCustomerFavorites(ctx, uuid.UUID(id))
+	if err != nil {
+		return nil, err
+	}
+	repoTabs, err := qtx.GetVisitedTabs(ctx, uuid.UUID(id))
+	if err != nil {
+		return nil, err
+	}
+	repoItems, err := qtx.GetCustomerSentOrderItems(ctx, uuid.UUID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	export := &model.CustomerDataExport{
+		ExportedAt:   time.Now(),
+		Profile:      NewCustomer(c),
+		Identities:   make([]model.CustomerIdentity, len(repoIdentities)),
+		Favorites:    make([]model.Favorite, len(repoFavorites)),
+		VisitedTabs:  make([]model.VisitedTab, len(repoTabs)),
+		OrderedItems: make([]*model.OrderItem, len(repoItems)),
+	}
+	for i, repoIdentity := range repoIdentities {
+		export.Identities[i] = model.CustomerIdentity{
+			Issuer:    repoIdentity.Issuer,
+			Subject:   repoIdentity.Subject,
+			Email:     repoIdentity.Email.String,
+			CreatedAt: repoIdentity.CreatedAt.Time,
+		}
+	}
+	for i, repoFavorite := range repoFavorites {
+		export.Favorites[i] = NewFavorite(repoFavorite)
+	}
+	for i, repoTab := range repoTabs {
+		export.VisitedTabs[i] = model.VisitedTab{
+			ID:         model.TabID(repoTab.ID),
+			TotalPrice: repoTab.TotalPrice,
+			CreatedAt:  repoTab.CreatedAt.Time,
+		}
+		if repoTab.ClosedAt.Valid {
+			export.VisitedTabs[i].ClosedAt = &repoTab.ClosedAt.Time
+		}
+	}
+	for i, repoItem := range repoItems {
+		item := NewOrderItem(repoItem)
+		// The other owners are other diners
+		item.GuestOwnerIDs = nil
+		item.CustomerOwnerIDs = []model.CustomerID{id}
+		export.OrderedItems[i] = item
+	}
+	return export, nil
+}
+
+// SaveFavorite saves the menu item with the modifiers under a name, replacing the
+// favorite of the customer with the same name if any
+func (s *CustomerService) SaveFavorite(ctx context.Context, customerID model.CustomerID, params model.SaveFavoriteParams) (model.Favorite, error) {
+	if params.Name == "" {
+		return model.Favorite{}, ErrEmptyFavoriteName
+	}
+	menuItem, err := s.queries.GetNotDeletedMenuItem(ctx, int16(params.MenuItemID))
+	if err != nil {
+		return model.Favorite{}, err
+	}
+	if !modifiersCompatible(params.Modifiers, menuItem.ModifiersConfig) {
+		return model.Favorite{}, ErrIncompatibleModifiers
+	}
+
+	repoFavorite, err := s.queries.SaveFavorite(ctx, repository.SaveFavoriteParams{
+		CustomerID: uuid.UUID(customerID),
+		MenuItemID: int16(params.MenuItemID),
+		Name:       params.Name,
+		Modifiers:  params.Modifiers,
+	})
+	if err != nil {
+		return model.Favorite{}, err
+	}
+	return NewFavorite(repository.GetCustomerFavoritesRow{
+		ID:              repoFavorite.ID,
+		CustomerID:      repoFavorite.CustomerID,
+		MenuItemID:      repoFavorite.MenuItemID,
+		Name:            repoFavorite.Name,
+		Modifiers:       repoFavorite.Modifiers,
+		CreatedAt:       repoFavorite.CreatedAt,
+		UpdatedAt:       repoFavorite.UpdatedAt,
+		MenuItemName:    menuItem.Name,
+		ModifiersConfig: menuItem.ModifiersConfig,
+	}), nil
+}
+
+// ListFavorites returns the favorites of the customer by name, flagging the
+// outdated ones
+func (s *CustomerService) ListFavorites(ctx context.Context, customerID model.CustomerID) ([]model.Favorite, error) {
+	repoFavorites, err := s.queries.GetCustomerFavorites(ctx, uuid.UUID(customerID))
+	if err != nil {
+		return nil, err
+	}
+	favorites := make([]model.Favorite, len(repoFavorites))
+	for i, repoFavorite := range repoFavorites {
+		favorites[i] = NewFavorite(repoFavorite)
+	}
+	return favorites, nil
+}
+
+func (s *CustomerService) DeleteFavorite(ctx context.Context, customerID model.CustomerID, id model.FavoriteID) error {
+	deleted, err := s.queries.DeleteFavorite(ctx, repository.DeleteFavoriteParams{
+		ID:         int64(id),
+		CustomerID: uuid.UUID(customerID),
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrFavoriteNotFound
+	}
+	return nil
+}
+
+// sendEmailVerification sends a link verifying the email of the customer in the
+// background, on a best effort basis, customers can ask for another one
+func (s *CustomerService) sendEmailVerification(ctx context.Context, id model.CustomerID) {
+	s.authService.runInBackground(ctx, "email verification", func(ctx context.Context) error {
+		return s.authService.SendEmailVerification(ctx, id)
+	})
+}
+
+// validEmail reports whether email is a bare address such as "jane@example.com"
+func validEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
+
+// customerUniqueViolation maps violations of the unique login ID and email of customers to their errors
+func customerUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolation {
+		return err
+	}
+	switch pgErr.ConstraintName {
+	case "customer_login_id_key":
+		return ErrLoginIDTaken
+	case "customer_email_key":
+		return ErrEmailTaken
+	default:
+		return err
+	}
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+	"restaurant-ordering-system/internal/pkg/model"
+)
+
+func TestCustomerServiceProfile(t *testing.T) {
+	db, rdb := newTestStores(t)
+	key := []byte("secret")
+	generateJWT := auth.NewCustomerJWTGenerator(auth.NewHMACKeyring(key), time.Hour)
+	authService := newTestAuthService(db, rdb, generateJWT, &testMailer{})
+	customerService := NewCustomerService(db, rdb, NewCacheService(db, rdb), authService)
+	ctx := t.Context()
+
+	alice, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("alice-password"), Name: "Alice",
+	})
+	require.NoError(t, err)
+	_, err = customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "bob", Email: "bob@example.com", Password: []byte("bob-password"), Name: "Bob",
+	})
+	require.NoError(t, err)
+	_, err = customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "other@example.com", Password: []byte("password"), Name: "Other",
+	})
+	require.ErrorIs(t, err, ErrLoginIDTaken)
+
+	// Unique login ID and email, changed with the password only
+	password := []byte("alice-password")
+	_, err = customerService.ChangeLoginID(ctx, alice.ID, "alice2", []byte("wrong-password"))
+	require.ErrorIs(t, err, ErrWrongPassword)
+	_, err = customerService.ChangeLoginID(ctx, alice.ID, "alice2", nil)
+	require.ErrorIs(t, err, ErrWrongPassword)
+	_, err = customerService.ChangeLoginID(ctx, alice.ID, "bob", password)
+	require.ErrorIs(t, err, ErrLoginIDTaken)
+	_, err = customerService.ChangeEmail(ctx, alice.ID, "bob@example.com", password)
+	require.ErrorIs(t, err, ErrEmailTaken)
+	changed, err := customerService.ChangeLoginID(ctx, alice.ID, "alice2", password)
+	require.NoError(t, err)
+	require.Equal(t, model.LoginID("alice2"), changed.LoginID)
+	require.True(t, changed.UpdatedAt.After(alice.UpdatedAt))
+
+	// Partial profile update
+	phoneNumber := "+1 555 0100"
+	updated, err := customerService.UpdateCustomerProfile(ctx, alice.ID, model.UpdateCustomerProfileParams{PhoneNumber: &phoneNumber})
+	require.NoError(t, err)
+	require.Equal(t, "Alice", updated.Name)
+	require.Equal(t, phoneNumber, updated.PhoneNumber)
+	require.True(t, updated.UpdatedAt.After(changed.UpdatedAt))
+
+	// Changing the password revokes the sessions opened before
+	oldClaims := &auth.Claims{
+		Role:             auth.CustomerRole,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: alice.ID.String(), IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+	}
+	revoked, err := authService.IsTokenRevoked(ctx, oldClaims)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	_, err = customerService.ChangePassword(ctx, alice.ID, []byte("wrong-password"), []byte("new-password"))
+	require.ErrorIs(t, err, ErrWrongPassword)
+	// Tokens issued right before the change are revoked too
+	recentToken, err := authService.GenerateToken(ctx, "alice2", "alice-password", "")
+	require.NoError(t, err)
+	recentClaims, err := auth.ParseJWT(recentToken, key)
+	require.NoError(t, err)
+	token, err := customerService.ChangePassword(ctx, alice.ID, []byte("alice-password"), []byte("new-password"))
+	require.NoError(t, err)
+
+	revoked, err = authService.IsTokenRevoked(ctx, oldClaims)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	revoked, err = authService.IsTokenRevoked(ctx, recentClaims)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	newClaims, err := auth.ParseJWT(token, key)
+	require.NoError(t, err)
+	revoked, err = authService.IsTokenRevoked(ctx, newClaims)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	_, err = authService.GenerateToken(ctx, "alice2", "alice-password", "")
+	require.Error(t, err)
+	_, err = authService.GenerateToken(ctx, "alice2", "new-password", "")
+	require.NoError(t, err)
+}
+
+func TestCustomerServiceDeleteAndExport(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	cacheService := NewCacheService(db, rdb)
+	authService := newTestAuthService(db, rdb, auth.NewCustomerJWTGenerator(auth.NewHMACKeyring([]byte("secret")), time.Hour), &testMailer{})
+	customerService := NewCustomerService(db, rdb, cacheService, authService)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+	menuService := newTestMenuService(t, db)
+
+	menuItem, err := menuService.CreateMenuItem(ctx, model.CreateMenuItemParams{
+		Name:        "Fried Rice",
+		Price:       100,
+		PortionSize: 1,
+		Available:   true,
+	})
+	require.NoError(t, err)
+	alice, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("alice-password"), Name: "Alice",
+	})
+	require.NoError(t, err)
+
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tabService.VisitTab(ctx, tabID, alice.ID))
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	sentOrderID := tab.Orders[len(tab.Orders)-1].ID
+	// Another diner shares an item with Alice and orders one alone
+	guestID, _, err := tabService.CreateGuest(ctx, tabID)
+	require.NoError(t, err)
+	require.NoError(t, tabService.UpdateGuestName(ctx, guestID, "Bob"))
+	_, err = orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID: sentOrderID, MenuItemID: menuItem.ID, Quantity: 2, GuestOwnerIDs: []model.GuestID{guestID}, CustomerOwnerIDs: []model.CustomerID{alice.ID},
+	})
+	require.NoError(t, err)
+	_, err = orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID: sentOrderID, MenuItemID: menuItem.ID, Quantity: 3, GuestOwnerIDs: []model.GuestID{guestID},
+	})
+	require.NoError(t, err)
+	require.NoError(t, orderService.SendOrder(ctx, sentOrderID))
+	tab, err = tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	_, err = orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID: tab.Orders[len(tab.Orders)-1].ID, MenuItemID: menuItem.ID, Quantity: 1, CustomerOwnerIDs: []model.CustomerID{alice.ID},
+	})
+	require.NoError(t, err)
+
+	export, err := customerService.ExportCustomerData(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.LoginID("alice"), export.Profile.LoginID)
+	require.Len(t, export.VisitedTabs, 1)
+	require.Equal(t, tabID, export.VisitedTabs[0].ID)
+	require.Len(t, export.OrderedItems, 1)
+	require.Equal(t, int16(2), export.OrderedItems[0].Quantity)
+	require.Empty(t, export.OrderedItems[0].GuestOwnerIDs)
+	require.Equal(t, []model.CustomerID{alice.ID}, export.OrderedItems[0].CustomerOwnerIDs)
+	document, err := json.Marshal(export)
+	require.NoError(t, err)
+	require.NotContains(t, string(document), "Bob")
+
+	require.ErrorIs(t, customerService.DeleteCustomer(ctx, alice.ID, []byte("wrong-password")), ErrWrongPassword)
+	require.NoError(t, customerService.DeleteCustomer(ctx, alice.ID, []byte("alice-password")))
+
+	_, err = customerService.GetCustomerByID(ctx, alice.ID)
+	require.Error(t, err)
+	visited, err := tabService.GetVisitedTabs(ctx, alice.ID, model.VisitedTabsParams{})
+	require.NoError(t, err)
+	require.Empty(t, visited.Tabs)
+
+	// The tab keeps its items and total, without the customer
+	tab, err = tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	require.Equal(t, int32(500), tab.TotalPrice)
+	require.Len(t, tab.Orders, 2)
+	for _, order := range tab.Orders {
+		for _, item := range order.Items {
+			require.Empty(t, item.CustomerOwnerIDs)
+		}
+	}
+
+	// The login ID and email can be used again
+	_, err = customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("password"), Name: "Alice",
+	})
+	require.NoError(t, err)
+}
+
+func TestCustomerServiceFavorites(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	cacheService := NewCacheService(db, rdb)
+	authService := newTestAuthService(db, rdb, auth.NewCustomerJWTGenerator(auth.NewHMACKeyring([]byte("secret")), time.Hour), &testMailer{})
+	customerService := NewCustomerService(db, rdb, cacheService, authService)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+	menuService := newTestMenuService(t, db)
+
+	pizza := model.CreateMenuItemParams{
+		Name:            "Pizza",
+		Price:           1200,
+		PortionSize:     1,
+		Available:       true,
+		ModifiersConfig: []byte(`{"extra_cheese": {"price": 100}, "crust": {"options": ["thin", "thick"]}}`),
+	}
+	menuItem, err := menuService.CreateMenuItem(ctx, pizza)
+	require.NoError(t, err)
+	alice, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("alice-password"), Name: "Alice",
+	})
+	require.NoError(t, err)
+
+	_, err = customerService.SaveFavorite(ctx, alice.ID, model.SaveFavoriteParams{
+		MenuItemID: menuItem.ID, Name: "My pizza", Modifiers: []byte(`{"pineapple": true}`),
+	})
+	require.ErrorIs(t, err, ErrIncompatibleModifiers)
+	_, err = customerService.SaveFavorite(ctx, alice.ID, model.SaveFavoriteParams{MenuItemID: menuItem.ID})
+	require.ErrorIs(t, err, ErrEmptyFavoriteName)
+	favorite, err := customerService.SaveFavorite(ctx, alice.ID, model.SaveFavoriteParams{
+		MenuItemID: menuItem.ID, Name: "My pizza", Modifiers: []byte(`{"crust": "thin"}`),
+	})
+	require.NoError(t, err)
+	require.False(t, favorite.Outdated)
+	// Saving under the same name replaces the favorite
+	replaced, err := customerService.SaveFavorite(ctx, alice.ID, model.SaveFavoriteParams{
+		MenuItemID: menuItem.ID, Name: "My pizza", Modifiers: []byte(`{"crust": "thin", "extra_cheese": true}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, favorite.ID, replaced.ID)
+
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tabService.VisitTab(ctx, tabID, alice.ID))
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	orderID := tab.Orders[len(tab.Orders)-1].ID
+	itemID, err := orderService.AddFavoriteToOrder(ctx, alice.ID, favorite.ID, orderID, 2)
+	require.NoError(t, err)
+	tab, err = tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	item := tab.Orders[len(tab.Orders)-1].Items[0]
+	require.Equal(t, itemID, item.ID)
+	require.Equal(t, int16(2), item.Quantity)
+	require.JSONEq(t, `{"crust": "thin", "extra_cheese": true}`, string(item.Modifiers))
+	require.Equal(t, []model.CustomerID{alice.ID}, item.CustomerOwnerIDs)
+
+	// Removing extra cheese from the menu outdates the favorite
+	pizza.ModifiersConfig = []byte(`{"crust": {"options": ["thin", "thick"]}}`)
+	_, err = menuService.UpdateMenuItem(ctx, menuItem.ID, model.UpdateMenuItemParams(pizza))
+	require.NoError(t, err)
+	favorites, err := customerService.ListFavorites(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, favorites, 1)
+	require.True(t, favorites[0].Outdated)
+	_, err = orderService.AddFavoriteToOrder(ctx, alice.ID, favorite.ID, orderID, 1)
+	require.ErrorIs(t, err, ErrOutdatedFavorite)
+
+	// Favorites are private
+	bob, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "bob", Email: "bob@example.com", Password: []byte("bob-password"), Name: "Bob",
+	})
+	require.NoError(t, err)
+	_, err = orderService.AddFavoriteToOrder(ctx, bob.ID, favorite.ID, orderID, 1)
+	require.ErrorIs(t, err, ErrFavoriteNotFound)
+	require.ErrorIs(t, customerService.DeleteFavorite(ctx, bob.ID, favorite.ID), ErrFavoriteNotFound)
+	require.NoError(t, customerService.DeleteFavorite(ctx, alice.ID, favorite.ID))
+	favorites, err = customerService.ListFavorites(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Empty(t, favorites)
+}
+
+func TestModifiersCompatible(t *testing.T) {
+	config := []byte(`{"extra_cheese": {"price": 100}, "crust": {"options": ["thin", "thick"]}}`)
+	for _, tc := range []struct {
+		modifiers, config string
+		compatible        bool
+	}{
+		{modifiers: ``, config: ``, compatible: true},
+		{modifiers: `null`, config: ``, compatible: true},
+		{modifiers: `{}`, config: `null`, compatible: true},
+		{modifiers: `{"crust": "thin"}`, config: string(config), compatible: true},
+		{modifiers: `{"crust": "thin", "extra_cheese": true}`, config: string(config), compatible: true},
+		{modifiers: `{"pineapple": true}`, config: string(config), compatible: false},
+		{modifiers: `{"crust": "thin"}`, config: ``, compatible: false},
+		{modifiers: `["crust"]`, config: string(config), compatible: false},
+		// Values must be one of the options, or a boolean for the other modifiers
+		{modifiers: `{"crust": "deep"}`, config: string(config), compatible: false},
+		{modifiers: `{"crust": true}`, config: string(config), compatible: false},
+		{modifiers: `{"extra_cheese": false}`, config: string(config), compatible: true},
+		{modifiers: `{"extra_cheese": "yes"}`, config: string(config), compatible: false},
+		{modifiers: `{"spice": "hot"}`, config: `{"spice": ["mild", "hot"]}`, compatible: true},
+		{modifiers: `{"spice": "medium"}`, config: `{"spice": ["mild", "hot"]}`, compatible: false},
+	} {
+		require.Equal(t, tc.compatible, modifiersCompatible([]byte(tc.modifiers), []byte(tc.config)), "%s with %s", tc.modifiers, tc.config)
+	}
+}
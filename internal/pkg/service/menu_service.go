@@ -3,43 +3,131 @@ package service
 // MenuService provides methods for managing menu items
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
 	"time"
 
+	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/menufile"
 	"restaurant-ordering-system/internal/pkg/model"
+	"restaurant-ordering-system/internal/pkg/photo"
 	"restaurant-ordering-system/internal/pkg/repository"
+	"restaurant-ordering-system/internal/pkg/storage"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var (
+	ErrMenuItemNotFound   = status.Error(codes.NotFound, "menu item not found")
+	ErrMenuNotFound       = status.Error(codes.NotFound, "menu not found")
+	ErrMenuNameTaken      = status.Error(codes.AlreadyExists, "a menu with this name already exists")
+	ErrPhotoTooLarge      = status.Error(codes.InvalidArgument, "photo is too large")
+	ErrPhotoTooManyPixels = status.Error(codes.InvalidArgument, "photo has too many pixels")
+	ErrUnsupportedPhoto   = status.Error(codes.InvalidArgument, "photo must be a JPEG, PNG or WebP image")
+)
+
+// photoExtensions are the extensions of the keys of the originals of photos
+var photoExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
 func NewMenuItem(repoItem repository.MenuItem) *model.MenuItem {
 	var deletedAt *time.Time
 	if repoItem.DeletedAt.Valid {
 		deletedAt = &repoItem.DeletedAt.Time
 	}
 	return &model.MenuItem{
-		ID:              model.MenuItemID(repoItem.ID),
-		Name:            repoItem.Name,
-		Description:     repoItem.Description.String,
-		PhotoPathinfo:   repoItem.PhotoPathinfo.String,
-		Price:           repoItem.Price,
-		PortionSize:     repoItem.PortionSize,
-		Available:       repoItem.Available,
-		ModifiersConfig: repoItem.ModifiersConfig,
-		CreatedAt:       repoItem.CreatedAt.Time,
-		DeletedAt:       deletedAt,
+		ID:                   model.MenuItemID(repoItem.ID),
+		Name:                 repoItem.Name,
+		Description:          repoItem.Description.String,
+		PhotoPathinfo:        repoItem.PhotoPathinfo.String,
+		Price:                repoItem.Price,
+		PortionSize:          repoItem.PortionSize,
+		Available:            repoItem.Available,
+		ModifiersConfig:      repoItem.ModifiersConfig,
+		AvailabilityOverride: newAvailabilityOverride(repoItem.AvailabilityOverride, repoItem.AvailabilityOverrideUntil),
+		CreatedAt:            repoItem.CreatedAt.Time,
+		DeletedAt:            deletedAt,
+	}
+}
+
+func newAvailabilityOverride(available pgtype.Bool, until pgtype.Timestamp) *model.AvailabilityOverride {
+	if !available.Valid {
+		return nil
+	}
+	override := &model.AvailabilityOverride{Available: available.Bool}
+	if until.Valid {
+		override.Until = &until.Time
+	}
+	return override
+}
+
+func NewMenu(repoMenu repository.Menu) *model.Menu {
+	return &model.Menu{
+		ID:          model.MenuID(repoMenu.ID),
+		Name:        repoMenu.Name,
+		Description: repoMenu.Description.String,
+		Timezone:    repoMenu.Timezone,
+		Schedules:   []model.MenuSchedule{},
+		MenuItemIDs: []model.MenuItemID{},
+		CreatedAt:   repoMenu.CreatedAt.Time,
+		UpdatedAt:   repoMenu.UpdatedAt.Time,
+	}
+}
+
+func NewMenuSchedule(repoSchedule repository.MenuSchedule) model.MenuSchedule {
+	weekdays := make([]time.Weekday, len(repoSchedule.Weekdays))
+	for i, weekday := range repoSchedule.Weekdays {
+		weekdays[i] = time.Weekday(weekday)
+	}
+	schedule := model.MenuSchedule{
+		Weekdays: weekdays,
+		Start:    model.TimeOfDay(repoSchedule.StartTime.Microseconds / int64(time.Minute/time.Microsecond)),
+		End:      model.TimeOfDay(repoSchedule.EndTime.Microseconds / int64(time.Minute/time.Microsecond)),
+	}
+	if repoSchedule.StartDate.Valid {
+		schedule.StartDate = &repoSchedule.StartDate.Time
+	}
+	if repoSchedule.EndDate.Valid {
+		schedule.EndDate = &repoSchedule.EndDate.Time
 	}
+	return schedule
+}
+
+// storedPhotoVariant is a variant of a photo as stored in the variants column
+type storedPhotoVariant struct {
+	Name   string `json:"name"`
+	Key    string `json:"key"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
 }
 
 type MenuService struct {
 	db      *pgxpool.Pool
 	queries *repository.Queries
+	storage storage.Storage
+	photos  config.PhotosConfig
 }
 
-func NewMenuService(db *pgxpool.Pool) *MenuService {
+func NewMenuService(db *pgxpool.Pool, store storage.Storage, photos config.PhotosConfig) *MenuService {
 	return &MenuService{
 		db:      db,
 		queries: repository.New(db),
+		storage: store,
+		photos:  photos,
 	}
 }
 
@@ -56,15 +144,28 @@ func (s *MenuService) CreateMenuItem(ctx context.Context, params model.CreateMen
 	if err != nil {
 		return nil, err
 	}
-	return NewMenuItem(item), nil
+	return s.withAvailability(ctx, NewMenuItem(item))
 }
 
 func (s *MenuService) GetMenuItem(ctx context.Context, id model.MenuItemID) (*model.MenuItem, error) {
-	item, err := s.queries.GetMenuItem(ctx, int16(id))
+	repoItem, err := s.queries.GetMenuItem(ctx, int16(id))
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.withAvailability(ctx, NewMenuItem(repoItem))
 	if err != nil {
 		return nil, err
 	}
-	return NewMenuItem(item), nil
+	repoPhoto, err := s.queries.GetMenuItemPhoto(ctx, int16(id))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	if err == nil {
+		if item.Photo, err = s.newMenuItemPhoto(repoPhoto); err != nil {
+			return nil, err
+		}
+	}
+	return item, nil
 }
 
 func (s *MenuService) ListMenuItems(ctx context.Context) ([]*model.MenuItem, error) {
@@ -73,8 +174,24 @@ func (s *MenuService) ListMenuItems(ctx context.Context) ([]*model.MenuItem, err
 		return nil, err
 	}
 	items := make([]*model.MenuItem, len(repoItems))
+	ids := make([]int16, len(repoItems))
+	byID := make(map[model.MenuItemID]*model.MenuItem, len(repoItems))
 	for i, item := range repoItems {
 		items[i] = NewMenuItem(item)
+		ids[i] = item.ID
+		byID[items[i].ID] = items[i]
+	}
+	if err := setAvailability(ctx, s.queries, items, time.Now()); err != nil {
+		return nil, err
+	}
+	repoPhotos, err := s.queries.GetMenuItemPhotos(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, repoPhoto := range repoPhotos {
+		if byID[model.MenuItemID(repoPhoto.MenuItemID)].Photo, err = s.newMenuItemPhoto(repoPhoto); err != nil {
+			return nil, err
+		}
 	}
 	return items, nil
 }
@@ -93,9 +210,831 @@ func (s *MenuService) UpdateMenuItem(ctx context.Context, id model.MenuItemID, p
 	if err != nil {
 		return nil, err
 	}
-	return NewMenuItem(item), nil
+	return s.withAvailability(ctx, NewMenuItem(item))
 }
 
 func (s *MenuService) DeleteMenuItem(ctx context.Context, id model.MenuItemID) error {
 	return s.queries.SoftDeleteMenuItem(ctx, int16(id))
 }
+
+// SetMenuItemAvailabilityOverride forces the menu item not deleted available or unavailable
+// regardless of the schedules of its menus, or removes the override when nil
+func (s *MenuService) SetMenuItemAvailabilityOverride(ctx context.Context, id model.MenuItemID, override *model.AvailabilityOverride) (*model.MenuItem, error) {
+	params := repository.SetMenuItemAvailabilityOverrideParams{ID: int16(id)}
+	if override != nil {
+		params.AvailabilityOverride = pgtype.Bool{Bool: override.Available, Valid: true}
+		if override.Until != nil {
+			params.AvailabilityOverrideUntil = pgtype.Timestamp{Time: override.Until.UTC(), Valid: true}
+		}
+	}
+	item, err := s.queries.SetMenuItemAvailabilityOverride(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMenuItemNotFound
+		}
+		return nil, err
+	}
+	return s.withAvailability(ctx, NewMenuItem(item))
+}
+
+// withAvailability returns item once its availability is evaluated
+func (s *MenuService) withAvailability(ctx context.Context, item *model.MenuItem) (*model.MenuItem, error) {
+	if err := setAvailability(ctx, s.queries, []*model.MenuItem{item}, time.Now()); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// setAvailability sets the menus of items and evaluates their availability at now,
+// reading the schedules of their menus in a single query
+func setAvailability(ctx context.Context, q *repository.Queries, items []*model.MenuItem, now time.Time) error {
+	ids := make([]int16, len(items))
+	for i, item := range items {
+		ids[i] = int16(item.ID)
+	}
+	rows, err := q.ListMenuSchedulesOfMenuItems(ctx, ids)
+	if err != nil {
+		return err
+	}
+	// The schedules of a menu are repeated for each of its items, and only read
+	// from the rows of the first one
+	menus := make(map[model.MenuID]*model.Menu)
+	firstItemIDs := make(map[model.MenuID]model.MenuItemID)
+	menuIDs := make(map[model.MenuItemID][]model.MenuID)
+	for _, row := range rows {
+		itemID, menuID := model.MenuItemID(row.MenuItemID), model.MenuID(row.MenuID)
+		if _, ok := menus[menuID]; !ok {
+			menus[menuID] = &model.Menu{ID: menuID, Timezone: row.Timezone}
+			firstItemIDs[menuID] = itemID
+		}
+		if !slices.Contains(menuIDs[itemID], menuID) {
+			menuIDs[itemID] = append(menuIDs[itemID], menuID)
+		}
+		if row.ScheduleID.Valid && firstItemIDs[menuID] == itemID {
+			menus[menuID].Schedules = append(menus[menuID].Schedules, NewMenuSchedule(repository.MenuSchedule{
+				Weekdays:  row.Weekdays,
+				StartTime: row.StartTime,
+				EndTime:   row.EndTime,
+				StartDate: row.StartDate,
+				EndDate:   row.EndDate,
+			}))
+		}
+	}
+	active := make(map[model.MenuID]bool, len(menus))
+	for id, menu := range menus {
+		active[id] = menu.ActiveAt(now)
+	}
+	for _, item := range items {
+		item.MenuIDs = menuIDs[item.ID]
+		item.AvailableNow = item.AvailableAt(now, func(id model.MenuID) bool { return active[id] })
+	}
+	return nil
+}
+
+// loadMenus returns the menus of repoMenus with their schedules and menu items,
+// evaluating whether they are active at now
+func loadMenus(ctx context.Context, q *repository.Queries, repoMenus []repository.Menu, now time.Time) ([]*model.Menu, error) {
+	ids := make([]int16, len(repoMenus))
+	menus := make([]*model.Menu, len(repoMenus))
+	byID := make(map[int16]*model.Menu, len(repoMenus))
+	for i, repoMenu := range repoMenus {
+		ids[i] = repoMenu.ID
+		menus[i] = NewMenu(repoMenu)
+		byID[repoMenu.ID] = menus[i]
+	}
+	repoSchedules, err := q.ListMenuSchedules(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, repoSchedule := range repoSchedules {
+		menu := byID[repoSchedule.MenuID]
+		menu.Schedules = append(menu.Schedules, NewMenuSchedule(repoSchedule))
+	}
+	repoItems, err := q.ListMenuMenuItems(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, repoItem := range repoItems {
+		menu := byID[repoItem.MenuID]
+		menu.MenuItemIDs = append(menu.MenuItemIDs, model.MenuItemID(repoItem.MenuItemID))
+	}
+	for _, menu := range menus {
+		menu.Active = menu.ActiveAt(now)
+	}
+	return menus, nil
+}
+
+func (s *MenuService) CreateMenu(ctx context.Context, params model.CreateMenuParams) (*model.Menu, error) {
+	if err := validateMenuParams(&params); err != nil {
+		return nil, err
+	}
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	repoMenu, err := qtx.CreateMenu(ctx, repository.CreateMenuParams{
+		Name:        params.Name,
+		Description: pgtype.Text{String: params.Description, Valid: params.Description != ""},
+		Timezone:    params.Timezone,
+	})
+	if err != nil {
+		return nil, menuUniqueViolation(err)
+	}
+	return s.saveMenuContents(ctx, tx, qtx, repoMenu, params)
+}
+
+func (s *MenuService) GetMenu(ctx context.Context, id model.MenuID) (*model.Menu, error) {
+	repoMenu, err := s.queries.GetMenu(ctx, int16(id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMenuNotFound
+		}
+		return nil, err
+	}
+	menus, err := loadMenus(ctx, s.queries, []repository.Menu{repoMenu}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return menus[0], nil
+}
+
+func (s *MenuService) ListMenus(ctx context.Context) ([]*model.Menu, error) {
+	repoMenus, err := s.queries.ListMenus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return loadMenus(ctx, s.queries, repoMenus, time.Now())
+}
+
+// UpdateMenu replaces every field of the menu, including its schedules and menu items
+func (s *MenuService) UpdateMenu(ctx context.Context, id model.MenuID, params model.UpdateMenuParams) (*model.Menu, error) {
+	createParams := model.CreateMenuParams(params)
+	if err := validateMenuParams(&createParams); err != nil {
+		return nil, err
+	}
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	repoMenu, err := qtx.UpdateMenu(ctx, repository.UpdateMenuParams{
+		ID:          int16(id),
+		Name:        createParams.Name,
+		Description: pgtype.Text{String: createParams.Description, Valid: createParams.Description != ""},
+		Timezone:    createParams.Timezone,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMenuNotFound
+		}
+		return nil, menuUniqueViolation(err)
+	}
+	if err := qtx.DeleteMenuSchedules(ctx, repoMenu.ID); err != nil {
+		return nil, err
+	}
+	if err := qtx.DeleteMenuMenuItems(ctx, repoMenu.ID); err != nil {
+		return nil, err
+	}
+	return s.saveMenuContents(ctx, tx, qtx, repoMenu, createParams)
+}
+
+func (s *MenuService) DeleteMenu(ctx context.Context, id model.MenuID) error {
+	deleted, err := s.queries.DeleteMenu(ctx, int16(id))
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrMenuNotFound
+	}
+	return nil
+}
+
+// saveMenuContents adds the schedules and menu items of params to the menu, then commits tx
+func (s *MenuService) saveMenuContents(ctx context.Context, tx pgx.Tx, qtx *repository.Queries, repoMenu repository.Menu, params model.CreateMenuParams) (*model.Menu, error) {
+	for _, schedule := range params.Schedules {
+		weekdays := make([]int16, len(schedule.Weekdays))
+		for i, weekday := range schedule.Weekdays {
+			weekdays[i] = int16(weekday)
+		}
+		repoSchedule := repository.AddMenuScheduleParams{
+			MenuID:    repoMenu.ID,
+			Weekdays:  weekdays,
+			StartTime: pgtype.Time{Microseconds: int64(schedule.Start) * int64(time.Minute/time.Microsecond), Valid: true},
+			EndTime:   pgtype.Time{Microseconds: int64(schedule.End) * int64(time.Minute/time.Microsecond), Valid: true},
+		}
+		if schedule.StartDate != nil {
+			repoSchedule.StartDate = pgtype.Date{Time: *schedule.StartDate, Valid: true}
+		}
+		if schedule.EndDate != nil {
+			repoSchedule.EndDate = pgtype.Date{Time: *schedule.EndDate, Valid: true}
+		}
+		if err := qtx.AddMenuSchedule(ctx, repoSchedule); err != nil {
+			return nil, err
+		}
+	}
+
+	itemIDs := make([]int16, 0, len(params.MenuItemIDs))
+	for _, id := range params.MenuItemIDs {
+		if !slices.Contains(itemIDs, int16(id)) {
+			itemIDs = append(itemIDs, int16(id))
+		}
+	}
+	count, err := qtx.CountNotDeletedMenuItems(ctx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	if count != int64(len(itemIDs)) {
+		return nil, ErrMenuItemNotFound
+	}
+	if err := qtx.AddMenuMenuItems(ctx, repository.AddMenuMenuItemsParams{MenuID: repoMenu.ID, MenuItemIds: itemIDs}); err != nil {
+		return nil, err
+	}
+
+	menus, err := loadMenus(ctx, qtx, []repository.Menu{repoMenu}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return menus[0], tx.Commit(ctx)
+}
+
+// validateMenuParams checks params, defaulting the timezone to UTC
+func validateMenuParams(params *model.CreateMenuParams) error {
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" {
+		return status.Error(codes.InvalidArgument, "invalid menu: name must not be empty")
+	}
+	if params.Timezone == "" {
+		params.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(params.Timezone); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid menu: unknown timezone %q", params.Timezone)
+	}
+	for i, schedule := range params.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid menu: schedule %d: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
+// menuUniqueViolation maps violations of the unique name of menus to ErrMenuNameTaken
+func menuUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "menu_name_key" {
+		return ErrMenuNameTaken
+	}
+	return err
+}
+
+// UploadMenuItemPhoto reads a photo from r and stores it as the photo of the menu item,
+// along with its variants, replacing the previous photo
+func (s *MenuService) UploadMenuItemPhoto(ctx context.Context, id model.MenuItemID, r io.Reader) (*model.MenuItemPhoto, error) {
+	// Fail before receiving the whole photo when the menu item does not exist
+	if _, err := s.queries.GetNotDeletedMenuItem(ctx, int16(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMenuItemNotFound
+		}
+		return nil, err
+	}
+	data, err := io.ReadAll(io.LimitReader(r, s.photos.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > s.photos.MaxSize {
+		return nil, ErrPhotoTooLarge
+	}
+	p, err := photo.Process(data, s.photos)
+	switch {
+	case errors.Is(err, photo.ErrUnsupportedFormat):
+		return nil, ErrUnsupportedPhoto
+	case errors.Is(err, photo.ErrTooManyPixels):
+		return nil, ErrPhotoTooManyPixels
+	case err != nil:
+		return nil, err
+	}
+
+	// Every upload gets new keys, so that caches never serve a previous photo
+	prefix := fmt.Sprintf("menu-items/%d/%s/", id, uuid.New())
+	originalKey := prefix + "original" + photoExtensions[p.ContentType]
+	keys := []string{originalKey}
+	if err := s.storage.Put(ctx, originalKey, p.ContentType, data); err != nil {
+		return nil, err
+	}
+	variants := make([]storedPhotoVariant, len(p.Variants))
+	for i, v := range p.Variants {
+		variants[i] = storedPhotoVariant{Name: v.Name, Key: prefix + v.Name + ".jpg", Width: v.Width, Height: v.Height}
+		keys = append(keys, variants[i].Key)
+		if err := s.storage.Put(ctx, variants[i].Key, "image/jpeg", v.Data); err != nil {
+			s.deletePhotoFiles(keys)
+			return nil, err
+		}
+	}
+	variantsJSON, err := json.Marshal(variants)
+	if err != nil {
+		s.deletePhotoFiles(keys)
+		return nil, err
+	}
+
+	repoPhoto, previous, err := s.saveMenuItemPhoto(ctx, repository.SaveMenuItemPhotoParams{
+		MenuItemID:  int16(id),
+		ContentType: p.ContentType,
+		Width:       int32(p.Width),
+		Height:      int32(p.Height),
+		Blurhash:    p.Blurhash,
+		OriginalKey: originalKey,
+		Variants:    variantsJSON,
+	})
+	if err != nil {
+		s.deletePhotoFiles(keys)
+		return nil, err
+	}
+	if previous != nil {
+		s.deletePhotoFiles(photoKeys(*previous))
+	}
+	return s.newMenuItemPhoto(repoPhoto)
+}
+
+// saveMenuItemPhoto saves the photo of a menu item not deleted, returning the photo it replaced if any.
+// The photo path of the menu item, read with its order items, becomes the URL of the original.
+func (s *MenuService) saveMenuItemPhoto(ctx context.Context, params repository.SaveMenuItemPhotoParams) (repository.MenuItemPhoto, *repository.MenuItemPhoto, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return repository.MenuItemPhoto{}, nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	// Lock the menu item so that concurrent uploads replace each other in turn
+	if _, err := qtx.LockNotDeletedMenuItem(ctx, params.MenuItemID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.MenuItemPhoto{}, nil, ErrMenuItemNotFound
+		}
+		return repository.MenuItemPhoto{}, nil, err
+	}
+	var previous *repository.MenuItemPhoto
+	prev, err := qtx.GetMenuItemPhoto(ctx, params.MenuItemID)
+	if err == nil {
+		previous = &prev
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return repository.MenuItemPhoto{}, nil, err
+	}
+	repoPhoto, err := qtx.SaveMenuItemPhoto(ctx, params)
+	if err != nil {
+		return repository.MenuItemPhoto{}, nil, err
+	}
+	if err := qtx.UpdateMenuItemPhotoPathinfo(ctx, repository.UpdateMenuItemPhotoPathinfoParams{
+		ID:            params.MenuItemID,
+		PhotoPathinfo: pgtype.Text{String: s.storage.URL(params.OriginalKey), Valid: true},
+	}); err != nil {
+		return repository.MenuItemPhoto{}, nil, err
+	}
+	return repoPhoto, previous, tx.Commit(ctx)
+}
+
+// deletePhotoFiles deletes the files of keys, ignoring failures which only leave
+// unreferenced files behind
+func (s *MenuService) deletePhotoFiles(keys []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	for _, key := range keys {
+		s.storage.Delete(ctx, key)
+	}
+}
+
+// photoKeys returns the keys of the files of a photo
+func photoKeys(repoPhoto repository.MenuItemPhoto) []string {
+	keys := []string{repoPhoto.OriginalKey}
+	var variants []storedPhotoVariant
+	if err := json.Unmarshal(repoPhoto.Variants, &variants); err == nil {
+		for _, v := range variants {
+			keys = append(keys, v.Key)
+		}
+	}
+	return keys
+}
+
+func (s *MenuService) newMenuItemPhoto(repoPhoto repository.MenuItemPhoto) (*model.MenuItemPhoto, error) {
+	var variants []storedPhotoVariant
+	if err := json.Unmarshal(repoPhoto.Variants, &variants); err != nil {
+		return nil, err
+	}
+	p := &model.MenuItemPhoto{
+		ContentType: repoPhoto.ContentType,
+		Width:       int(repoPhoto.Width),
+		Height:      int(repoPhoto.Height),
+		Blurhash:    repoPhoto.Blurhash,
+		OriginalURL: s.storage.URL(repoPhoto.OriginalKey),
+		Variants:    make([]model.PhotoVariant, len(variants)),
+		CreatedAt:   repoPhoto.CreatedAt.Time,
+	}
+	for i, v := range variants {
+		p.Variants[i] = model.PhotoVariant{Name: v.Name, URL: s.storage.URL(v.Key), Width: v.Width, Height: v.Height}
+	}
+	return p, nil
+}
+
+// ExportMenu returns the menu items not deleted, along with every tag and dimension
+func (s *MenuService) ExportMenu(ctx context.Context) (*model.MenuDocument, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	menu, err := loadMenu(ctx, s.queries.WithTx(tx))
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &model.MenuDocument{
+		Dimensions: []model.MenuDocumentDimension{},
+		Tags:       []model.MenuDocumentTag{},
+		Items:      []model.MenuDocumentItem{},
+	}
+	for _, d := range menu.dimensions {
+		doc.Dimensions = append(doc.Dimensions, model.MenuDocumentDimension{
+			Key:         d.ExternalKey,
+			Value:       d.Value,
+			Description: d.Description.String,
+		})
+	}
+	for _, t := range menu.tags {
+		doc.Tags = append(doc.Tags, model.MenuDocumentTag{
+			Key:           t.ExternalKey,
+			Value:         t.Value,
+			Description:   t.Description.String,
+			Dimension:     menu.dimensionKey(t.Dimension),
+			Prerequisites: menu.prerequisites[t.ID],
+		})
+	}
+	for _, item := range menu.items {
+		if item.DeletedAt.Valid {
+			continue
+		}
+		doc.Items = append(doc.Items, model.MenuDocumentItem{
+			Key:             item.ExternalKey,
+			Name:            item.Name,
+			Description:     item.Description.String,
+			PhotoPathinfo:   item.PhotoPathinfo.String,
+			Price:           item.Price,
+			PortionSize:     item.PortionSize,
+			Available:       item.Available,
+			ModifiersConfig: item.ModifiersConfig,
+			Tags:            menu.itemTags[item.ID],
+		})
+	}
+	return doc, nil
+}
+
+// ImportMenu creates or updates the dimensions, tags and items of doc by key, replacing the
+// prerequisites of the tags and the tags of the items, and restoring deleted items.
+// Entries of the menu missing from doc are left as is. Changes are applied in a single
+// transaction, rolled back on any error or when dryRun only reports them.
+func (s *MenuService) ImportMenu(ctx context.Context, doc *model.MenuDocument, dryRun bool) (*model.MenuImportResult, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	menu, err := loadMenu(ctx, qtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMenuDocument(doc, menu); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid menu: %v", err)
+	}
+
+	result := &model.MenuImportResult{Changes: []model.MenuChange{}}
+	record := func(kind model.MenuEntityKind, key string, exists bool, fields []string) bool {
+		switch {
+		case !exists:
+			result.Changes = append(result.Changes, model.MenuChange{Kind: kind, Key: key, Action: model.MenuChangeCreate})
+		case len(fields) > 0:
+			result.Changes = append(result.Changes, model.MenuChange{Kind: kind, Key: key, Action: model.MenuChangeUpdate, Fields: fields})
+		default:
+			result.Unchanged++
+			return false
+		}
+		return true
+	}
+
+	for _, d := range doc.Dimensions {
+		current, exists := menu.dimensionsByKey[d.Key]
+		var fields []string
+		if exists {
+			fields = changedFields(
+				fieldChange{"value", current.Value != d.Value},
+				fieldChange{"description", current.Description.String != d.Description},
+			)
+		}
+		if !record(model.MenuEntityDimension, d.Key, exists, fields) {
+			continue
+		}
+		row, err := qtx.UpsertMenuTagDimension(ctx, repository.UpsertMenuTagDimensionParams{
+			ExternalKey: d.Key,
+			Value:       d.Value,
+			Description: pgtype.Text{String: d.Description, Valid: d.Description != ""},
+		})
+		if err != nil {
+			return nil, err
+		}
+		menu.dimensionsByKey[d.Key] = row
+	}
+
+	// Tags are all upserted before their prerequisites, which may be tags of doc
+	prerequisitesChanged := make(map[string]bool)
+	for _, t := range doc.Tags {
+		current, exists := menu.tagsByKey[t.Key]
+		var fields []string
+		if exists {
+			fields = changedFields(
+				fieldChange{"value", current.Value != t.Value},
+				fieldChange{"description", current.Description.String != t.Description},
+				fieldChange{"dimension", menu.dimensionKey(current.Dimension) != t.Dimension},
+				fieldChange{"prerequisites", !sameKeys(menu.prerequisites[current.ID], t.Prerequisites)},
+			)
+		}
+		if !record(model.MenuEntityTag, t.Key, exists, fields) {
+			continue
+		}
+		prerequisitesChanged[t.Key] = !exists || slices.Contains(fields, "prerequisites")
+		var dimension pgtype.Int2
+		if t.Dimension != "" {
+			dimension = pgtype.Int2{Int16: menu.dimensionsByKey[t.Dimension].ID, Valid: true}
+		}
+		row, err := qtx.UpsertMenuTag(ctx, repository.UpsertMenuTagParams{
+			ExternalKey: t.Key,
+			Value:       t.Value,
+			Description: pgtype.Text{String: t.Description, Valid: t.Description != ""},
+			Dimension:   dimension,
+		})
+		if err != nil {
+			return nil, err
+		}
+		menu.tagsByKey[t.Key] = row
+	}
+	for _, t := range doc.Tags {
+		if !prerequisitesChanged[t.Key] {
+			continue
+		}
+		tagID := menu.tagsByKey[t.Key].ID
+		if err := qtx.DeleteMenuTagPrerequisites(ctx, tagID); err != nil {
+			return nil, err
+		}
+		for _, key := range uniqueKeys(t.Prerequisites) {
+			if err := qtx.AddMenuTagPrerequisite(ctx, repository.AddMenuTagPrerequisiteParams{
+				MenuTagID:         tagID,
+				PrerequisiteTagID: menu.tagsByKey[key].ID,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, item := range doc.Items {
+		portionSize := item.PortionSize
+		if portionSize == 0 {
+			portionSize = 1
+		}
+		modifiersConfig := []byte(item.ModifiersConfig)
+		if isJSONNull(modifiersConfig) {
+			modifiersConfig = nil
+		}
+		current, exists := menu.itemsByKey[item.Key]
+		var fields []string
+		if exists {
+			fields = changedFields(
+				fieldChange{"name", current.Name != item.Name},
+				fieldChange{"description", current.Description.String != item.Description},
+				fieldChange{"photo_pathinfo", current.PhotoPathinfo.String != item.PhotoPathinfo},
+				fieldChange{"price", current.Price != item.Price},
+				fieldChange{"portion_size", current.PortionSize != portionSize},
+				fieldChange{"available", current.Available != item.Available},
+				fieldChange{"modifiers_config", !sameJSON(current.ModifiersConfig, modifiersConfig)},
+				fieldChange{"tags", !sameKeys(menu.itemTags[current.ID], item.Tags)},
+				fieldChange{"deleted_at", current.DeletedAt.Valid},
+			)
+		}
+		if !record(model.MenuEntityItem, item.Key, exists, fields) {
+			continue
+		}
+		row, err := qtx.UpsertMenuItem(ctx, repository.UpsertMenuItemParams{
+			ExternalKey:     item.Key,
+			Name:            item.Name,
+			Description:     pgtype.Text{String: item.Description, Valid: item.Description != ""},
+			PhotoPathinfo:   pgtype.Text{String: item.PhotoPathinfo, Valid: item.PhotoPathinfo != ""},
+			Price:           item.Price,
+			PortionSize:     portionSize,
+			Available:       item.Available,
+			ModifiersConfig: modifiersConfig,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !exists || slices.Contains(fields, "tags") {
+			if err := qtx.DeleteMenuItemTags(ctx, row.ID); err != nil {
+				return nil, err
+			}
+			for _, key := range uniqueKeys(item.Tags) {
+				if err := qtx.AddMenuItemTag(ctx, repository.AddMenuItemTagParams{
+					MenuItemID: row.ID,
+					MenuTagID:  menu.tagsByKey[key].ID,
+				}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	result.Applied = true
+	return result, nil
+}
+
+// menuSnapshot is the whole menu, as exported and as compared to imported documents
+type menuSnapshot struct {
+	dimensions      []repository.MenuTagDimension
+	tags            []repository.MenuTag
+	items           []repository.MenuItem
+	dimensionsByKey map[string]repository.MenuTagDimension
+	tagsByKey       map[string]repository.MenuTag
+	itemsByKey      map[string]repository.MenuItem
+	dimensionKeys   map[int16]string
+	// The sorted keys of the prerequisites of every tag, and of the tags of every item
+	prerequisites map[int16][]string
+	itemTags      map[int16][]string
+}
+
+func loadMenu(ctx context.Context, q *repository.Queries) (*menuSnapshot, error) {
+	var (
+		m   menuSnapshot
+		err error
+	)
+	if m.dimensions, err = q.ListMenuTagDimensions(ctx); err != nil {
+		return nil, err
+	}
+	if m.tags, err = q.ListMenuTags(ctx); err != nil {
+		return nil, err
+	}
+	if m.items, err = q.ListAllMenuItems(ctx); err != nil {
+		return nil, err
+	}
+	prerequisites, err := q.ListMenuTagPrerequisites(ctx)
+	if err != nil {
+		return nil, err
+	}
+	itemTags, err := q.ListMenuItemTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.dimensionsByKey = make(map[string]repository.MenuTagDimension, len(m.dimensions))
+	m.dimensionKeys = make(map[int16]string, len(m.dimensions))
+	for _, d := range m.dimensions {
+		m.dimensionsByKey[d.ExternalKey] = d
+		m.dimensionKeys[d.ID] = d.ExternalKey
+	}
+	m.tagsByKey = make(map[string]repository.MenuTag, len(m.tags))
+	tagKeys := make(map[int16]string, len(m.tags))
+	for _, t := range m.tags {
+		m.tagsByKey[t.ExternalKey] = t
+		tagKeys[t.ID] = t.ExternalKey
+	}
+	m.itemsByKey = make(map[string]repository.MenuItem, len(m.items))
+	for _, item := range m.items {
+		m.itemsByKey[item.ExternalKey] = item
+	}
+	m.prerequisites = make(map[int16][]string)
+	for _, p := range prerequisites {
+		m.prerequisites[p.MenuTagID] = append(m.prerequisites[p.MenuTagID], tagKeys[p.PrerequisiteTagID])
+	}
+	m.itemTags = make(map[int16][]string)
+	for _, it := range itemTags {
+		m.itemTags[it.MenuItemID] = append(m.itemTags[it.MenuItemID], tagKeys[it.MenuTagID])
+	}
+	for _, keys := range m.prerequisites {
+		slices.Sort(keys)
+	}
+	for _, keys := range m.itemTags {
+		slices.Sort(keys)
+	}
+	return &m, nil
+}
+
+// dimensionKey returns the key of the dimension id, empty if NULL
+func (m *menuSnapshot) dimensionKey(id pgtype.Int2) string {
+	if !id.Valid {
+		return ""
+	}
+	return m.dimensionKeys[id.Int16]
+}
+
+// validateMenuDocument reports every invalid entry of doc, whose references must be
+// keys of doc or of the menu
+func validateMenuDocument(doc *model.MenuDocument, menu *menuSnapshot) error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	checkKey := func(kind model.MenuEntityKind, key string, seen map[string]bool) {
+		check(key != "" && strings.TrimSpace(key) == key && !strings.Contains(key, menufile.ListSeparator),
+			"%s key %q must not be empty, contain %s nor surrounding spaces", kind, key, menufile.ListSeparator)
+		check(!seen[key], "%s key %q is not unique", kind, key)
+		seen[key] = true
+	}
+
+	dimensions := make(map[string]bool, len(doc.Dimensions))
+	for _, d := range doc.Dimensions {
+		checkKey(model.MenuEntityDimension, d.Key, dimensions)
+		check(d.Value != "", "dimension %q must have a value", d.Key)
+	}
+	tags := make(map[string]bool, len(doc.Tags))
+	for _, t := range doc.Tags {
+		checkKey(model.MenuEntityTag, t.Key, tags)
+	}
+	tagExists := func(key string) bool {
+		_, ok := menu.tagsByKey[key]
+		return tags[key] || ok
+	}
+	for _, t := range doc.Tags {
+		check(t.Value != "", "tag %q must have a value", t.Key)
+		_, ok := menu.dimensionsByKey[t.Dimension]
+		check(t.Dimension == "" || dimensions[t.Dimension] || ok, "tag %q has an unknown dimension %q", t.Key, t.Dimension)
+		for _, key := range t.Prerequisites {
+			check(tagExists(key), "tag %q has an unknown prerequisite %q", t.Key, key)
+			check(key != t.Key, "tag %q is its own prerequisite", t.Key)
+		}
+	}
+	items := make(map[string]bool, len(doc.Items))
+	for _, item := range doc.Items {
+		checkKey(model.MenuEntityItem, item.Key, items)
+		check(item.Name != "", "item %q must have a name", item.Key)
+		check(item.Price >= 0, "item %q must not have a negative price", item.Key)
+		check(item.PortionSize >= 0, "item %q must not have a negative portion_size", item.Key)
+		var config map[string]json.RawMessage
+		check(len(item.ModifiersConfig) == 0 || isJSONNull(item.ModifiersConfig) || json.Unmarshal(item.ModifiersConfig, &config) == nil,
+			"item %q must have a JSON object as modifiers_config", item.Key)
+		for _, key := range item.Tags {
+			check(tagExists(key), "item %q has an unknown tag %q", item.Key, key)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// fieldChange tells whether an import changes the field name of an entry
+type fieldChange struct {
+	name    string
+	changed bool
+}
+
+// changedFields returns the names of the fields which changed
+func changedFields(changes ...fieldChange) []string {
+	var fields []string
+	for _, change := range changes {
+		if change.changed {
+			fields = append(fields, change.name)
+		}
+	}
+	return fields
+}
+
+// uniqueKeys returns keys sorted without duplicates
+func uniqueKeys(keys []string) []string {
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// sameKeys reports whether the sorted current keys are the keys, in any order
+func sameKeys(current, keys []string) bool {
+	return slices.Equal(current, uniqueKeys(keys))
+}
+
+func isJSONNull(data []byte) bool {
+	return strings.TrimSpace(string(data)) == "null"
+}
+
+// sameJSON reports whether a and b are the same JSON values, or both empty
+func sameJSON(a, b []byte) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
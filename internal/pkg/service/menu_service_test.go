@@ -0,0 +1,271 @@
+package service
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/model"
+	"restaurant-ordering-system/internal/pkg/storage"
+	"restaurant-ordering-system/internal/pkg/storage/s3test"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMenuServiceUploadPhoto(t *testing.T) {
+	db, _ := newTestStores(t)
+	ctx := t.Context()
+
+	server := s3test.NewServer()
+	defer server.Close()
+	menuService := NewMenuService(db, storage.NewS3Storage(server.Config(), "https://cdn.example.com", nil), testPhotos)
+
+	menuItem, err := menuService.CreateMenuItem(ctx, model.CreateMenuItemParams{Name: "Ramen", Price: 100, PortionSize: 1, Available: true})
+	require.NoError(t, err)
+
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for y := range 20 {
+		for x := range 40 {
+			img.Set(x, y, color.RGBA{R: uint8(x * 6), G: 100, B: uint8(y * 12), A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	uploaded, err := menuService.UploadMenuItemPhoto(ctx, menuItem.ID, bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, "image/png", uploaded.ContentType)
+	require.Equal(t, 40, uploaded.Width)
+	require.NotEmpty(t, uploaded.Blurhash)
+	require.True(t, strings.HasPrefix(uploaded.OriginalURL, "https://cdn.example.com/menu-items/"+menuItem.ID.String()+"/"))
+	require.True(t, strings.HasSuffix(uploaded.OriginalURL, "/original.png"))
+	require.Len(t, uploaded.Variants, 2)
+	require.Equal(t, model.PhotoVariant{Name: "thumbnail", URL: uploaded.Variants[0].URL, Width: 16, Height: 8}, uploaded.Variants[0])
+	// The large variant is not enlarged beyond the original
+	require.Equal(t, 40, uploaded.Variants[1].Width)
+	require.Len(t, server.Keys(), 3)
+
+	item, err := menuService.GetMenuItem(ctx, menuItem.ID)
+	require.NoError(t, err)
+	require.Equal(t, uploaded.Variants, item.Photo.Variants)
+	// The photo path read with order items follows the uploaded photo
+	require.Equal(t, uploaded.OriginalURL, item.PhotoPathinfo)
+	items, err := menuService.ListMenuItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, uploaded.OriginalURL, items[0].Photo.OriginalURL)
+
+	// A new photo replaces the files of the previous one
+	replaced, err := menuService.UploadMenuItemPhoto(ctx, menuItem.ID, bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.NotEqual(t, uploaded.OriginalURL, replaced.OriginalURL)
+	item, err = menuService.GetMenuItem(ctx, menuItem.ID)
+	require.NoError(t, err)
+	require.Equal(t, replaced.OriginalURL, item.PhotoPathinfo)
+	require.Len(t, server.Keys(), 3)
+	for _, key := range server.Keys() {
+		require.Contains(t, replaced.OriginalURL, strings.Join(strings.Split(key, "/")[:3], "/"))
+	}
+
+	_, err = menuService.UploadMenuItemPhoto(ctx, menuItem.ID, strings.NewReader("GIF89a not a supported image"))
+	require.ErrorIs(t, err, ErrUnsupportedPhoto)
+	_, err = menuService.UploadMenuItemPhoto(ctx, menuItem.ID, bytes.NewReader(make([]byte, testPhotos.MaxSize+1)))
+	require.ErrorIs(t, err, ErrPhotoTooLarge)
+	_, err = menuService.UploadMenuItemPhoto(ctx, menuItem.ID+1, bytes.NewReader(buf.Bytes()))
+	require.ErrorIs(t, err, ErrMenuItemNotFound)
+	require.Len(t, server.Keys(), 3)
+}
+
+func TestMenuServiceImportExport(t *testing.T) {
+	db, _ := newTestStores(t)
+	ctx := t.Context()
+	menuService := newTestMenuService(t, db)
+
+	existing, err := menuService.CreateMenuItem(ctx, model.CreateMenuItemParams{Name: "Tea", Price: 30, PortionSize: 1, Available: true})
+	require.NoError(t, err)
+	doc := &model.MenuDocument{
+		Dimensions: []model.MenuDocumentDimension{{Key: "diet", Value: "Dietary"}},
+		Tags: []model.MenuDocumentTag{
+			{Key: "vegan", Value: "Vegan", Dimension: "diet", Prerequisites: []string{"vegetarian"}},
+			{Key: "vegetarian", Value: "Vegetarian", Dimension: "diet"},
+		},
+		Items: []model.MenuDocumentItem{
+			{Key: "curry", Name: "Curry", Price: 1000, Available: true, ModifiersConfig: []byte(`{"spice": ["mild", "hot"]}`), Tags: []string{"vegan"}},
+		},
+	}
+
+	// A dry run reports the changes without applying them
+	result, err := menuService.ImportMenu(ctx, doc, true)
+	require.NoError(t, err)
+	require.False(t, result.Applied)
+	require.Len(t, result.Changes, 4)
+	items, err := menuService.ListMenuItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	result, err = menuService.ImportMenu(ctx, doc, false)
+	require.NoError(t, err)
+	require.True(t, result.Applied)
+	require.Equal(t, model.MenuChange{Kind: model.MenuEntityItem, Key: "curry", Action: model.MenuChangeCreate}, result.Changes[3])
+
+	exported, err := menuService.ExportMenu(ctx)
+	require.NoError(t, err)
+	require.Len(t, exported.Items, 2)
+	require.Equal(t, "Curry", exported.Items[0].Name)
+	require.Equal(t, int16(1), exported.Items[0].PortionSize)
+	require.Equal(t, []string{"vegan"}, exported.Items[0].Tags)
+	require.Equal(t, []string{"vegetarian"}, exported.Tags[0].Prerequisites)
+	require.Equal(t, "Tea", exported.Items[1].Name)
+	require.NotEmpty(t, exported.Items[1].Key, "items created otherwise get a key")
+
+	// Importing an export changes nothing, and upserts by key
+	result, err = menuService.ImportMenu(ctx, exported, false)
+	require.NoError(t, err)
+	require.Empty(t, result.Changes)
+	require.Equal(t, 5, result.Unchanged)
+	exported.Items[1].Price = 35
+	exported.Items[1].Tags = []string{"vegan"}
+	require.NoError(t, menuService.DeleteMenuItem(ctx, existing.ID))
+	result, err = menuService.ImportMenu(ctx, exported, false)
+	require.NoError(t, err)
+	require.Equal(t, []model.MenuChange{{
+		Kind: model.MenuEntityItem, Key: exported.Items[1].Key, Action: model.MenuChangeUpdate,
+		Fields: []string{"price", "tags", "deleted_at"},
+	}}, result.Changes)
+	item, err := menuService.GetMenuItem(ctx, existing.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(35), item.Price)
+	require.Nil(t, item.DeletedAt)
+
+	// Invalid documents are rejected as a whole
+	_, err = menuService.ImportMenu(ctx, &model.MenuDocument{
+		Items: []model.MenuDocumentItem{
+			{Key: "soup", Name: "Soup", Tags: []string{"spicy"}},
+			{Key: "soup", Name: "Soup again", Price: -1},
+		},
+	}, false)
+	require.ErrorContains(t, err, `item "soup" has an unknown tag "spicy"`)
+	require.ErrorContains(t, err, `item key "soup" is not unique`)
+	require.ErrorContains(t, err, `item "soup" must not have a negative price`)
+	items, err = menuService.ListMenuItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+}
+
+func TestMenuServiceMenus(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+	menuService := newTestMenuService(t, db)
+	cacheService := NewCacheService(db, rdb)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+
+	menuItemIDs := map[string]model.MenuItemID{}
+	for _, name := range []string{"Pancakes", "Steak", "Water"} {
+		menuItem, err := menuService.CreateMenuItem(ctx, model.CreateMenuItemParams{Name: name, Price: 100, PortionSize: 1, Available: true})
+		require.NoError(t, err)
+		menuItemIDs[name] = menuItem.ID
+	}
+
+	// Breakfast was only served in the past, and dinner is served all day
+	past := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	breakfast, err := menuService.CreateMenu(ctx, model.CreateMenuParams{
+		Name:     "Breakfast",
+		Timezone: "Europe/Paris",
+		Schedules: []model.MenuSchedule{
+			{Weekdays: []time.Weekday{time.Saturday}, Start: 7 * 60, End: 11 * 60, EndDate: &past},
+		},
+		MenuItemIDs: []model.MenuItemID{menuItemIDs["Pancakes"], menuItemIDs["Pancakes"]},
+	})
+	require.NoError(t, err)
+	require.False(t, breakfast.Active)
+	require.Equal(t, []model.MenuItemID{menuItemIDs["Pancakes"]}, breakfast.MenuItemIDs)
+	require.Equal(t, model.TimeOfDay(11*60), breakfast.Schedules[0].End)
+	require.Equal(t, past, *breakfast.Schedules[0].EndDate)
+	dinner, err := menuService.CreateMenu(ctx, model.CreateMenuParams{Name: "Dinner", MenuItemIDs: []model.MenuItemID{menuItemIDs["Steak"]}})
+	require.NoError(t, err)
+	require.True(t, dinner.Active)
+	require.Equal(t, "UTC", dinner.Timezone)
+
+	items, err := menuService.ListMenuItems(ctx)
+	require.NoError(t, err)
+	availableNow := map[string]bool{}
+	for _, item := range items {
+		availableNow[item.Name] = item.AvailableNow
+	}
+	require.Equal(t, map[string]bool{"Pancakes": false, "Steak": true, "Water": true}, availableNow)
+
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	orderID := tab.Orders[len(tab.Orders)-1].ID
+	pancakes := model.CreateOrderItemParams{OrderID: orderID, MenuItemID: menuItemIDs["Pancakes"], Quantity: 1}
+	_, err = orderService.CreateOrderItem(ctx, pancakes)
+	require.ErrorIs(t, err, ErrMenuItemUnavailable)
+
+	// An override makes pancakes available outside of breakfast, until it ends
+	until := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+	item, err := menuService.SetMenuItemAvailabilityOverride(ctx, menuItemIDs["Pancakes"], &model.AvailabilityOverride{Available: true, Until: &until})
+	require.NoError(t, err)
+	require.True(t, item.AvailableNow)
+	require.Equal(t, []model.MenuID{breakfast.ID}, item.MenuIDs)
+	require.True(t, until.Equal(*item.AvailabilityOverride.Until))
+	_, err = orderService.CreateOrderItem(ctx, pancakes)
+	require.NoError(t, err)
+	item, err = menuService.SetMenuItemAvailabilityOverride(ctx, menuItemIDs["Pancakes"], nil)
+	require.NoError(t, err)
+	require.Nil(t, item.AvailabilityOverride)
+	require.False(t, item.AvailableNow)
+
+	// Without schedules, breakfast is always served
+	breakfast, err = menuService.UpdateMenu(ctx, breakfast.ID, model.UpdateMenuParams{Name: "Breakfast", MenuItemIDs: []model.MenuItemID{menuItemIDs["Pancakes"]}})
+	require.NoError(t, err)
+	require.True(t, breakfast.Active)
+	require.Empty(t, breakfast.Schedules)
+	item, err = menuService.GetMenuItem(ctx, menuItemIDs["Pancakes"])
+	require.NoError(t, err)
+	require.True(t, item.AvailableNow)
+
+	// Items sharing a menu with several schedules are available while any of them is open
+	brunch, err := menuService.CreateMenu(ctx, model.CreateMenuParams{
+		Name: "Brunch",
+		Schedules: []model.MenuSchedule{
+			{Start: 10 * 60, End: 14 * 60, EndDate: &past},
+			{Start: 0, End: 0},
+		},
+		MenuItemIDs: []model.MenuItemID{menuItemIDs["Pancakes"], menuItemIDs["Water"]},
+	})
+	require.NoError(t, err)
+	items, err = menuService.ListMenuItems(ctx)
+	require.NoError(t, err)
+	menuIDs := map[string][]model.MenuID{}
+	for _, item := range items {
+		availableNow[item.Name] = item.AvailableNow
+		menuIDs[item.Name] = item.MenuIDs
+	}
+	require.Equal(t, map[string]bool{"Pancakes": true, "Steak": true, "Water": true}, availableNow)
+	require.Equal(t, []model.MenuID{breakfast.ID, brunch.ID}, menuIDs["Pancakes"])
+	require.Equal(t, []model.MenuID{brunch.ID}, menuIDs["Water"])
+
+	_, err = menuService.CreateMenu(ctx, model.CreateMenuParams{Name: "Dinner"})
+	require.ErrorIs(t, err, ErrMenuNameTaken)
+	_, err = menuService.CreateMenu(ctx, model.CreateMenuParams{Name: "Lunch", Timezone: "Nowhere/Unknown"})
+	require.ErrorContains(t, err, "unknown timezone")
+	_, err = menuService.CreateMenu(ctx, model.CreateMenuParams{Name: "Lunch", Schedules: []model.MenuSchedule{{Weekdays: []time.Weekday{7}}}})
+	require.ErrorContains(t, err, "schedule 1: weekday 7")
+	_, err = menuService.CreateMenu(ctx, model.CreateMenuParams{Name: "Lunch", MenuItemIDs: []model.MenuItemID{menuItemIDs["Water"] + 1}})
+	require.ErrorIs(t, err, ErrMenuItemNotFound)
+
+	menus, err := menuService.ListMenus(ctx)
+	require.NoError(t, err)
+	require.Len(t, menus, 3)
+	require.NoError(t, menuService.DeleteMenu(ctx, dinner.ID))
+	require.ErrorIs(t, menuService.DeleteMenu(ctx, dinner.ID), ErrMenuNotFound)
+	_, err = menuService.GetMenu(ctx, dinner.ID)
+	require.ErrorIs(t, err, ErrMenuNotFound)
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "restaurant-ordering-system/internal/pkg/service"
+
+// cacheMetrics holds the instruments describing the behavior of the tab cache
+type cacheMetrics struct {
+	hits           metric.Int64Counter
+	misses         metric.Int64Counter
+	rebuildLatency metric.Float64Histogram
+	rebuildShared  metric.Int64Counter
+	watchConflicts metric.Int64Counter
+	tabKeys        metric.Int64Histogram
+	unavailable    metric.Int64Counter
+}
+
+func newCacheMetrics() *cacheMetrics {
+	meter := otel.Meter(meterName)
+	m := new(cacheMetrics)
+	// Instrument creation only fails on invalid names, which are constants here.
+	m.hits, _ = meter.Int64Counter("cache.tab.hits",
+		metric.WithDescription("Number of tab reads served from the cache"),
+	)
+	m.misses, _ = meter.Int64Counter("cache.tab.misses",
+		metric.WithDescription("Number of tab reads that fell back to the database"),
+	)
+	m.rebuildLatency, _ = meter.Float64Histogram("cache.tab.rebuild.duration",
+		metric.WithDescription("Time taken to load a tab from the database and cache it"),
+		metric.WithUnit("s"),
+	)
+	m.rebuildShared, _ = meter.Int64Counter("cache.tab.rebuild.shared",
+		metric.WithDescription("Number of tab rebuilds whose result was shared by singleflight"),
+	)
+	m.watchConflicts, _ = meter.Int64Counter("cache.watch.conflicts",
+		metric.WithDescription("Number of optimistic transactions aborted because a watched key changed"),
+	)
+	m.tabKeys, _ = meter.Int64Histogram("cache.tab.keys",
+		metric.WithDescription("Number of keys written when caching a tab"),
+	)
+	m.unavailable, _ = meter.Int64Counter("cache.unavailable",
+		metric.WithDescription("Number of operations degraded or rejected because the cache was unreachable"),
+	)
+	return m
+}
+
+func (m *cacheMetrics) hit(ctx context.Context, operation string) {
+	m.hits.Add(ctx, 1, metric.WithAttributes(attribute.String("operation", operation)))
+}
+
+func (m *cacheMetrics) miss(ctx context.Context, operation string) {
+	m.misses.Add(ctx, 1, metric.WithAttributes(attribute.String("operation", operation)))
+}
+
+func (m *cacheMetrics) watchConflict(ctx context.Context, operation string) {
+	m.watchConflicts.Add(ctx, 1, metric.WithAttributes(attribute.String("operation", operation)))
+}
+
+func (m *cacheMetrics) unavailableCache(ctx context.Context, operation string) {
+	m.unavailable.Add(ctx, 1, metric.WithAttributes(attribute.String("operation", operation)))
+}
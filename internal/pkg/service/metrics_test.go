@@ -0,0 +1,107 @@
+package service
+
+import (
+	"testing"
+
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// newTestMeterReader installs a global meter provider whose metrics are read on demand
+func newTestMeterReader(t *testing.T) *sdkmetric.ManualReader {
+	reader := sdkmetric.NewManualReader()
+	previous := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+	return reader
+}
+
+// collectMetrics returns the metrics of the service package recorded so far, by name
+func collectMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	metrics := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		if sm.Scope.Name != meterName {
+			continue
+		}
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	return metrics
+}
+
+// counterValue returns the value of a counter for operation
+func counterValue(metrics map[string]metricdata.Aggregation, name, operation string) int64 {
+	sum, ok := metrics[name].(metricdata.Sum[int64])
+	if !ok {
+		return 0
+	}
+	for _, point := range sum.DataPoints {
+		if v, _ := point.Attributes.Value("operation"); v == attribute.StringValue(operation) {
+			return point.Value
+		}
+	}
+	return 0
+}
+
+func TestCacheMetrics(t *testing.T) {
+	reader := newTestMeterReader(t)
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	cacheService := NewCacheService(db, rdb)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+	menuItem, err := newTestMenuService(t, db).CreateMenuItem(ctx, model.CreateMenuItemParams{
+		Name:        "Dumplings",
+		Price:       100,
+		PortionSize: 1,
+		Available:   true,
+	})
+	require.NoError(t, err)
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	// Evict the tab, so that it is rebuilt from the database on its next read
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	require.NoError(t, rdb.FlushAll(ctx).Err())
+
+	// A unary read misses the cache and rebuilds the tab, then hits it
+	_, err = tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	metrics := collectMetrics(t, reader)
+	require.Equal(t, int64(1), counterValue(metrics, "cache.tab.misses", "GetOpenTab"))
+	hits := counterValue(metrics, "cache.tab.hits", "GetOpenTab")
+	rebuilds, ok := metrics["cache.tab.rebuild.duration"].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, rebuilds.DataPoints, 1)
+	require.Equal(t, uint64(1), rebuilds.DataPoints[0].Count)
+	keys, ok := metrics["cache.tab.keys"].(metricdata.Histogram[int64])
+	require.True(t, ok)
+	require.Equal(t, uint64(1), keys.DataPoints[0].Count)
+
+	// Opening an events stream reads the cached tab
+	_, _, err = tabService.GetTabEventsCursor(ctx, tabID, "")
+	require.NoError(t, err)
+	metrics = collectMetrics(t, reader)
+	require.Equal(t, hits+1, counterValue(metrics, "cache.tab.hits", "GetOpenTab"))
+	require.Equal(t, int64(1), counterValue(metrics, "cache.tab.misses", "GetOpenTab"))
+
+	// Mutations of the order not sent yet check it in the cache
+	_, err = orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID:    tab.Orders[0].ID,
+		MenuItemID: menuItem.ID,
+		Quantity:   1,
+	})
+	require.NoError(t, err)
+	metrics = collectMetrics(t, reader)
+	require.Equal(t, int64(1), counterValue(metrics, "cache.tab.hits", "checkOrderNotSent"))
+	require.Zero(t, counterValue(metrics, "cache.unavailable", "checkOrderNotSent"))
+}
@@ -2,16 +2,24 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
+	"restaurant-ordering-system/internal/pkg/config"
 	"restaurant-ordering-system/internal/pkg/model"
 	"restaurant-ordering-system/internal/pkg/repository"
 	"restaurant-ordering-system/internal/pkg/repository/cache"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func NewOrder(repoOrder repository.OrderWithItems) *model.Order {
@@ -69,35 +77,52 @@ func NewOrderItem(repoItem repository.OrderItemWithMenu) *model.OrderItem {
 	}
 }
 
+var (
+	ErrTooManyDraftItems     = status.Error(codes.ResourceExhausted, "too many items in the order")
+	ErrMenuItemUnavailable   = status.Error(codes.FailedPrecondition, "menu item is not available")
+	ErrInvalidReorderSource  = status.Error(codes.InvalidArgument, "either a source order or a source tab must be given")
+	ErrReorderSourceNotFound = status.Error(codes.NotFound, "source not found in the visit history")
+	ErrOrderItemNotFound     = status.Error(codes.NotFound, "order item not found")
+)
+
 type OrderService struct {
 	db           *pgxpool.Pool
-	rdb          *redis.Client
+	rdb          redis.UniversalClient
 	queries      *repository.Queries
 	rqueries     *cache.RedisQueries
 	cacheService *CacheService
+	limits       config.TabLimitsConfig
 }
 
-func NewOrderService(db *pgxpool.Pool, rdb *redis.Client, cacheService *CacheService) *OrderService {
+func NewOrderService(db *pgxpool.Pool, rdb redis.UniversalClient, cacheService *CacheService, limits config.TabLimitsConfig) *OrderService {
 	return &OrderService{
 		db:           db,
 		rdb:          rdb,
 		queries:      repository.New(db),
 		rqueries:     cache.New(rdb),
 		cacheService: cacheService,
+		limits:       limits,
 	}
 }
 
 func (s *OrderService) CreateOrderItem(ctx context.Context, params model.CreateOrderItemParams) (model.OrderItemID, error) {
-	if params.Quantity < 1 {
-		return model.OrderItemID{}, errors.New("quantity is < 1")
+	if err := s.checkQuantity(params.Quantity); err != nil {
+		return model.OrderItemID{}, err
 	}
 
-	menuItem, err := s.queries.GetNotDeletedMenuItem(ctx, int16(params.MenuItemID))
+	repoMenuItem, err := s.queries.GetNotDeletedMenuItem(ctx, int16(params.MenuItemID))
 	if err != nil {
 		return model.OrderItemID{}, err
 	}
-	if !menuItem.Available {
-		return model.OrderItemID{}, errors.New("menu item is not available")
+	menuItem := NewMenuItem(repoMenuItem)
+	if err := setAvailability(ctx, s.queries, []*model.MenuItem{menuItem}, time.Now()); err != nil {
+		return model.OrderItemID{}, err
+	}
+	if !menuItem.AvailableNow {
+		return model.OrderItemID{}, ErrMenuItemUnavailable
+	}
+	if err := validateModifiers(params.Modifiers, menuItem.ModifiersConfig); err != nil {
+		return model.OrderItemID{}, err
 	}
 
 	visitingGuestIDs := make([]model.GuestID, 0, len(params.GuestOwnerIDs))
@@ -121,6 +146,15 @@ func (s *OrderService) CreateOrderItem(ctx context.Context, params model.CreateO
 
 	var orderItemID model.OrderItemID
 	if err := s.checkOrderNotSent(ctx, params.OrderID, func(tx *redis.Tx) error {
+		// The items are not watched, concurrent creations may exceed the maximum slightly
+		count, err := cache.New(tx).CountNotSentOrderItems(ctx, params.OrderID.TabID)
+		if err != nil {
+			return err
+		}
+		if count >= int64(s.limits.MaxDraftItems) {
+			return ErrTooManyDraftItems
+		}
+
 		scopedID, err := cache.New(tx).GetNextOrderItemID(ctx, params.OrderID)
 		if err != nil {
 			return err
@@ -134,8 +168,9 @@ func (s *OrderService) CreateOrderItem(ctx context.Context, params model.CreateO
 			customerOwnerIDs[i] = model.CustomerID(id)
 		}
 
-		if _, err := tx.Pipelined(ctx, func(p redis.Pipeliner) error {
-			cache.New(p).CreateOrderItem(ctx, &model.OrderItem{
+		if _, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			q := cache.New(p)
+			if err := q.CreateOrderItem(ctx, &model.OrderItem{
 				ID:               orderItemID,
 				Quantity:         params.Quantity,
 				Modifiers:        params.Modifiers,
@@ -143,13 +178,18 @@ func (s *OrderService) CreateOrderItem(ctx context.Context, params model.CreateO
 				CustomerOwnerIDs: customerOwnerIDs,
 				MenuItemID:       params.MenuItemID,
 				Name:             menuItem.Name,
-				Description:      menuItem.Description.String,
-				PhotoPathinfo:    menuItem.PhotoPathinfo.String,
+				Description:      menuItem.Description,
+				PhotoPathinfo:    menuItem.PhotoPathinfo,
 				Price:            menuItem.Price,
 				PortionSize:      menuItem.PortionSize,
 				ModifiersConfig:  menuItem.ModifiersConfig,
+			}); err != nil {
+				return err
+			}
+			return q.AddTabEvent(ctx, params.OrderID.TabID, &model.TabEvent{
+				Type:        model.OrderItemCreated,
+				OrderItemID: &orderItemID,
 			})
-			return nil
 		}); err != nil {
 			return err
 		}
@@ -162,65 +202,328 @@ func (s *OrderService) CreateOrderItem(ctx context.Context, params model.CreateO
 	return orderItemID, nil
 }
 
+// AddFavoriteToOrder creates an item of the favorite of the customer in the order not
+// sent yet orderID, owned by the customer if they visit the tab
+func (s *OrderService) AddFavoriteToOrder(ctx context.Context, customerID model.CustomerID, favoriteID model.FavoriteID, orderID model.OrderID, quantity int16) (model.OrderItemID, error) {
+	repoFavorite, err := s.queries.GetCustomerFavorite(ctx, repository.GetCustomerFavoriteParams{
+		ID:         int64(favoriteID),
+		CustomerID: uuid.UUID(customerID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OrderItemID{}, ErrFavoriteNotFound
+		}
+		return model.OrderItemID{}, err
+	}
+	if NewFavorite(repository.GetCustomerFavoritesRow(repoFavorite)).Outdated {
+		return model.OrderItemID{}, ErrOutdatedFavorite
+	}
+
+	return s.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID:          orderID,
+		MenuItemID:       model.MenuItemID(repoFavorite.MenuItemID),
+		Quantity:         quantity,
+		Modifiers:        repoFavorite.Modifiers,
+		CustomerOwnerIDs: []model.CustomerID{customerID},
+	})
+}
+
+// Reorder clones the sent items of a past order, or of every sent order of a past
+// tab, of the visit history of the customer into the order not sent yet
+// params.OrderID, with their quantity and modifiers. Items whose menu item is
+// no longer available are skipped and reported.
+func (s *OrderService) Reorder(ctx context.Context, customerID model.CustomerID, params model.ReorderParams) (*model.ReorderResult, error) {
+	var sourceTabID model.TabID
+	var sourceOrderID pgtype.Int2
+	switch {
+	case params.SourceOrderID != nil && params.SourceTabID == nil:
+		sourceTabID = params.SourceOrderID.TabID
+		sourceOrderID = pgtype.Int2{Int16: int16(params.SourceOrderID.Scoped), Valid: true}
+	case params.SourceTabID != nil && params.SourceOrderID == nil:
+		sourceTabID = *params.SourceTabID
+	default:
+		return nil, ErrInvalidReorderSource
+	}
+
+	visiting, err := s.queries.IsVisitingCustomerIDs(ctx, repository.IsVisitingCustomerIDsParams{
+		TabID:       uuid.UUID(sourceTabID),
+		CustomerIds: []uuid.UUID{uuid.UUID(customerID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(visiting) == 0 {
+		return nil, ErrReorderSourceNotFound
+	}
+	repoItems, err := s.queries.GetSentOrderItemsForReorder(ctx, repository.GetSentOrderItemsForReorderParams{
+		TabID:   uuid.UUID(sourceTabID),
+		OrderID: sourceOrderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if params.SourceOrderID != nil && len(repoItems) == 0 {
+		return nil, ErrReorderSourceNotFound
+	}
+
+	// Like CreateOrderItem, the customer only owns the items if they visit the tab
+	var customerOwnerIDs []model.CustomerID
+	visiting, err = s.queries.IsVisitingCustomerIDs(ctx, repository.IsVisitingCustomerIDsParams{
+		TabID:       uuid.UUID(params.OrderID.TabID),
+		CustomerIds: []uuid.UUID{uuid.UUID(customerID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(visiting) > 0 {
+		customerOwnerIDs = []model.CustomerID{customerID}
+	}
+
+	// The availability of the menu items, at the time of the reorder
+	menuItems := make([]*model.MenuItem, len(repoItems))
+	for i, repoItem := range repoItems {
+		menuItems[i] = &model.MenuItem{
+			ID:                   model.MenuItemID(repoItem.MenuItemID),
+			Available:            repoItem.Available,
+			AvailabilityOverride: newAvailabilityOverride(repoItem.AvailabilityOverride, repoItem.AvailabilityOverrideUntil),
+		}
+	}
+	if err := setAvailability(ctx, s.queries, menuItems, time.Now()); err != nil {
+		return nil, err
+	}
+
+	result := &model.ReorderResult{}
+	var items []*model.OrderItem
+	for i, repoItem := range repoItems {
+		if params.OnlyOwned && !slices.Contains(repoItem.CustomerOwners, uuid.UUID(customerID)) {
+			continue
+		}
+		var reason model.SkipReason
+		switch {
+		case repoItem.DeletedAt.Valid:
+			reason = model.SkipReasonDeleted
+		case !menuItems[i].AvailableNow:
+			reason = model.SkipReasonUnavailable
+		// The modifiers config may have changed since
+		case validateModifiers(repoItem.Modifiers, repoItem.ModifiersConfig) != nil:
+			reason = model.SkipReasonIncompatibleModifiers
+		}
+		if reason != "" {
+			result.SkippedItems = append(result.SkippedItems, model.SkippedOrderItem{
+				MenuItemID: model.MenuItemID(repoItem.MenuItemID),
+				Name:       repoItem.Name,
+				Reason:     reason,
+			})
+			continue
+		}
+		items = append(items, &model.OrderItem{
+			// The maximum quantity may have been lowered since
+			Quantity:         min(repoItem.Quantity, int16(s.limits.MaxQuantity)),
+			Modifiers:        repoItem.Modifiers,
+			CustomerOwnerIDs: customerOwnerIDs,
+			MenuItemID:       model.MenuItemID(repoItem.MenuItemID),
+			Name:             repoItem.Name,
+			Description:      repoItem.Description.String,
+			PhotoPathinfo:    repoItem.PhotoPathinfo.String,
+			Price:            repoItem.Price,
+			PortionSize:      repoItem.PortionSize,
+			ModifiersConfig:  repoItem.ModifiersConfig,
+		})
+	}
+	if len(items) == 0 {
+		return result, nil
+	}
+
+	if err := s.checkOrderNotSent(ctx, params.OrderID, func(tx *redis.Tx) error {
+		count, err := cache.New(tx).CountNotSentOrderItems(ctx, params.OrderID.TabID)
+		if err != nil {
+			return err
+		}
+		if count+int64(len(items)) > int64(s.limits.MaxDraftItems) {
+			return ErrTooManyDraftItems
+		}
+
+		result.OrderItemIDs = make([]model.OrderItemID, len(items))
+		for i, item := range items {
+			scopedID, err := cache.New(tx).GetNextOrderItemID(ctx, params.OrderID)
+			if err != nil {
+				return err
+			}
+			item.ID = model.OrderItemID{OrderID: params.OrderID, Scoped: scopedID}
+			result.OrderItemIDs[i] = item.ID
+		}
+
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			q := cache.New(p)
+			for _, item := range items {
+				if err := q.CreateOrderItem(ctx, item); err != nil {
+					return err
+				}
+				if err := q.AddTabEvent(ctx, params.OrderID.TabID, &model.TabEvent{
+					Type:        model.OrderItemCreated,
+					OrderItemID: &item.ID,
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (s *OrderService) DeleteOrderItem(ctx context.Context, orderItemID model.OrderItemID) error {
-	return s.checkOrderItemNotSent(ctx, orderItemID, func(q *cache.RedisQueries) {
+	return s.checkOrderItemNotSent(ctx, orderItemID, model.OrderItemDeleted, func(q *cache.RedisQueries) {
 		q.DeleteOrderItem(ctx, orderItemID)
 	})
 }
 
+// UpdateOrderItemModifiers checks the modifiers against the modifiers config the
+// item was ordered with, as when creating it
 func (s *OrderService) UpdateOrderItemModifiers(ctx context.Context, orderItemID model.OrderItemID, modifiers []byte) error {
-	return s.checkOrderItemNotSent(ctx, orderItemID, func(q *cache.RedisQueries) {
-		q.UpdateOrderItemModifiers(ctx, orderItemID, modifiers)
+	return s.checkOrderNotSent(ctx, orderItemID.OrderID, func(tx *redis.Tx) error {
+		modifiersConfig, err := cache.WatchAndGetOrderItemModifiersConfig(ctx, tx, orderItemID)
+		if errors.Is(err, redis.Nil) {
+			return ErrOrderItemNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := validateModifiers(modifiers, modifiersConfig); err != nil {
+			return err
+		}
+		return s.updateOrderItem(ctx, tx, orderItemID, model.OrderItemUpdated, func(q *cache.RedisQueries) {
+			q.UpdateOrderItemModifiers(ctx, orderItemID, modifiers)
+		})
 	})
 }
 
 func (s *OrderService) UpdateOrderItemQuantity(ctx context.Context, orderItemID model.OrderItemID, quantity int16) error {
-	return s.checkOrderItemNotSent(ctx, orderItemID, func(q *cache.RedisQueries) {
+	if err := s.checkQuantity(quantity); err != nil {
+		return err
+	}
+	return s.checkOrderItemNotSent(ctx, orderItemID, model.OrderItemUpdated, func(q *cache.RedisQueries) {
 		q.UpdateOrderItemQuantity(ctx, orderItemID, quantity)
 	})
 }
 
 func (s *OrderService) AddOrderItemGuestOwner(ctx context.Context, orderItemID model.OrderItemID, guestID model.GuestID) error {
-	return s.checkOrderItemNotSent(ctx, orderItemID, func(q *cache.RedisQueries) {
+	return s.checkOrderItemNotSent(ctx, orderItemID, model.OrderItemUpdated, func(q *cache.RedisQueries) {
 		q.AddOrderItemGuestOwner(ctx, orderItemID, guestID)
 	})
 }
 
 func (s *OrderService) RemoveOrderItemGuestOwner(ctx context.Context, orderItemID model.OrderItemID, guestID model.GuestID) error {
-	return s.checkOrderItemNotSent(ctx, orderItemID, func(q *cache.RedisQueries) {
+	return s.checkOrderItemNotSent(ctx, orderItemID, model.OrderItemUpdated, func(q *cache.RedisQueries) {
 		q.RemoveOrderItemGuestOwner(ctx, orderItemID, guestID)
 	})
 }
 
 func (s *OrderService) AddOrderItemCustomerOwner(ctx context.Context, orderItemID model.OrderItemID, customerID model.CustomerID) error {
-	return s.checkOrderItemNotSent(ctx, orderItemID, func(q *cache.RedisQueries) {
+	return s.checkOrderItemNotSent(ctx, orderItemID, model.OrderItemUpdated, func(q *cache.RedisQueries) {
 		q.AddOrderItemCustomerOwner(ctx, orderItemID, customerID)
 	})
 }
 
 func (s *OrderService) RemoveOrderItemCustomerOwner(ctx context.Context, orderItemID model.OrderItemID, customerID model.CustomerID) error {
-	return s.checkOrderItemNotSent(ctx, orderItemID, func(q *cache.RedisQueries) {
+	return s.checkOrderItemNotSent(ctx, orderItemID, model.OrderItemUpdated, func(q *cache.RedisQueries) {
 		q.RemoveOrderItemCustomerOwner(ctx, orderItemID, customerID)
 	})
 }
 
-func (s *OrderService) checkOrderItemNotSent(ctx context.Context, id model.OrderItemID, fn func(q *cache.RedisQueries)) error {
+// validateModifiers checks that the modifiers chosen for an item are empty, or a
+// JSON object whose keys are all keys of the modifiers config of its menu item.
+// Modifiers whose config lists options, either as an array or in an "options"
+// array, must be one of them, and the others are toggled with a boolean.
+func validateModifiers(modifiers, modifiersConfig []byte) error {
+	if len(modifiers) == 0 || isJSONNull(modifiers) {
+		return nil
+	}
+	var chosen map[string]json.RawMessage
+	if err := json.Unmarshal(modifiers, &chosen); err != nil {
+		return ErrIncompatibleModifiers
+	}
+	if len(chosen) == 0 {
+		return nil
+	}
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(modifiersConfig, &config); err != nil {
+		return ErrIncompatibleModifiers
+	}
+	for key, value := range chosen {
+		modifierConfig, ok := config[key]
+		if !ok {
+			return ErrIncompatibleModifiers
+		}
+		var options []json.RawMessage
+		if json.Unmarshal(modifierConfig, &options) != nil {
+			var withOptions struct {
+				Options []json.RawMessage `json:"options"`
+			}
+			if json.Unmarshal(modifierConfig, &withOptions) == nil {
+				options = withOptions.Options
+			}
+		}
+		if options == nil {
+			if toggled := strings.TrimSpace(string(value)); toggled != "true" && toggled != "false" {
+				return ErrIncompatibleModifiers
+			}
+			continue
+		}
+		if !slices.ContainsFunc(options, func(option json.RawMessage) bool { return sameJSON(option, value) }) {
+			return ErrIncompatibleModifiers
+		}
+	}
+	return nil
+}
+
+// checkQuantity checks that quantity is between 1 and the maximum quantity of order items
+func (s *OrderService) checkQuantity(quantity int16) error {
+	if quantity < 1 || int(quantity) > s.limits.MaxQuantity {
+		return status.Errorf(codes.InvalidArgument, "quantity must be between 1 and %d", s.limits.MaxQuantity)
+	}
+	return nil
+}
+
+// checkOrderItemNotSent runs fn and publishes an event of type eventType
+// in a single transaction, provided the order of the item is not sent
+func (s *OrderService) checkOrderItemNotSent(ctx context.Context, id model.OrderItemID, eventType model.TabEventType, fn func(q *cache.RedisQueries)) error {
 	return s.checkOrderNotSent(ctx, id.OrderID, func(tx *redis.Tx) error {
-		_, err := tx.Pipelined(ctx, func(p redis.Pipeliner) error {
-			fn(cache.New(p))
-			return nil
+		return s.updateOrderItem(ctx, tx, id, eventType, fn)
+	})
+}
+
+// updateOrderItem runs fn and publishes an event of type eventType in tx
+func (s *OrderService) updateOrderItem(ctx context.Context, tx *redis.Tx, id model.OrderItemID, eventType model.TabEventType, fn func(q *cache.RedisQueries)) error {
+	_, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		q := cache.New(p)
+		fn(q)
+		return q.AddTabEvent(ctx, id.OrderID.TabID, &model.TabEvent{
+			Type:        eventType,
+			OrderItemID: &id,
 		})
-		return err
 	})
+	return err
 }
 
 func (s *OrderService) checkOrderNotSent(ctx context.Context, id model.OrderID, fn func(tx *redis.Tx) error) error {
+	return s.cacheService.retryTx(ctx, "checkOrderNotSent", func() error {
+		return s.watchOrderNotSent(ctx, id, fn)
+	})
+}
+
+func (s *OrderService) watchOrderNotSent(ctx context.Context, id model.OrderID, fn func(tx *redis.Tx) error) error {
 	return s.rdb.Watch(ctx, func(tx *redis.Tx) error {
 		ok, err := cache.WatchAndCheckOrderNotSent(ctx, tx, id)
 		if err != nil {
 			if !errors.Is(err, redis.Nil) {
 				return err
 			}
+			s.cacheService.metrics.miss(ctx, "checkOrderNotSent")
 
 			if err := tx.Unwatch(ctx).Err(); err != nil {
 				return err
@@ -234,16 +537,24 @@ func (s *OrderService) checkOrderNotSent(ctx context.Context, id model.OrderID,
 			if err != nil {
 				return err
 			}
+		} else {
+			s.cacheService.metrics.hit(ctx, "checkOrderNotSent")
 		}
 		if !ok {
 			return errors.New("order is already sent")
 		}
 
 		return fn(tx)
-	})
+	}, cache.TxKey(id.TabID))
 }
 
 func (s *OrderService) SendOrder(ctx context.Context, toBeSentOrderID model.OrderID) error {
+	return s.cacheService.retryTx(ctx, "SendOrder", func() error {
+		return s.sendOrder(ctx, toBeSentOrderID)
+	})
+}
+
+func (s *OrderService) sendOrder(ctx context.Context, toBeSentOrderID model.OrderID) error {
 	tabID := uuid.UUID(toBeSentOrderID.TabID)
 
 	tx, err := s.db.Begin(ctx)
@@ -269,6 +580,7 @@ func (s *OrderService) SendOrder(ctx context.Context, toBeSentOrderID model.Orde
 				return err
 			}
 			miss = true
+			s.cacheService.metrics.miss(ctx, "SendOrder")
 			order, err := qtx.GetOrderWithItems(ctx, repository.GetOrderWithItemsParams{
 				TabID:    uuid.UUID(toBeSentOrderID.TabID),
 				ScopedID: int16(toBeSentOrderID.Scoped),
@@ -283,6 +595,7 @@ func (s *OrderService) SendOrder(ctx context.Context, toBeSentOrderID model.Orde
 				return errors.New("order is empty")
 			}
 		} else {
+			s.cacheService.metrics.hit(ctx, "SendOrder")
 			if notSentOrderID != toBeSentOrderID {
 				return errors.New("order is already sent")
 			}
@@ -324,7 +637,7 @@ func (s *OrderService) SendOrder(ctx context.Context, toBeSentOrderID model.Orde
 		}
 
 		return nil
-	}); err != nil {
+	}, cache.TxKey(toBeSentOrderID.TabID)); err != nil {
 		return err
 	}
 
@@ -332,6 +645,10 @@ func (s *OrderService) SendOrder(ctx context.Context, toBeSentOrderID model.Orde
 		return err
 	}
 
+	s.cacheService.publishTabEvent(ctx, toBeSentOrderID.TabID, &model.TabEvent{
+		Type:    model.OrderSent,
+		OrderID: &toBeSentOrderID,
+	})
 	go s.cacheService.GetAndCacheTab(ctx, toBeSentOrderID.TabID)
 
 	return nil
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOrderServiceConcurrentMutationsOnSingleTab(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	cacheService := NewCacheService(db, rdb)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+	menuService := newTestMenuService(t, db)
+
+	menuItem, err := menuService.CreateMenuItem(ctx, model.CreateMenuItemParams{
+		Name:        "Fried Rice",
+		Price:       100,
+		PortionSize: 1,
+		Available:   true,
+	})
+	require.NoError(t, err)
+
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	orderID := tab.Orders[len(tab.Orders)-1].ID
+
+	const diners = 16
+
+	var wg sync.WaitGroup
+	ids := make([]model.OrderItemID, diners)
+	errs := make([]error, diners)
+	for i := range diners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids[i], errs[i] = orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+				OrderID:    orderID,
+				MenuItemID: menuItem.ID,
+				Quantity:   1,
+			})
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	for i := range diners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = orderService.UpdateOrderItemQuantity(ctx, ids[i], int16(i+1))
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	tab, err = tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	require.Len(t, tab.Orders[len(tab.Orders)-1].Items, diners)
+
+	// Sending the order races with further edits: every edit must either
+	// succeed before the order is sent or be rejected, never fail spuriously.
+	sendErrs := make(chan error, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sendErrs <- orderService.SendOrder(ctx, orderID)
+	}()
+	for i := range diners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = orderService.UpdateOrderItemQuantity(ctx, ids[i], 1)
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, <-sendErrs)
+	for _, err := range errs {
+		if err != nil {
+			require.EqualError(t, err, "order is already sent")
+		}
+	}
+
+	tab, err = tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	require.Len(t, tab.Orders, 2)
+	require.Len(t, tab.Orders[0].Items, diners)
+}
+
+func TestTabLimits(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	limits := config.TabLimitsConfig{MaxGuests: 2, MaxDraftItems: 2, MaxQuantity: 5}
+	cacheService := NewCacheService(db, rdb)
+	orderService := NewOrderService(db, rdb, cacheService, limits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, limits)
+	menuService := newTestMenuService(t, db)
+
+	menuItem, err := menuService.CreateMenuItem(ctx, model.CreateMenuItemParams{
+		Name:        "Fried Rice",
+		Price:       100,
+		PortionSize: 1,
+		Available:   true,
+	})
+	require.NoError(t, err)
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+
+	for range limits.MaxGuests {
+		_, _, err := tabService.CreateGuest(ctx, tabID)
+		require.NoError(t, err)
+	}
+	_, _, err = tabService.CreateGuest(ctx, tabID)
+	require.ErrorIs(t, err, ErrTooManyGuests)
+
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	orderID := tab.Orders[len(tab.Orders)-1].ID
+	params := model.CreateOrderItemParams{OrderID: orderID, MenuItemID: menuItem.ID, Quantity: 6}
+	_, err = orderService.CreateOrderItem(ctx, params)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	params.Quantity = 5
+	var itemID model.OrderItemID
+	for range limits.MaxDraftItems {
+		itemID, err = orderService.CreateOrderItem(ctx, params)
+		require.NoError(t, err)
+	}
+	_, err = orderService.CreateOrderItem(ctx, params)
+	require.ErrorIs(t, err, ErrTooManyDraftItems)
+	require.Equal(t, codes.InvalidArgument, status.Code(orderService.UpdateOrderItemQuantity(ctx, itemID, 6)))
+
+	// Deleting an item makes room for another one
+	require.NoError(t, orderService.DeleteOrderItem(ctx, itemID))
+	_, err = orderService.CreateOrderItem(ctx, params)
+	require.NoError(t, err)
+}
+
+func TestOrderServiceReorder(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	cacheService := NewCacheService(db, rdb)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+	menuService := newTestMenuService(t, db)
+	authService := newTestAuthService(db, rdb, auth.NewCustomerJWTGenerator(auth.NewHMACKeyring([]byte("secret")), time.Hour), &testMailer{})
+	customer, err := NewCustomerService(db, rdb, cacheService, authService).CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("alice-password"),
+	})
+	require.NoError(t, err)
+
+	menuItemIDs := map[string]model.MenuItemID{}
+	for _, name := range []string{"Noodles", "Tea", "Soup", "Dumplings"} {
+		params := model.CreateMenuItemParams{Name: name, Price: 100, PortionSize: 1, Available: true}
+		if name == "Noodles" {
+			params.ModifiersConfig = []byte(`{"spicy": {}}`)
+		}
+		menuItem, err := menuService.CreateMenuItem(ctx, params)
+		require.NoError(t, err)
+		menuItemIDs[name] = menuItem.ID
+	}
+
+	// A past tab where Alice had noodles and soup, and the table tea and dumplings.
+	// Soup and dumplings are off the menu since.
+	pastTabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tabService.VisitTab(ctx, pastTabID, customer.ID))
+	pastTab, err := tabService.GetOpenTab(ctx, pastTabID)
+	require.NoError(t, err)
+	pastOrderID := pastTab.Orders[len(pastTab.Orders)-1].ID
+	for _, params := range []model.CreateOrderItemParams{
+		{MenuItemID: menuItemIDs["Noodles"], Quantity: 2, Modifiers: []byte(`{"spicy": true}`), CustomerOwnerIDs: []model.CustomerID{customer.ID}},
+		{MenuItemID: menuItemIDs["Tea"], Quantity: 1},
+		{MenuItemID: menuItemIDs["Soup"], Quantity: 1, CustomerOwnerIDs: []model.CustomerID{customer.ID}},
+		{MenuItemID: menuItemIDs["Dumplings"], Quantity: 1},
+	} {
+		params.OrderID = pastOrderID
+		_, err := orderService.CreateOrderItem(ctx, params)
+		require.NoError(t, err)
+	}
+	// Modifiers are checked against the modifiers config of the menu item
+	_, err = orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID: pastOrderID, MenuItemID: menuItemIDs["Noodles"], Quantity: 1, Modifiers: []byte(`{"spicy": "very"}`),
+	})
+	require.ErrorIs(t, err, ErrIncompatibleModifiers)
+	_, err = orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID: pastOrderID, MenuItemID: menuItemIDs["Tea"], Quantity: 1, Modifiers: []byte(`{"spicy": true}`),
+	})
+	require.ErrorIs(t, err, ErrIncompatibleModifiers)
+	// Including when they are updated
+	teaItemID, err := orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID: pastOrderID, MenuItemID: menuItemIDs["Tea"], Quantity: 1,
+	})
+	require.NoError(t, err)
+	err = orderService.UpdateOrderItemModifiers(ctx, teaItemID, []byte(`{"spicy": true}`))
+	require.ErrorIs(t, err, ErrIncompatibleModifiers)
+	require.NoError(t, orderService.DeleteOrderItem(ctx, teaItemID))
+	err = orderService.UpdateOrderItemModifiers(ctx, teaItemID, nil)
+	require.ErrorIs(t, err, ErrOrderItemNotFound)
+	require.NoError(t, orderService.SendOrder(ctx, pastOrderID))
+	_, err = tabService.CloseTab(ctx, pastTabID)
+	require.NoError(t, err)
+	require.NoError(t, menuService.DeleteMenuItem(ctx, menuItemIDs["Soup"]))
+	_, err = menuService.UpdateMenuItem(ctx, menuItemIDs["Dumplings"], model.UpdateMenuItemParams{Name: "Dumplings", Price: 100, PortionSize: 1})
+	require.NoError(t, err)
+
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tabService.VisitTab(ctx, tabID, customer.ID))
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	orderID := tab.Orders[len(tab.Orders)-1].ID
+
+	_, err = orderService.Reorder(ctx, customer.ID, model.ReorderParams{OrderID: orderID})
+	require.ErrorIs(t, err, ErrInvalidReorderSource)
+	otherTabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	_, err = orderService.Reorder(ctx, customer.ID, model.ReorderParams{OrderID: orderID, SourceTabID: &otherTabID})
+	require.ErrorIs(t, err, ErrReorderSourceNotFound)
+
+	// Only the items of Alice
+	result, err := orderService.Reorder(ctx, customer.ID, model.ReorderParams{OrderID: orderID, SourceTabID: &pastTabID, OnlyOwned: true})
+	require.NoError(t, err)
+	require.Len(t, result.OrderItemIDs, 1)
+	require.Equal(t, []model.SkippedOrderItem{
+		{MenuItemID: menuItemIDs["Soup"], Name: "Soup", Reason: model.SkipReasonDeleted},
+	}, result.SkippedItems)
+
+	// The whole order
+	result, err = orderService.Reorder(ctx, customer.ID, model.ReorderParams{OrderID: orderID, SourceOrderID: &pastOrderID})
+	require.NoError(t, err)
+	require.Len(t, result.OrderItemIDs, 2)
+	require.Equal(t, []model.SkippedOrderItem{
+		{MenuItemID: menuItemIDs["Soup"], Name: "Soup", Reason: model.SkipReasonDeleted},
+		{MenuItemID: menuItemIDs["Dumplings"], Name: "Dumplings", Reason: model.SkipReasonUnavailable},
+	}, result.SkippedItems)
+
+	tab, err = tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	items := tab.Orders[len(tab.Orders)-1].Items
+	require.Len(t, items, 3)
+	quantities := map[model.MenuItemID]int16{}
+	for _, item := range items {
+		quantities[item.MenuItemID] += item.Quantity
+		require.Equal(t, []model.CustomerID{customer.ID}, item.CustomerOwnerIDs)
+		if item.MenuItemID == menuItemIDs["Noodles"] {
+			require.JSONEq(t, `{"spicy": true}`, string(item.Modifiers))
+		}
+	}
+	require.Equal(t, map[model.MenuItemID]int16{menuItemIDs["Noodles"]: 4, menuItemIDs["Tea"]: 1}, quantities)
+
+	// Noodles are no longer spicy
+	_, err = menuService.UpdateMenuItem(ctx, menuItemIDs["Noodles"], model.UpdateMenuItemParams{
+		Name: "Noodles", Price: 100, PortionSize: 1, Available: true, ModifiersConfig: []byte(`{"mild": {}}`),
+	})
+	require.NoError(t, err)
+	result, err = orderService.Reorder(ctx, customer.ID, model.ReorderParams{OrderID: orderID, SourceOrderID: &pastOrderID})
+	require.NoError(t, err)
+	require.Len(t, result.OrderItemIDs, 1)
+	require.Equal(t, []model.SkippedOrderItem{
+		{MenuItemID: menuItemIDs["Noodles"], Name: "Noodles", Reason: model.SkipReasonIncompatibleModifiers},
+		{MenuItemID: menuItemIDs["Soup"], Name: "Soup", Reason: model.SkipReasonDeleted},
+		{MenuItemID: menuItemIDs["Dumplings"], Name: "Dumplings", Reason: model.SkipReasonUnavailable},
+	}, result.SkippedItems)
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/storage"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+// testTabLimits are loose enough for every test but TestTabLimits
+var testTabLimits = config.TabLimitsConfig{MaxGuests: 50, MaxDraftItems: 200, MaxQuantity: 99}
+
+// testPhotos accepts small photos, resized to small variants
+var testPhotos = config.PhotosConfig{
+	MaxSize:       1 << 20,
+	MaxPixels:     1 << 20,
+	JPEGQuality:   80,
+	VariantWidths: map[string]int{"thumbnail": 16, "large": 64},
+}
+
+// newTestMenuService creates a menu service storing photos in a temporary directory
+func newTestMenuService(t *testing.T, db *pgxpool.Pool) *MenuService {
+	return NewMenuService(db, storage.NewLocalStorage(t.TempDir(), "http://media.test"), testPhotos)
+}
+
+// newTestStores starts PostgreSQL and Redis containers with the schema migrated
+func newTestStores(t *testing.T) (*pgxpool.Pool, *redis.Client) {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	files, err := filepath.Glob("../../../migrations/*.sql")
+	require.NoError(t, err)
+	var migrations []string
+	for _, file := range files {
+		if !strings.Contains(filepath.Base(file), "seed") {
+			migrations = append(migrations, file)
+		}
+	}
+
+	pgC, err := postgres.Run(t.Context(), "postgres:17-alpine",
+		postgres.WithDatabase("testdb"),
+		postgres.WithUsername("testuser"),
+		postgres.WithPassword("testpassword"),
+		postgres.WithInitScripts(migrations...),
+		postgres.BasicWaitStrategies(),
+	)
+	testcontainers.CleanupContainer(t, pgC)
+	require.NoError(t, err, "failed to start postgres container")
+
+	rC, err := tcredis.Run(t.Context(), "redis:8")
+	testcontainers.CleanupContainer(t, rC)
+	require.NoError(t, err, "failed to start redis container")
+
+	dsn, err := pgC.ConnectionString(t.Context(), "sslmode=disable")
+	require.NoError(t, err)
+	db, err := pgxpool.New(t.Context(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+
+	redisURL, err := rC.ConnectionString(t.Context())
+	require.NoError(t, err)
+	opts, err := redis.ParseURL(redisURL)
+	require.NoError(t, err)
+	rdb := redis.NewClient(opts)
+	t.Cleanup(func() { rdb.Close() })
+
+	return db, rdb
+}
+
+// newUnreachableRedis returns a client of a Redis server that does not exist, failing fast
+func newUnreachableRedis(t *testing.T) *redis.Client {
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	t.Cleanup(func() { rdb.Close() })
+	return rdb
+}
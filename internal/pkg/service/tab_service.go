@@ -3,16 +3,26 @@ package service
 // TabService provides methods for managing tabs
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
+	"restaurant-ordering-system/internal/pkg/config"
 	"restaurant-ordering-system/internal/pkg/model"
 	"restaurant-ordering-system/internal/pkg/repository"
 	"restaurant-ordering-system/internal/pkg/repository/cache"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func NewTab(repoTab repository.TabWithOrders) *model.Tab {
@@ -45,21 +55,47 @@ func NewTab(repoTab repository.TabWithOrders) *model.Tab {
 	}
 }
 
+var (
+	ErrTooManyGuests    = status.Error(codes.ResourceExhausted, "too many guests in the tab")
+	ErrGuestNotFound    = status.Error(codes.NotFound, "guest not found")
+	ErrInvalidPageToken = status.Error(codes.InvalidArgument, "page token is invalid")
+	// Errors of open tabs and their events
+	ErrTabNotFound    = status.Error(codes.NotFound, "tab not found")
+	ErrTabClosed      = status.Error(codes.FailedPrecondition, "tab is already closed")
+	ErrInvalidEventID = status.Error(codes.InvalidArgument, "last event ID is invalid")
+	// Errors of guest claims
+	ErrInvalidClaimToken   = status.Error(codes.PermissionDenied, "claim token of the guest is invalid")
+	ErrGuestAlreadyClaimed = status.Error(codes.FailedPrecondition, "guest is already claimed by another customer")
+)
+
+const (
+	defaultVisitedTabsPageSize = 20
+	maxVisitedTabsPageSize     = 100
+	// maxFavoriteItems is the number of favorite items of spend summaries
+	maxFavoriteItems = 5
+)
+
 type TabService struct {
 	db           *pgxpool.Pool
-	rdb          *redis.Client
+	rdb          redis.UniversalClient
 	queries      *repository.Queries
 	rqueries     *cache.RedisQueries
+	erqueries    *cache.RedisQueries
 	cacheService *CacheService
+	limits       config.TabLimitsConfig
 }
 
-func NewTabService(db *pgxpool.Pool, rdb *redis.Client, cacheService *CacheService) *TabService {
+// NewTabService creates a tab service whose blocking reads of tab events use
+// eventsRdb, a client with its own connection pool
+func NewTabService(db *pgxpool.Pool, rdb, eventsRdb redis.UniversalClient, cacheService *CacheService, limits config.TabLimitsConfig) *TabService {
 	return &TabService{
 		db:           db,
 		rdb:          rdb,
 		queries:      repository.New(db),
 		rqueries:     cache.New(rdb),
+		erqueries:    cache.New(eventsRdb),
 		cacheService: cacheService,
+		limits:       limits,
 	}
 }
 
@@ -91,41 +127,70 @@ func (s *TabService) CreateTab(ctx context.Context) (model.TabID, error) {
 		return model.TabID{}, err
 	}
 
-	s.rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
-		return cache.New(p).CreateTab(ctx, model.TabID(row.ID), row.CreatedAt.Time, model.ScopedOrderID(orderID))
-	})
+	if err := s.rqueries.CreateTab(ctx, model.TabID(row.ID), row.CreatedAt.Time, model.ScopedOrderID(orderID)); err != nil {
+		if !cache.IsUnavailable(err) {
+			return model.TabID{}, err
+		}
+		// The tab is cached from the database on its first read once Redis is reachable again
+		s.cacheService.metrics.unavailableCache(ctx, "CreateTab")
+	}
 
 	return model.TabID(tabID), nil
 }
 
 func (s *TabService) VisitTab(ctx context.Context, tabID model.TabID, customerID model.CustomerID) error {
-	return s.checkTabNotClosed(ctx, tabID, func(qtx *repository.Queries) error {
+	if err := s.checkTabNotClosed(ctx, tabID, func(qtx *repository.Queries) error {
 		return qtx.VisitTab(ctx, repository.VisitTabParams{
 			TabID:      uuid.UUID(tabID),
 			CustomerID: uuid.UUID(customerID),
 		})
-	})
+	}); err != nil {
+		return err
+	}
+
+	s.cacheService.publishTabEvent(ctx, tabID, &model.TabEvent{Type: model.TabVisited})
+
+	return nil
 }
 
-func (s *TabService) CreateGuest(ctx context.Context, tabID model.TabID) (model.GuestID, error) {
+// CreateGuest adds a guest to the tab and returns its ID and the token with which
+// a customer claims it, see ClaimGuest
+func (s *TabService) CreateGuest(ctx context.Context, tabID model.TabID) (model.GuestID, string, error) {
+	claimToken := rand.Text()
 	var scopedID model.ScopedGuestID
 	if err := s.checkTabNotClosed(ctx, tabID, func(qtx *repository.Queries) error {
-		scopedIDInt, err := qtx.CreateGuest(ctx, uuid.UUID(tabID))
+		scopedIDInt, err := qtx.CreateGuest(ctx, repository.CreateGuestParams{
+			TabID:     uuid.UUID(tabID),
+			MaxGuests: int16(s.limits.MaxGuests),
+		})
 		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTooManyGuests // The tab exists, checked by checkTabNotClosed
+			}
 			return err
 		}
 
 		scopedID = model.ScopedGuestID(scopedIDInt)
 
-		return nil
+		return qtx.CreateGuestClaimToken(ctx, repository.CreateGuestClaimTokenParams{
+			TabID:     uuid.UUID(tabID),
+			GuestID:   int16(scopedID),
+			TokenHash: hashToken(claimToken),
+		})
 	}); err != nil {
-		return model.GuestID{}, err
+		return model.GuestID{}, "", err
 	}
 
-	return model.GuestID{
+	guestID := model.GuestID{
 		TabID:  tabID,
 		Scoped: scopedID,
-	}, nil
+	}
+	s.cacheService.publishTabEvent(ctx, tabID, &model.TabEvent{
+		Type:    model.GuestCreated,
+		GuestID: &guestID,
+	})
+
+	return guestID, claimToken, nil
 }
 
 func (s *TabService) UpdateGuestName(ctx context.Context, guestID model.GuestID, name string) error {
@@ -144,8 +209,12 @@ func (s *TabService) UpdateGuestName(ctx context.Context, guestID model.GuestID,
 	}
 
 	if _, err := s.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
-		cache.New(p).UpdateGuestName(ctx, guestID.TabID, guestID.Scoped, name)
-		return nil
+		q := cache.New(p)
+		q.UpdateGuestName(ctx, guestID.TabID, guestID.Scoped, name)
+		return q.AddTabEvent(ctx, guestID.TabID, &model.TabEvent{
+			Type:    model.GuestUpdated,
+			GuestID: &guestID,
+		})
 	}); err != nil {
 		return err
 	}
@@ -153,26 +222,212 @@ func (s *TabService) UpdateGuestName(ctx context.Context, guestID model.GuestID,
 	return nil
 }
 
-func (s *TabService) GetOpenTab(ctx context.Context, tabID model.TabID) (*model.Tab, error) {
-	tab, err := s.rqueries.GetOpenTabWithOrders(ctx, tabID)
+// ClaimGuest links the activity of a guest to the customer they signed up or logged
+// in as during the visit, given the claim token returned by CreateGuest. The customer
+// replaces the guest among the owners of the order items, sent or not, visits the tab,
+// and takes the custom name of the guest as their name unless they already have one.
+// A guest is claimed by a single customer, who may claim it again, e.g. to retry
+// updating the cache after the claim was saved.
+func (s *TabService) ClaimGuest(ctx context.Context, guestID model.GuestID, customerID model.CustomerID, claimToken string) error {
+	claimedTab, err := s.claimGuest(ctx, guestID, customerID, claimToken)
 	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			if tab, err := s.cacheService.GetAndCacheTab(ctx, tabID); tab != nil {
-				if tab.ClosedAt != nil {
-					return nil, errors.New("tab is already closed")
-				}
-				return tab, nil
-			} else {
-				return nil, err
-			}
+		return err
+	}
+	if err := s.cacheService.retryTx(ctx, "ClaimGuest", func() error {
+		return s.cacheClaimedGuest(ctx, guestID, customerID, claimedTab)
+	}); err != nil {
+		return err
+	}
+
+	s.cacheService.publishTabEvent(ctx, guestID.TabID, &model.TabEvent{
+		Type:    model.GuestClaimed,
+		GuestID: &guestID,
+	})
+	return nil
+}
+
+// claimGuest saves the claim in the database and returns the sent orders of the claimed tab
+func (s *TabService) claimGuest(ctx context.Context, guestID model.GuestID, customerID model.CustomerID, claimToken string) (*model.Tab, error) {
+	tabID := uuid.UUID(guestID.TabID)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	tab, err := qtx.GetTabForNoKeyUpdate(ctx, tabID)
+	if err != nil {
+		return nil, err
+	}
+	if tab.ClosedAt.Valid {
+		return nil, ErrTabClosed
+	}
+	claim, err := qtx.GetGuestClaimTokenForUpdate(ctx, repository.GetGuestClaimTokenForUpdateParams{
+		TabID:   tabID,
+		GuestID: int16(guestID.Scoped),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrGuestNotFound
 		}
 		return nil, err
 	}
+	if subtle.ConstantTimeCompare(claim.TokenHash, hashToken(claimToken)) != 1 {
+		return nil, ErrInvalidClaimToken
+	}
+	if claim.ClaimedBy.Valid && claim.ClaimedBy.Bytes != customerID {
+		return nil, ErrGuestAlreadyClaimed
+	}
+
+	if err := qtx.MarkGuestClaimed(ctx, repository.MarkGuestClaimedParams{
+		TabID:     tabID,
+		GuestID:   int16(guestID.Scoped),
+		ClaimedBy: pgtype.UUID{Bytes: customerID, Valid: true},
+	}); err != nil {
+		return nil, err
+	}
+	if err := qtx.VisitTab(ctx, repository.VisitTabParams{
+		TabID:      tabID,
+		CustomerID: uuid.UUID(customerID),
+	}); err != nil {
+		return nil, err
+	}
+	if err := qtx.TransferOrderItemsGuestOwner(ctx, repository.TransferOrderItemsGuestOwnerParams{
+		TabID:      tabID,
+		GuestID:    int16(guestID.Scoped),
+		CustomerID: uuid.UUID(customerID),
+	}); err != nil {
+		return nil, err
+	}
+	if name, ok := tab.GuestNames[int16(guestID.Scoped)]; ok {
+		if err := qtx.RemoveGuestName(ctx, repository.RemoveGuestNameParams{
+			ID:       tabID,
+			ScopedID: int16(guestID.Scoped),
+		}); err != nil {
+			return nil, err
+		}
+		if err := qtx.SetCustomerNameIfEmpty(ctx, repository.SetCustomerNameIfEmptyParams{
+			ID:   uuid.UUID(customerID),
+			Name: name,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	claimedTab, err := loadTab(ctx, qtx, guestID.TabID)
+	if err != nil {
+		return nil, err
+	}
+	sentOrders := claimedTab.Orders
+	if lastOrder := sentOrders[len(sentOrders)-1]; lastOrder.SentAt == nil {
+		sentOrders = sentOrders[:len(sentOrders)-1]
+	}
+	claimedTab.Orders = sentOrders
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return claimedTab, nil
+}
+
+// cacheClaimedGuest applies a saved claim to the cached tab. The order not sent yet
+// only lives in Redis, and the sent orders cached there are replaced by the claimed ones.
+func (s *TabService) cacheClaimedGuest(ctx context.Context, guestID model.GuestID, customerID model.CustomerID, claimedTab *model.Tab) error {
+	return s.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		_, orderItemIDs, err := cache.WatchAndGetNotSentOrderIDAndItemIDs(ctx, tx, guestID.TabID)
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return nil // Not cached, the tab is cached from the database on its next read
+			}
+			return err
+		}
+		orderItems, err := cache.WatchAndGetOrderItems(ctx, tx, orderItemIDs)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			q := cache.New(p)
+			for _, item := range orderItems {
+				if !slices.Contains(item.GuestOwnerIDs, guestID) {
+					continue
+				}
+				q.RemoveOrderItemGuestOwner(ctx, item.ID, guestID)
+				if !slices.Contains(item.CustomerOwnerIDs, customerID) {
+					q.AddOrderItemCustomerOwner(ctx, item.ID, customerID)
+				}
+			}
+			q.RemoveGuestName(ctx, guestID.TabID, guestID.Scoped)
+			if len(claimedTab.Orders) == 0 {
+				return nil
+			}
+			return q.CacheTab(ctx, claimedTab)
+		})
+		return err
+	}, cache.TxKey(guestID.TabID))
+}
 
+func (s *TabService) GetOpenTab(ctx context.Context, tabID model.TabID) (*model.Tab, error) {
+	tab, err := s.rqueries.GetOpenTabWithOrders(ctx, tabID)
+	switch {
+	case err == nil:
+		s.cacheService.metrics.hit(ctx, "GetOpenTab")
+		return tab, nil
+	case errors.Is(err, redis.Nil):
+		s.cacheService.metrics.miss(ctx, "GetOpenTab")
+		tab, err = s.cacheService.GetAndCacheTab(ctx, tabID)
+	case cache.IsUnavailable(err):
+		// Serve the tab from the database until Redis is reachable again
+		s.cacheService.metrics.unavailableCache(ctx, "GetOpenTab")
+		tab, err = s.cacheService.loadTab(ctx, tabID)
+	case errors.Is(err, cache.ErrTabClosed):
+		return nil, ErrTabClosed
+	default:
+		return nil, err
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTabNotFound
+	}
+	if tab == nil {
+		return nil, err
+	}
+	if tab.ClosedAt != nil {
+		return nil, ErrTabClosed
+	}
 	return tab, nil
 }
 
+// GetTabEventsCursor returns the cursor from which the events of an open tab are followed.
+// Without lastEventID, only the events published from now on are followed.
+// missed reports whether events following lastEventID may have been dropped.
+func (s *TabService) GetTabEventsCursor(ctx context.Context, tabID model.TabID, lastEventID string) (cursor string, missed bool, err error) {
+	if _, err := s.GetOpenTab(ctx, tabID); err != nil {
+		return "", false, err
+	}
+	cursor, missed, err = s.rqueries.GetTabEventsCursor(ctx, tabID, lastEventID)
+	if errors.Is(err, cache.ErrInvalidStreamID) {
+		return "", false, ErrInvalidEventID
+	}
+	return cursor, missed, err
+}
+
+// WaitTabEvents returns the events of a tab published after cursor, waiting up to block for the first one.
+// Waiting holds a connection of the events client, so that followers never starve the other commands.
+func (s *TabService) WaitTabEvents(ctx context.Context, tabID model.TabID, cursor string, block time.Duration) ([]*model.TabEvent, error) {
+	return s.erqueries.WaitTabEvents(ctx, tabID, cursor, block)
+}
+
 func (s *TabService) CloseTab(ctx context.Context, tabID model.TabID) (time.Time, error) {
+	var closedAt time.Time
+	err := s.cacheService.retryTx(ctx, "CloseTab", func() error {
+		var err error
+		closedAt, err = s.closeTab(ctx, tabID)
+		return err
+	})
+	return closedAt, err
+}
+
+func (s *TabService) closeTab(ctx context.Context, tabID model.TabID) (time.Time, error) {
 	closedTabID := uuid.UUID(tabID)
 
 	tx, err := s.db.Begin(ctx)
@@ -187,7 +442,7 @@ func (s *TabService) CloseTab(ctx context.Context, tabID model.TabID) (time.Time
 		return time.Time{}, err
 	}
 	if tab.ClosedAt.Valid {
-		return time.Time{}, errors.New("tab is already closed")
+		return time.Time{}, ErrTabClosed
 	}
 
 	if err := qtx.DeleteNotSentOrders(ctx, closedTabID); err != nil {
@@ -221,7 +476,7 @@ func (s *TabService) CloseTab(ctx context.Context, tabID model.TabID) (time.Time
 			return err
 		}
 		return nil
-	}); err != nil {
+	}, cache.TxKey(tabID)); err != nil {
 		return time.Time{}, err
 	}
 
@@ -229,6 +484,7 @@ func (s *TabService) CloseTab(ctx context.Context, tabID model.TabID) (time.Time
 		return time.Time{}, err
 	}
 
+	s.cacheService.publishTabEvent(ctx, tabID, &model.TabEvent{Type: model.TabClosed})
 	go s.cacheService.GetAndCacheTab(ctx, tabID)
 
 	return closedAt, nil
@@ -247,7 +503,7 @@ func (s *TabService) checkTabNotClosed(ctx context.Context, tabID model.TabID, d
 		return err
 	}
 	if tab.ClosedAt.Valid {
-		return errors.New("tab is already closed")
+		return ErrTabClosed
 	}
 
 	if err := do(qtx); err != nil {
@@ -261,14 +517,140 @@ func (s *TabService) checkTabNotClosed(ctx context.Context, tabID model.TabID, d
 	return nil
 }
 
-func (s *TabService) GetVisitedTabs(ctx context.Context, customerID model.CustomerID) ([]*model.Tab, error) {
-	repoTabs, err := s.queries.GetVisitedTabsWithOrders(ctx, uuid.UUID(customerID))
+// GetVisitedTabs returns a page of the tabs visited by the customer, newest first
+func (s *TabService) GetVisitedTabs(ctx context.Context, customerID model.CustomerID, params model.VisitedTabsParams) (*model.VisitedTabsPage, error) {
+	pageSize := params.PageSize
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultVisitedTabsPageSize
+	case pageSize > maxVisitedTabsPageSize:
+		pageSize = maxVisitedTabsPageSize
+	}
+
+	arg := repository.ListVisitedTabsParams{
+		CustomerID: uuid.UUID(customerID),
+		PageSize:   int32(pageSize + 1), // The extra tab tells whether there is a next page
+	}
+	// Creation times are stored in UTC without time zone
+	if params.Since != nil {
+		arg.Since = pgtype.Timestamp{Time: params.Since.UTC(), Valid: true}
+	}
+	if params.Until != nil {
+		arg.Until = pgtype.Timestamp{Time: params.Until.UTC(), Valid: true}
+	}
+	if params.Closed != nil {
+		arg.Closed = pgtype.Bool{Bool: *params.Closed, Valid: true}
+	}
+	if params.PageToken != "" {
+		createdAt, tabID, err := decodePageToken(params.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		arg.AfterCreatedAt = pgtype.Timestamp{Time: createdAt, Valid: true}
+		arg.AfterID = pgtype.UUID{Bytes: tabID, Valid: true}
+	}
+
+	repoTabs, err := s.queries.ListVisitedTabs(ctx, arg)
 	if err != nil {
 		return nil, err
 	}
-	tabs := make([]*model.Tab, len(repoTabs))
+	page := &model.VisitedTabsPage{}
+	if len(repoTabs) > pageSize {
+		repoTabs = repoTabs[:pageSize]
+		last := repoTabs[pageSize-1]
+		page.NextPageToken = encodePageToken(last.CreatedAt.Time, last.ID)
+	}
+
+	page.Tabs = make([]*model.Tab, len(repoTabs))
+	if params.Summary {
+		for i, repoTab := range repoTabs {
+			page.Tabs[i] = NewTab(repository.TabWithOrders{
+				ID:         repoTab.ID,
+				TotalPrice: repoTab.TotalPrice,
+				CreatedAt:  repoTab.CreatedAt,
+				ClosedAt:   repoTab.ClosedAt,
+				GuestNames: repoTab.GuestNames,
+			})
+		}
+		return page, nil
+	}
+
+	ids := make([]uuid.UUID, len(repoTabs))
 	for i, repoTab := range repoTabs {
-		tabs[i] = NewTab(repoTab)
+		ids[i] = repoTab.ID
+	}
+	tabsWithOrders, err := s.queries.GetTabsWithOrders(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, repoTab := range tabsWithOrders {
+		page.Tabs[slices.Index(ids, repoTab.ID)] = NewTab(repoTab)
+	}
+	return page, nil
+}
+
+// encodePageToken returns the opaque token of the page following the tab
+func encodePageToken(createdAt time.Time, tabID uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt.Format(time.RFC3339Nano) + " " + tabID.String()))
+}
+
+func decodePageToken(token string) (time.Time, uuid.UUID, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, ErrInvalidPageToken
+	}
+	rawCreatedAt, rawTabID, _ := strings.Cut(string(b), " ")
+	createdAt, err := time.Parse(time.RFC3339Nano, rawCreatedAt)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, ErrInvalidPageToken
+	}
+	tabID, err := uuid.Parse(rawTabID)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, ErrInvalidPageToken
+	}
+	return createdAt, tabID, nil
+}
+
+// GetSpendSummary returns what the customer spent, how many tabs they visited and
+// the items they ordered the most
+func (s *TabService) GetSpendSummary(ctx context.Context, customerID model.CustomerID) (*model.SpendSummary, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	totalSpend, err := qtx.GetCustomerSpend(ctx, uuid.UUID(customerID))
+	if err != nil {
+		return nil, err
+	}
+	visitCount, err := qtx.CountVisitedTabs(ctx, uuid.UUID(customerID))
+	if err != nil {
+		return nil, err
+	}
+	repoItems, err := qtx.GetCustomerFavoriteItems(ctx, repository.GetCustomerFavoriteItemsParams{
+		CustomerID: uuid.UUID(customerID),
+		MaxItems:   maxFavoriteItems,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &model.SpendSummary{
+		TotalSpend:    totalSpend,
+		VisitCount:    visitCount,
+		FavoriteItems: make([]model.FavoriteItem, len(repoItems)),
+	}
+	for i, repoItem := range repoItems {
+		summary.FavoriteItems[i] = model.FavoriteItem{
+			MenuItemID: model.MenuItemID(repoItem.ID),
+			Name:       repoItem.Name,
+			Quantity:   repoItem.Quantity,
+			TabCount:   repoItem.TabCount,
+		}
 	}
-	return tabs, nil
+	return summary, nil
 }
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/auth"
+	"restaurant-ordering-system/internal/pkg/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTabServiceClaimGuest(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	cacheService := NewCacheService(db, rdb)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+	authService := newTestAuthService(db, rdb, auth.NewCustomerJWTGenerator(auth.NewHMACKeyring([]byte("secret")), time.Hour), &testMailer{})
+	customerService := NewCustomerService(db, rdb, cacheService, authService)
+
+	menuItem, err := newTestMenuService(t, db).CreateMenuItem(ctx, model.CreateMenuItemParams{
+		Name:        "Dumplings",
+		Price:       100,
+		PortionSize: 1,
+		Available:   true,
+	})
+	require.NoError(t, err)
+	customer, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("alice-password"),
+	})
+	require.NoError(t, err)
+
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	guestID, claimToken, err := tabService.CreateGuest(ctx, tabID)
+	require.NoError(t, err)
+	otherGuestID, otherClaimToken, err := tabService.CreateGuest(ctx, tabID)
+	require.NoError(t, err)
+	require.NoError(t, tabService.UpdateGuestName(ctx, guestID, "Alice"))
+
+	createOrderItem := func(owners ...model.GuestID) model.OrderItemID {
+		tab, err := tabService.GetOpenTab(ctx, tabID)
+		require.NoError(t, err)
+		id, err := orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+			OrderID:       tab.Orders[len(tab.Orders)-1].ID,
+			MenuItemID:    menuItem.ID,
+			Quantity:      1,
+			GuestOwnerIDs: owners,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	sentItemID := createOrderItem(guestID, otherGuestID)
+	require.NoError(t, orderService.SendOrder(ctx, sentItemID.OrderID))
+	draftItemID := createOrderItem(guestID)
+
+	require.ErrorIs(t, tabService.ClaimGuest(ctx, model.GuestID{TabID: tabID, Scoped: 3}, customer.ID, claimToken), ErrGuestNotFound)
+	// Knowing the guest ID is not enough
+	require.ErrorIs(t, tabService.ClaimGuest(ctx, guestID, customer.ID, otherClaimToken), ErrInvalidClaimToken)
+	require.ErrorIs(t, tabService.ClaimGuest(ctx, guestID, customer.ID, ""), ErrInvalidClaimToken)
+	require.NoError(t, tabService.ClaimGuest(ctx, guestID, customer.ID, claimToken))
+	// Claiming again changes nothing, but only the customer who claimed the guest can
+	require.NoError(t, tabService.ClaimGuest(ctx, guestID, customer.ID, claimToken))
+	mallory, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "mallory", Email: "mallory@example.com", Password: []byte("mallory-password"),
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, tabService.ClaimGuest(ctx, guestID, mallory.ID, claimToken), ErrGuestAlreadyClaimed)
+
+	check := func(tab *model.Tab) {
+		t.Helper()
+		require.NotContains(t, tab.CustomGuestNames, guestID)
+		items := map[model.OrderItemID]*model.OrderItem{}
+		for _, order := range tab.Orders {
+			for _, item := range order.Items {
+				items[item.ID] = item
+			}
+		}
+		require.Equal(t, []model.GuestID{otherGuestID}, items[sentItemID].GuestOwnerIDs)
+		require.Equal(t, []model.CustomerID{customer.ID}, items[sentItemID].CustomerOwnerIDs)
+		require.Empty(t, items[draftItemID].GuestOwnerIDs)
+		require.Equal(t, []model.CustomerID{customer.ID}, items[draftItemID].CustomerOwnerIDs)
+	}
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	check(tab)
+	// The database agrees with the cache, except for the draft which only lives in Redis
+	loaded, err := cacheService.loadTab(ctx, tabID)
+	require.NoError(t, err)
+	loaded.Orders[len(loaded.Orders)-1].Items = tab.Orders[len(tab.Orders)-1].Items
+	check(loaded)
+
+	visited, err := tabService.GetVisitedTabs(ctx, customer.ID, model.VisitedTabsParams{})
+	require.NoError(t, err)
+	require.Len(t, visited.Tabs, 1)
+	claimed, err := customerService.GetCustomerByID(ctx, customer.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Alice", claimed.Name)
+}
+
+func TestTabServiceVisitHistory(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	cacheService := NewCacheService(db, rdb)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+	authService := newTestAuthService(db, rdb, auth.NewCustomerJWTGenerator(auth.NewHMACKeyring([]byte("secret")), time.Hour), &testMailer{})
+	customerService := NewCustomerService(db, rdb, cacheService, authService)
+
+	menuService := newTestMenuService(t, db)
+	noodles, err := menuService.CreateMenuItem(ctx, model.CreateMenuItemParams{Name: "Noodles", Price: 100, PortionSize: 1, Available: true})
+	require.NoError(t, err)
+	tea, err := menuService.CreateMenuItem(ctx, model.CreateMenuItemParams{Name: "Tea", Price: 30, PortionSize: 1, Available: true})
+	require.NoError(t, err)
+	customer, err := customerService.CreateCustomer(ctx, model.CreateCustomerParams{
+		LoginID: "alice", Email: "alice@example.com", Password: []byte("alice-password"),
+	})
+	require.NoError(t, err)
+
+	// Three visited tabs, newest last: the first one closed, the items of the second
+	// one shared with a guest
+	order := func(tabID model.TabID, menuItemID model.MenuItemID, quantity int16, guestOwners ...model.GuestID) {
+		tab, err := tabService.GetOpenTab(ctx, tabID)
+		require.NoError(t, err)
+		itemID, err := orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+			OrderID:          tab.Orders[len(tab.Orders)-1].ID,
+			MenuItemID:       menuItemID,
+			Quantity:         quantity,
+			GuestOwnerIDs:    guestOwners,
+			CustomerOwnerIDs: []model.CustomerID{customer.ID},
+		})
+		require.NoError(t, err)
+		require.NoError(t, orderService.SendOrder(ctx, itemID.OrderID))
+	}
+	tabIDs := make([]model.TabID, 3)
+	for i := range tabIDs {
+		tabIDs[i], err = tabService.CreateTab(ctx)
+		require.NoError(t, err)
+		require.NoError(t, tabService.VisitTab(ctx, tabIDs[i], customer.ID))
+	}
+	order(tabIDs[0], noodles.ID, 2)
+	order(tabIDs[0], tea.ID, 1)
+	guestID, _, err := tabService.CreateGuest(ctx, tabIDs[1])
+	require.NoError(t, err)
+	order(tabIDs[1], noodles.ID, 1, guestID)
+	_, err = tabService.CloseTab(ctx, tabIDs[0])
+	require.NoError(t, err)
+
+	// Pages
+	page, err := tabService.GetVisitedTabs(ctx, customer.ID, model.VisitedTabsParams{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Tabs, 2)
+	require.Equal(t, tabIDs[2], page.Tabs[0].ID)
+	require.Equal(t, tabIDs[1], page.Tabs[1].ID)
+	require.NotEmpty(t, page.Tabs[1].Orders)
+	require.NotEmpty(t, page.NextPageToken)
+	page, err = tabService.GetVisitedTabs(ctx, customer.ID, model.VisitedTabsParams{PageSize: 2, PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, page.Tabs, 1)
+	require.Equal(t, tabIDs[0], page.Tabs[0].ID)
+	require.Empty(t, page.NextPageToken)
+	_, err = tabService.GetVisitedTabs(ctx, customer.ID, model.VisitedTabsParams{PageToken: "not-a-token"})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	// Filters and summaries
+	closed := true
+	page, err = tabService.GetVisitedTabs(ctx, customer.ID, model.VisitedTabsParams{Closed: &closed, Summary: true})
+	require.NoError(t, err)
+	require.Len(t, page.Tabs, 1)
+	require.Equal(t, tabIDs[0], page.Tabs[0].ID)
+	require.Equal(t, int32(230), page.Tabs[0].TotalPrice)
+	require.Empty(t, page.Tabs[0].Orders)
+	future := time.Now().Add(time.Hour)
+	page, err = tabService.GetVisitedTabs(ctx, customer.ID, model.VisitedTabsParams{Since: &future})
+	require.NoError(t, err)
+	require.Empty(t, page.Tabs)
+
+	summary, err := tabService.GetSpendSummary(ctx, customer.ID)
+	require.NoError(t, err)
+	require.Equal(t, &model.SpendSummary{
+		TotalSpend: 280, // 2 noodles and a tea, and half of the noodles shared with the guest
+		VisitCount: 3,
+		FavoriteItems: []model.FavoriteItem{
+			{MenuItemID: noodles.ID, Name: "Noodles", Quantity: 3, TabCount: 2},
+			{MenuItemID: tea.ID, Name: "Tea", Quantity: 1, TabCount: 1},
+		},
+	}, summary)
+}
+
+func TestTabServiceGetTabEventsCursor(t *testing.T) {
+	db, rdb := newTestStores(t)
+	ctx := t.Context()
+
+	tabService := NewTabService(db, rdb, rdb, NewCacheService(db, rdb), testTabLimits)
+
+	_, _, err := tabService.GetTabEventsCursor(ctx, model.TabID(uuid.New()), "")
+	require.ErrorIs(t, err, ErrTabNotFound)
+
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+	_, _, err = tabService.GetTabEventsCursor(ctx, tabID, "not-an-id")
+	require.ErrorIs(t, err, ErrInvalidEventID)
+	_, missed, err := tabService.GetTabEventsCursor(ctx, tabID, "")
+	require.NoError(t, err)
+	require.False(t, missed)
+
+	_, err = tabService.CloseTab(ctx, tabID)
+	require.NoError(t, err)
+	_, _, err = tabService.GetTabEventsCursor(ctx, tabID, "")
+	require.ErrorIs(t, err, ErrTabClosed)
+}
+
+func TestTabServiceWithoutRedis(t *testing.T) {
+	db, _ := newTestStores(t)
+	rdb := newUnreachableRedis(t)
+	ctx := t.Context()
+
+	cacheService := NewCacheService(db, rdb)
+	orderService := NewOrderService(db, rdb, cacheService, testTabLimits)
+	tabService := NewTabService(db, rdb, rdb, cacheService, testTabLimits)
+	menuItem, err := newTestMenuService(t, db).CreateMenuItem(ctx, model.CreateMenuItemParams{
+		Name:        "Dumplings",
+		Price:       100,
+		PortionSize: 1,
+		Available:   true,
+	})
+	require.NoError(t, err)
+
+	// Tabs are created in the database only
+	tabID, err := tabService.CreateTab(ctx)
+	require.NoError(t, err)
+
+	// and served from it
+	tab, err := tabService.GetOpenTab(ctx, tabID)
+	require.NoError(t, err)
+	require.Equal(t, tabID, tab.ID)
+	require.Len(t, tab.Orders, 1)
+	require.Nil(t, tab.Orders[0].SentAt)
+	_, err = tabService.GetOpenTab(ctx, model.TabID(uuid.New()))
+	require.ErrorIs(t, err, ErrTabNotFound)
+
+	// Order drafts only live in Redis
+	_, err = orderService.CreateOrderItem(ctx, model.CreateOrderItemParams{
+		OrderID:    tab.Orders[0].ID,
+		MenuItemID: menuItem.ID,
+		Quantity:   1,
+	})
+	require.ErrorIs(t, err, ErrCacheUnavailable)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
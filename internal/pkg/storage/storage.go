@@ -0,0 +1,60 @@
+// Package storage stores uploaded files, e.g. the photos of menu items, and tells
+// the URLs they are served at
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// ErrInvalidKey is returned for keys which are empty, absolute or escape the storage with ..
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// Storage stores files under slash-separated keys, e.g. menu-items/1/abc/large.jpg
+type Storage interface {
+	// Put stores data under key, replacing any file stored there
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Delete removes the file stored under key, if any
+	Delete(ctx context.Context, key string) error
+	// URL returns the URL the file stored under key is served at
+	URL(key string) string
+}
+
+// New creates the storage selected by cfg.Driver
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "", DriverLocal:
+		return NewLocalStorage(cfg.Local.Dir, cfg.BaseURL), nil
+	case DriverS3:
+		return NewS3Storage(cfg.S3, cfg.BaseURL, nil), nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
+
+// checkKey rejects the keys which could address files outside of the storage
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+// joinURL appends key to baseURL
+func joinURL(baseURL, key string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + key
+}
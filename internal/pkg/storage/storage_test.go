@@ -0,0 +1,106 @@
+package storage_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/config"
+	"restaurant-ordering-system/internal/pkg/storage"
+	"restaurant-ordering-system/internal/pkg/storage/s3test"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	s := storage.NewLocalStorage(dir, "http://localhost/media/")
+
+	require.NoError(t, s.Put(t.Context(), "menu-items/1/photo.jpg", "image/jpeg", []byte("jpeg")))
+	data, err := os.ReadFile(filepath.Join(dir, "menu-items", "1", "photo.jpg"))
+	require.NoError(t, err)
+	require.Equal(t, "jpeg", string(data))
+	require.Equal(t, "http://localhost/media/menu-items/1/photo.jpg", s.URL("menu-items/1/photo.jpg"))
+
+	for _, key := range []string{"", "/etc/passwd", "../secret", "menu-items/../../secret", "a//b"} {
+		require.ErrorIs(t, s.Put(t.Context(), key, "text/plain", nil), storage.ErrInvalidKey, key)
+	}
+
+	require.NoError(t, s.Delete(t.Context(), "menu-items/1/photo.jpg"))
+	require.NoFileExists(t, filepath.Join(dir, "menu-items", "1", "photo.jpg"))
+	// Deleting a missing file is not an error
+	require.NoError(t, s.Delete(t.Context(), "menu-items/1/photo.jpg"))
+}
+
+func TestS3Storage(t *testing.T) {
+	server := s3test.NewServer()
+	defer server.Close()
+	s := storage.NewS3Storage(server.Config(), "", nil)
+
+	require.NoError(t, s.Put(t.Context(), "menu-items/1/large photo.jpg", "image/jpeg", []byte("jpeg")))
+	obj, ok := server.Object("menu-items/1/large photo.jpg")
+	require.True(t, ok)
+	require.Equal(t, "jpeg", string(obj.Data))
+	require.Equal(t, "image/jpeg", obj.ContentType)
+	require.Contains(t, obj.CacheControl, "immutable")
+
+	// Objects are served at the URL of the bucket without a base URL
+	url := s.URL("menu-items/1/large photo.jpg")
+	require.Equal(t, server.URL+"/"+s3test.Bucket+"/menu-items/1/large%20photo.jpg", url)
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "jpeg", string(data))
+
+	require.NoError(t, s.Delete(t.Context(), "menu-items/1/large photo.jpg"))
+	require.Empty(t, server.Keys())
+	require.NoError(t, s.Delete(t.Context(), "menu-items/1/large photo.jpg"))
+
+	// Requests signed with another secret are rejected
+	cfg := server.Config()
+	cfg.SecretAccessKey = "wrong"
+	require.ErrorContains(t, storage.NewS3Storage(cfg, "", nil).Put(t.Context(), "key", "text/plain", nil), "403")
+
+	// Buckets are addressed in the host name without path-style URLs
+	cfg.PathStyle = false
+	cfg.Endpoint = "https://s3.eu-west-1.amazonaws.com"
+	require.Equal(t, "https://"+s3test.Bucket+".s3.eu-west-1.amazonaws.com/a/b.jpg", storage.NewS3Storage(cfg, "", nil).URL("a/b.jpg"))
+	require.Equal(t, "https://cdn.example.com/a/b.jpg", storage.NewS3Storage(cfg, "https://cdn.example.com", nil).URL("a/b.jpg"))
+}
+
+func TestS3StorageTimeout(t *testing.T) {
+	// A bucket which never answers
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+	cfg := config.S3StorageConfig{
+		Endpoint:        server.URL,
+		Region:          s3test.Region,
+		Bucket:          s3test.Bucket,
+		AccessKeyID:     s3test.AccessKeyID,
+		SecretAccessKey: s3test.SecretAccessKey,
+		PathStyle:       true,
+		Timeout:         100 * time.Millisecond,
+	}
+
+	start := time.Now()
+	require.Error(t, storage.NewS3Storage(cfg, "", nil).Put(t.Context(), "key", "text/plain", nil))
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestNew(t *testing.T) {
+	s, err := storage.New(config.StorageConfig{Driver: storage.DriverLocal, BaseURL: "/media", Local: config.LocalStorageConfig{Dir: t.TempDir()}})
+	require.NoError(t, err)
+	require.IsType(t, &storage.LocalStorage{}, s)
+	_, err = storage.New(config.StorageConfig{Driver: "ftp"})
+	require.Error(t, err)
+}
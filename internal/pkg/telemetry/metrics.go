@@ -0,0 +1,56 @@
+// Package telemetry configures the OpenTelemetry providers used by the application
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"restaurant-ordering-system/internal/pkg/config"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// SetupMetrics installs the global meter provider described by cfg.
+// The returned function flushes and stops the provider.
+func SetupMetrics(ctx context.Context, cfg config.MetricsConfig) (func(context.Context) error, error) {
+	var exporter sdkmetric.Exporter
+	switch cfg.Exporter {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "stdout":
+		e, err := stdoutmetric.New()
+		if err != nil {
+			return nil, err
+		}
+		exporter = e
+	case "otlp":
+		opts := []otlpmetricgrpc.Option{}
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlpmetricgrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		}
+		e, err := otlpmetricgrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		exporter = e
+	default:
+		return nil, fmt.Errorf("unknown metrics exporter %q", cfg.Exporter)
+	}
+
+	readerOpts := []sdkmetric.PeriodicReaderOption{}
+	if cfg.Interval > 0 {
+		readerOpts = append(readerOpts, sdkmetric.WithInterval(cfg.Interval))
+	}
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, readerOpts...)),
+	)
+	otel.SetMeterProvider(provider)
+
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,171 @@
+// Package tlsconfig builds the TLS configuration of the server and reloads its
+// certificates when their files change
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+const (
+	ModeOff  = "off"
+	ModeTLS  = "tls"
+	ModeMTLS = "mtls"
+)
+
+// Reloader holds the certificate of the server and the CAs of its clients,
+// reloading them from their files
+type Reloader struct {
+	cfg config.TLSConfig
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+// NewServerConfig returns the TLS configuration for cfg, or nil if TLS is off.
+// In mTLS mode, clients must present a certificate signed by one of the client CAs.
+// TLS is only turned off explicitly, an empty mode is an error.
+func NewServerConfig(cfg config.TLSConfig) (*tls.Config, *Reloader, error) {
+	switch cfg.Mode {
+	case ModeOff:
+		return nil, nil, nil
+	case ModeTLS, ModeMTLS:
+	case "":
+		return nil, nil, errors.New("TLS mode is required, use off to disable TLS")
+	default:
+		return nil, nil, fmt.Errorf("unknown TLS mode %q", cfg.Mode)
+	}
+
+	r := &Reloader{cfg: cfg}
+	if _, err := r.reload(); err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.getCertificate,
+	}
+	if cfg.Mode == ModeMTLS {
+		// Client certificates are verified against the current client CAs by
+		// verifyClientCertificate, since they may be reloaded after the config is built
+		tlsConfig.ClientAuth = tls.RequireAnyClientCert
+		tlsConfig.VerifyPeerCertificate = r.verifyClientCertificate
+	}
+	return tlsConfig, r, nil
+}
+
+// Run reloads the certificates every interval until ctx is done,
+// whenever one of their files was modified
+func (r *Reloader) Run(ctx context.Context, interval time.Duration, logger *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		reloaded, err := r.reload()
+		if err != nil {
+			// Keep serving the previous certificates until the files are fixed
+			logger.Error("Failed to reload TLS certificates", "error", err)
+		} else if reloaded {
+			logger.Info("Reloaded TLS certificates")
+		}
+	}
+}
+
+// reload loads the certificates again if one of their files was modified since the last load
+func (r *Reloader) reload() (bool, error) {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.Mode == ModeMTLS {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+	modTimes := make(map[string]time.Time, len(files))
+	changed := false
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, err
+		}
+		modTimes[file] = info.ModTime()
+
+		r.mu.RLock()
+		last, ok := r.modTimes[file]
+		r.mu.RUnlock()
+		changed = changed || !ok || !last.Equal(info.ModTime())
+	}
+	if !changed {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return false, err
+	}
+	var clientCAs *x509.CertPool
+	if r.cfg.Mode == ModeMTLS {
+		pem, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return false, err
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return false, fmt.Errorf("no certificates found in %s", r.cfg.ClientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	return true, nil
+}
+
+func (r *Reloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+func (r *Reloader) verifyClientCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("client certificate required")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs[i] = cert
+	}
+
+	r.mu.RLock()
+	clientCAs := r.clientCAs
+	r.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
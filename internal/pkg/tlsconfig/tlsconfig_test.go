@@ -0,0 +1,165 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"restaurant-ordering-system/internal/pkg/config"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, commonName string, usage x509.ExtKeyUsage, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.cert.Raw}, PrivateKey: c.key}
+}
+
+func (c *testCert) write(t *testing.T, certFile, keyFile string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}), 0o600))
+	if keyFile == "" {
+		return
+	}
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600))
+}
+
+// handshake connects a client to a server using serverConfig and returns the
+// certificate presented by the server
+func handshake(t *testing.T, serverConfig *tls.Config, clientCert *tls.Certificate, roots *x509.CertPool) (*x509.Certificate, error) {
+	t.Helper()
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+	defer lis.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	clientConfig := &tls.Config{RootCAs: roots, ServerName: "server"}
+	if clientCert != nil {
+		clientConfig.Certificates = []tls.Certificate{*clientCert}
+	}
+	client, err := tls.Dial("tcp", lis.Addr().String(), clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	// With TLS 1.3 the client certificate is verified after the client handshake
+	if err := <-serverErr; err != nil {
+		return nil, err
+	}
+	return client.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestMTLSWithReload(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.TLSConfig{
+		Mode:         ModeMTLS,
+		CertFile:     filepath.Join(dir, "server_cert.pem"),
+		KeyFile:      filepath.Join(dir, "server_key.pem"),
+		ClientCAFile: filepath.Join(dir, "client_ca.pem"),
+	}
+
+	serverCA := newTestCert(t, "server-ca", x509.ExtKeyUsageServerAuth, nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(serverCA.cert)
+	serverCert := newTestCert(t, "server", x509.ExtKeyUsageServerAuth, serverCA)
+	serverCert.write(t, cfg.CertFile, cfg.KeyFile)
+	clientCA := newTestCert(t, "client-ca", x509.ExtKeyUsageClientAuth, nil)
+	clientCA.write(t, cfg.ClientCAFile, "")
+	kitchen := newTestCert(t, "kitchen", x509.ExtKeyUsageClientAuth, clientCA).tlsCertificate()
+	stranger := newTestCert(t, "stranger", x509.ExtKeyUsageClientAuth, nil).tlsCertificate()
+
+	tlsConfig, reloader, err := NewServerConfig(cfg)
+	require.NoError(t, err)
+
+	presented, err := handshake(t, tlsConfig, &kitchen, roots)
+	require.NoError(t, err)
+	require.Equal(t, serverCert.cert.SerialNumber, presented.SerialNumber)
+	_, err = handshake(t, tlsConfig, &stranger, roots)
+	require.Error(t, err)
+	_, err = handshake(t, tlsConfig, nil, roots)
+	require.Error(t, err)
+
+	reloaded, err := reloader.reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+
+	// Rotating the server certificate
+	rotated := newTestCert(t, "server", x509.ExtKeyUsageServerAuth, serverCA)
+	rotated.write(t, cfg.CertFile, cfg.KeyFile)
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(cfg.CertFile, later, later))
+	reloaded, err = reloader.reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+
+	presented, err = handshake(t, tlsConfig, &kitchen, roots)
+	require.NoError(t, err)
+	require.Equal(t, rotated.cert.SerialNumber, presented.SerialNumber)
+}
+
+func TestModeOff(t *testing.T) {
+	tlsConfig, reloader, err := NewServerConfig(config.TLSConfig{Mode: ModeOff})
+	require.NoError(t, err)
+	require.Nil(t, tlsConfig)
+	require.Nil(t, reloader)
+
+	_, _, err = NewServerConfig(config.TLSConfig{Mode: "plaintext"})
+	require.Error(t, err)
+	// Plaintext is never the fallback of a missing mode
+	_, _, err = NewServerConfig(config.TLSConfig{})
+	require.Error(t, err)
+}
@@ -0,0 +1,5 @@
+-- migrations/003_customer_deletion.sql
+-- Deleted customers are anonymized rather than removed, keeping the tabs they shared
+ALTER TABLE "customer" ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMP;
+ALTER TABLE "customer" ALTER COLUMN "login_id" DROP NOT NULL;
+ALTER TABLE "customer" ALTER COLUMN "email" DROP NOT NULL;
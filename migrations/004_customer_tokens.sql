@@ -0,0 +1,16 @@
+-- migrations/004_customer_tokens.sql
+ALTER TABLE "customer" ADD COLUMN IF NOT EXISTS "email_verified_at" TIMESTAMP;
+
+-- Single-use tokens sent to customers by email, only their SHA-256 hash is stored
+CREATE TABLE IF NOT EXISTS "customer_token" (
+    "token_hash" BYTEA PRIMARY KEY,
+    "customer_id" UUID NOT NULL,
+    "purpose" TEXT NOT NULL CHECK ("purpose" IN ('email_verification', 'password_reset')),
+    "email" TEXT NOT NULL,
+    "expires_at" TIMESTAMP NOT NULL,
+    "used_at" TIMESTAMP,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY ("customer_id") REFERENCES "customer"("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "customer_token_customer_id_idx" ON "customer_token" ("customer_id", "purpose");
@@ -0,0 +1,13 @@
+-- migrations/005_customer_identities.sql
+-- External identities customers log in with, by OpenID Connect issuer and subject
+CREATE TABLE IF NOT EXISTS "customer_identity" (
+    "issuer" TEXT NOT NULL,
+    "subject" TEXT NOT NULL,
+    "customer_id" UUID NOT NULL,
+    "email" TEXT,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY ("issuer", "subject"),
+    FOREIGN KEY ("customer_id") REFERENCES "customer"("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "customer_identity_customer_id_idx" ON "customer_identity" ("customer_id");
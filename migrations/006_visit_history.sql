@@ -0,0 +1,4 @@
+-- migrations/006_visit_history.sql
+-- Visit history is listed per customer, newest tabs first
+CREATE INDEX IF NOT EXISTS "visitation_customer_id_idx" ON "visitation" ("customer_id");
+CREATE INDEX IF NOT EXISTS "tab_created_at_idx" ON "tab" ("created_at" DESC, "id" DESC);
@@ -0,0 +1,16 @@
+-- migrations/007_favorites.sql
+-- Menu items saved by customers with their modifiers, under a name unique per customer
+CREATE TABLE IF NOT EXISTS "favorite" (
+    "id" BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    "customer_id" UUID NOT NULL,
+    "menu_item_id" SMALLINT NOT NULL,
+    "name" TEXT NOT NULL,
+    "modifiers" JSONB,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE ("customer_id", "name"),
+    FOREIGN KEY ("customer_id") REFERENCES "customer"("id") ON DELETE CASCADE,
+    FOREIGN KEY ("menu_item_id") REFERENCES "menu_item"("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "favorite_menu_item_id_idx" ON "favorite" ("menu_item_id");
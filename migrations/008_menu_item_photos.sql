@@ -0,0 +1,14 @@
+-- migrations/008_menu_item_photos.sql
+-- Photos uploaded for menu items, stored with their resized variants under
+-- storage keys, "variants" holding [{"name", "key", "width", "height"}]
+CREATE TABLE IF NOT EXISTS "menu_item_photo" (
+    "menu_item_id" SMALLINT PRIMARY KEY,
+    "content_type" TEXT NOT NULL,
+    "width" INTEGER NOT NULL,
+    "height" INTEGER NOT NULL,
+    "blurhash" TEXT NOT NULL,
+    "original_key" TEXT NOT NULL,
+    "variants" JSONB NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY ("menu_item_id") REFERENCES "menu_item"("id") ON DELETE CASCADE
+);
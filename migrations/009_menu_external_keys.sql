@@ -0,0 +1,10 @@
+-- migrations/009_menu_external_keys.sql
+-- Stable keys identifying menu items, tags and dimensions across menu imports and
+-- exports, generated for the rows created otherwise
+ALTER TABLE "menu_tag_dimension" ADD COLUMN IF NOT EXISTS "external_key" TEXT NOT NULL DEFAULT gen_random_uuid()::TEXT;
+ALTER TABLE "menu_tag" ADD COLUMN IF NOT EXISTS "external_key" TEXT NOT NULL DEFAULT gen_random_uuid()::TEXT;
+ALTER TABLE "menu_item" ADD COLUMN IF NOT EXISTS "external_key" TEXT NOT NULL DEFAULT gen_random_uuid()::TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "menu_tag_dimension_external_key_idx" ON "menu_tag_dimension" ("external_key");
+CREATE UNIQUE INDEX IF NOT EXISTS "menu_tag_external_key_idx" ON "menu_tag" ("external_key");
+CREATE UNIQUE INDEX IF NOT EXISTS "menu_item_external_key_idx" ON "menu_item" ("external_key");
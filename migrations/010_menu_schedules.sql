@@ -0,0 +1,42 @@
+-- migrations/010_menu_schedules.sql
+-- Named menus grouping menu items by service period, such as breakfast or dinner,
+-- active during their schedules in their timezone
+CREATE TABLE IF NOT EXISTS "menu" (
+    "id" SMALLINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    "name" TEXT UNIQUE NOT NULL,
+    "description" TEXT,
+    "timezone" TEXT NOT NULL DEFAULT 'UTC',
+    "created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- A weekly time window of a menu, ending the next day when "end_time" is not after
+-- "start_time". Empty "weekdays" (0 for Sunday) mean every day, and the optional dates
+-- bound the days the window starts on.
+CREATE TABLE IF NOT EXISTS "menu_schedule" (
+    "id" INTEGER PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    "menu_id" SMALLINT NOT NULL,
+    "weekdays" SMALLINT[] NOT NULL DEFAULT '{}',
+    "start_time" TIME NOT NULL,
+    "end_time" TIME NOT NULL,
+    "start_date" DATE,
+    "end_date" DATE,
+    FOREIGN KEY ("menu_id") REFERENCES "menu"("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "menu_schedule_menu_id_idx" ON "menu_schedule" ("menu_id");
+
+CREATE TABLE IF NOT EXISTS "menu_menu_item" (
+    "menu_id" SMALLINT,
+    "menu_item_id" SMALLINT,
+    PRIMARY KEY ("menu_id", "menu_item_id"),
+    FOREIGN KEY ("menu_id") REFERENCES "menu"("id") ON DELETE CASCADE,
+    FOREIGN KEY ("menu_item_id") REFERENCES "menu_item"("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "menu_menu_item_menu_item_id_idx" ON "menu_menu_item" ("menu_item_id");
+
+-- Forces a menu item available or unavailable, regardless of "available" and of the
+-- schedules of its menus, until "availability_override_until" if not NULL
+ALTER TABLE "menu_item" ADD COLUMN IF NOT EXISTS "availability_override" BOOLEAN;
+ALTER TABLE "menu_item" ADD COLUMN IF NOT EXISTS "availability_override_until" TIMESTAMP;
@@ -0,0 +1,5 @@
+-- migrations/011_customer_identity_logins.sql
+-- The last login with every identity, which customers without a password reauthenticate
+-- with. Unknown for the identities linked before.
+ALTER TABLE "customer_identity" ADD COLUMN IF NOT EXISTS "last_login_at" TIMESTAMP;
+ALTER TABLE "customer_identity" ALTER COLUMN "last_login_at" SET DEFAULT NOW();
@@ -0,0 +1,13 @@
+-- migrations/012_guest_claim_tokens.sql
+-- Tokens handed to the device of every guest, proving who may claim the guest.
+-- Only their SHA-256 hash is stored. Guests created before have none and can not be claimed.
+CREATE TABLE IF NOT EXISTS "guest_claim_token" (
+    "tab_id" UUID,
+    "guest_id" SMALLINT,
+    "token_hash" BYTEA NOT NULL,
+    "claimed_by" UUID,
+    "claimed_at" TIMESTAMP,
+    PRIMARY KEY ("tab_id", "guest_id"),
+    FOREIGN KEY ("tab_id") REFERENCES "tab"("id") ON DELETE CASCADE,
+    FOREIGN KEY ("claimed_by") REFERENCES "customer"("id") ON DELETE SET NULL
+);
@@ -52,7 +52,7 @@ func TestEndToEndFlow(t *testing.T) {
 		postgres.WithDatabase(cfg.Database.Database),
 		postgres.WithUsername(cfg.Database.User),
 		postgres.WithPassword(cfg.Database.Password),
-		postgres.WithInitScripts("../migrations/001_create_tables.sql"),
+		postgres.WithInitScripts("../migrations/001_create_tables.sql", "../migrations/003_customer_deletion.sql", "../migrations/004_customer_tokens.sql", "../migrations/005_customer_identities.sql", "../migrations/006_visit_history.sql", "../migrations/007_favorites.sql", "../migrations/008_menu_item_photos.sql", "../migrations/009_menu_external_keys.sql", "../migrations/010_menu_schedules.sql", "../migrations/011_customer_identity_logins.sql", "../migrations/012_guest_claim_tokens.sql"),
 		postgres.WithSQLDriver("pgx"),
 		postgres.BasicWaitStrategies(),
 		network.WithNetwork([]string{cfg.Database.Host}, net),